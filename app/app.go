@@ -58,6 +58,7 @@ func (a *App) SetUp() error {
 	}
 
 	a.subscribeToEvents()
+	a.startSchedulers()
 
 	a.addGinMiddlewares()
 	a.router.MapRoutes(a.componentRegistry, a.gin.Group("/api"))
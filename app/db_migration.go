@@ -1,18 +1,85 @@
 package app
 
 import (
+	assetprojections "contentgit/domain/asset/projections"
+	crprojections "contentgit/domain/changerequest/projections"
+	"contentgit/domain/content"
+	"contentgit/domain/content/events"
 	"contentgit/domain/content/projections"
+	notiprojections "contentgit/domain/notification/projections"
 	"contentgit/ports/out/persistance/eventsourcing"
 	"log"
+
+	"gorm.io/gorm"
 )
 
 func (a *App) migrateDatabase() error {
 	log.Println(">>> Database Migrate")
 	// 테이블 생성
 	if err := a.gormDB.AutoMigrate(&eventsourcing.Event{}, &eventsourcing.Snapshot{},
-		&projections.ContentProjection{}, &projections.ContentFieldChange{}, &projections.ContentFieldComment{}); err != nil {
+		&projections.ContentProjection{}, &projections.ContentFieldChange{}, &projections.ContentFieldComment{},
+		&projections.ContentBranchProjection{}, &projections.ContentCommitProjection{}, &content.ContentTag{},
+		&content.ContentKey{}, &projections.PublishedContentProjection{},
+		&content.ContentSchedule{}, &content.ContentTypeSchema{}, &content.SchemaMigration{},
+		&projections.ContentReferenceProjection{},
+		&crprojections.ChangeRequestProjection{}, &crprojections.ChangeRequestReview{}, &crprojections.ChangeRequestComment{},
+		&notiprojections.NotificationProjection{}, &assetprojections.AssetProjection{}); err != nil {
 		return err
 	}
 
-	return nil
+	// 브랜치별 이벤트 스트림, 버전별 스냅샷, 댓글별 알림을 막는 이전 인덱스 제거
+	legacyIndexes := []struct {
+		model any
+		name  string
+	}{
+		{&eventsourcing.Event{}, "idx_unique"},
+		{&eventsourcing.Snapshot{}, "idx_snapshot_unique"},
+		{&eventsourcing.Snapshot{}, "idx_snapshots_stream"},
+		{&eventsourcing.Snapshot{}, "idx_snapshot_aggregate_id_version"},
+		{&notiprojections.NotificationProjection{}, "idx_notifications_event"},
+	}
+	for _, legacyIndex := range legacyIndexes {
+		if a.gormDB.Migrator().HasIndex(legacyIndex.model, legacyIndex.name) {
+			if err := a.gormDB.Migrator().DropIndex(legacyIndex.model, legacyIndex.name); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := eraseSchemaMigrationValues(a.gormDB); err != nil {
+		return err
+	}
+
+	return BackfillFieldCommentIds(a.gormDB)
+}
+
+// eraseSchemaMigrationValues removes the field values that schema migration reports stored before they were left out,
+// a report keeps the names of the fields only.
+func eraseSchemaMigrationValues(db *gorm.DB) error {
+	return db.Exec(`
+		UPDATE content_type_migrations m
+		SET results = (
+			SELECT jsonb_agg(CASE WHEN jsonb_typeof(r->'fields') = 'array'
+				THEN jsonb_set(r, '{fields}', (SELECT COALESCE(jsonb_agg(f - 'beforeValue' - 'afterValue'), '[]'::jsonb)
+				                               FROM jsonb_array_elements(r->'fields') f))
+				ELSE r END)
+			FROM jsonb_array_elements(m.results) r)
+		WHERE jsonb_path_exists(m.results, '$[*].fields[*] ? (exists(@.beforeValue) || exists(@.afterValue))')`).Error
+}
+
+// BackfillFieldCommentIds gives the field comments projected before comment ids existed the id their events stand for.
+// The id of such a comment is the version of its event (content.FieldCommentId), and the n-th comment projected for a
+// content is the n-th CONTENT_FIELD_COMMENT_ADDED_V1 event of its stream, as the projection appends them in event order.
+func BackfillFieldCommentIds(db *gorm.DB) error {
+	return db.Exec(`
+		UPDATE content_field_comments c
+		SET comment_id = e.comment_id
+		FROM (SELECT id, row_number() OVER (PARTITION BY content_id ORDER BY id) AS ordinal
+		      FROM content_field_comments) r,
+		     (SELECT aggregate_id, COALESCE(NULLIF(data->>'commentId', ''), version::text) AS comment_id,
+		             row_number() OVER (PARTITION BY aggregate_id ORDER BY id) AS ordinal
+		      FROM events
+		      WHERE event_type = ? AND deleted_at IS NULL) e
+		WHERE c.id = r.id AND e.aggregate_id = c.content_id AND e.ordinal = r.ordinal
+		  AND (c.comment_id IS NULL OR c.comment_id = '')`, events.FieldCommentAddedEventType).Error
 }
@@ -13,4 +13,37 @@ func (a *App) subscribeToEvents() {
 		consumerCtx := foundation.ContextProvider().SetDB(context.TODO(), a.gormDB)
 		contentEventConsumer.Consume(consumerCtx)
 	}()
+
+	changeRequestEventConsumer := consumer.NewEventConsumer(pgmq.NewPostgresMessagingQueue(), a.componentRegistry.Get("ChangeRequestEventHandler").(consumer.EventHandler))
+	go func() {
+		consumerCtx := foundation.ContextProvider().SetDB(context.TODO(), a.gormDB)
+		changeRequestEventConsumer.Consume(consumerCtx)
+	}()
+
+	notificationEventConsumer := consumer.NewQueueEventConsumer(pgmq.NewPostgresMessagingQueue(), pgmq.ContentNotificationQueue,
+		a.componentRegistry.Get("NotificationEventHandler").(consumer.EventHandler))
+	go func() {
+		consumerCtx := foundation.ContextProvider().SetDB(context.TODO(), a.gormDB)
+		notificationEventConsumer.Consume(consumerCtx)
+	}()
+
+	referenceEventConsumer := consumer.NewQueueEventConsumer(pgmq.NewPostgresMessagingQueue(), pgmq.ContentReferenceQueue,
+		a.componentRegistry.Get("ReferenceEventHandler").(consumer.EventHandler))
+	go func() {
+		consumerCtx := foundation.ContextProvider().SetDB(context.TODO(), a.gormDB)
+		referenceEventConsumer.Consume(consumerCtx)
+	}()
+
+	contentPurgeEventConsumer := consumer.NewQueueEventConsumer(pgmq.NewPostgresMessagingQueue(), pgmq.ContentChangeRequestQueue,
+		a.componentRegistry.Get("ContentPurgeEventHandler").(consumer.EventHandler))
+	go func() {
+		consumerCtx := foundation.ContextProvider().SetDB(context.TODO(), a.gormDB)
+		contentPurgeEventConsumer.Consume(consumerCtx)
+	}()
+
+	assetEventConsumer := consumer.NewEventConsumer(pgmq.NewPostgresMessagingQueue(), a.componentRegistry.Get("AssetEventHandler").(consumer.EventHandler))
+	go func() {
+		consumerCtx := foundation.ContextProvider().SetDB(context.TODO(), a.gormDB)
+		assetEventConsumer.Consume(consumerCtx)
+	}()
 }
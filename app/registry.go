@@ -3,10 +3,16 @@ package app
 import (
 	"contentgit/app/cache"
 	"contentgit/appservices"
+	"contentgit/config"
+	"contentgit/domain/asset"
+	"contentgit/domain/changerequest"
 	"contentgit/domain/content"
+	"contentgit/domain/notification"
+	"contentgit/ports/in/scheduler"
 	"contentgit/ports/out/messaging/broker/pgmq"
 	"contentgit/ports/out/persistance/eventsourcing"
 	"contentgit/ports/out/persistance/rdb"
+	"contentgit/ports/out/storage/localfs"
 )
 
 type ComponentRegistry struct {
@@ -38,25 +44,171 @@ func (a *App) registerComponents() error {
 
 	// register repositories
 	a.componentRegistry.Register("ContentProjectionRepository", &rdb.ContentProjectionRepositoryImpl{})
+	a.componentRegistry.Register("ContentStreamRepository", &rdb.ContentStreamRepositoryImpl{})
+	a.componentRegistry.Register("ContentBranchProjectionRepository", &rdb.ContentBranchProjectionRepositoryImpl{})
+	a.componentRegistry.Register("ContentCommitProjectionRepository", &rdb.ContentCommitProjectionRepositoryImpl{})
+	a.componentRegistry.Register("ContentTagRepository", &rdb.ContentTagRepositoryImpl{})
+	a.componentRegistry.Register("ContentKeyRepository", &rdb.ContentKeyRepositoryImpl{})
+	a.componentRegistry.Register("PublishedContentProjectionRepository", &rdb.PublishedContentProjectionRepositoryImpl{})
+	a.componentRegistry.Register("ContentScheduleRepository", &rdb.ContentScheduleRepositoryImpl{})
+	a.componentRegistry.Register("ContentTypeSchemaRepository", &rdb.ContentTypeSchemaRepositoryImpl{})
+	a.componentRegistry.Register("SchemaMigrationRepository", &rdb.SchemaMigrationRepositoryImpl{})
+	a.componentRegistry.Register("ContentReferenceProjectionRepository", &rdb.ContentReferenceProjectionRepositoryImpl{})
+	a.componentRegistry.Register("ChangeRequestProjectionRepository", &rdb.ChangeRequestProjectionRepositoryImpl{})
+	a.componentRegistry.Register("NotificationProjectionRepository", &rdb.NotificationProjectionRepositoryImpl{})
+	a.componentRegistry.Register("AssetProjectionRepository", &rdb.AssetProjectionRepositoryImpl{})
+	a.componentRegistry.Register("Clock", content.SystemClock())
 	a.componentRegistry.Register("EventsBus", pgmq.NewPostgresMessagingQueue())
 
+	personalDataFields := content.NewPersonalDataFields(config.Config.PersonalDataFields)
+	a.componentRegistry.Register("ContentEventSerializer", content.NewShreddingEventSerializer(
+		a.componentRegistry.components["ContentKeyRepository"].(content.ContentKeyRepository),
+		personalDataFields,
+	))
+
+	a.componentRegistry.Register("ContentAggregateStore", eventsourcing.NewRdbEventStore(
+		a.componentRegistry.components["EventsBus"].(eventsourcing.EventsBus),
+		a.componentRegistry.components["ContentEventSerializer"].(eventsourcing.Serializer),
+		&eventsourcing.EventRepository{},
+		&eventsourcing.SnapshotRepository{},
+	))
+
+	a.componentRegistry.Register("ChangeRequestEventSerializer", changerequest.NewShreddingEventSerializer(
+		a.componentRegistry.components["ContentKeyRepository"].(content.ContentKeyRepository),
+		personalDataFields,
+	))
+	a.componentRegistry.Register("ChangeRequestAggregateStore", eventsourcing.NewRdbEventStore(
+		a.componentRegistry.components["EventsBus"].(eventsourcing.EventsBus),
+		a.componentRegistry.components["ChangeRequestEventSerializer"].(eventsourcing.Serializer),
+		&eventsourcing.EventRepository{},
+		&eventsourcing.SnapshotRepository{},
+	))
+
+	a.componentRegistry.Register("AssetEventSerializer", asset.NewEventSerializer())
+	a.componentRegistry.Register("AssetAggregateStore", eventsourcing.NewRdbEventStore(
+		a.componentRegistry.components["EventsBus"].(eventsourcing.EventsBus),
+		a.componentRegistry.components["AssetEventSerializer"].(eventsourcing.Serializer),
+		&eventsourcing.EventRepository{},
+		&eventsourcing.SnapshotRepository{},
+	))
+
+	assetStorage, err := localfs.NewLocalStorage(config.Config.AssetStorage.Path)
+	if err != nil {
+		return err
+	}
+	a.componentRegistry.Register("AssetStorage", assetStorage)
+
+	workflows, err := content.NewWorkflows(config.Config.Workflows)
+	if err != nil {
+		return err
+	}
+
+	localeFallbacks, err := content.NewLocaleFallbacks(config.Config.Locales)
+	if err != nil {
+		return err
+	}
+	a.componentRegistry.Register("LocaleFallbacks", localeFallbacks)
+
 	// register services
 	contentService := appservices.NewContentService(
-		eventsourcing.NewRdbEventStore(
-			a.componentRegistry.components["EventsBus"].(eventsourcing.EventsBus),
-			content.NewEventSerializer(),
-			&eventsourcing.EventRepository{},
-			&eventsourcing.SnapshotRepository{},
-		),
+		a.componentRegistry.components["ContentAggregateStore"].(eventsourcing.AggregateStore),
+		a.componentRegistry.components["ContentTagRepository"].(content.ContentTagRepository),
+		a.componentRegistry.components["ContentKeyRepository"].(content.ContentKeyRepository),
+		personalDataFields,
+		workflows,
+		a.componentRegistry.components["ContentScheduleRepository"].(content.ContentScheduleRepository),
+		a.componentRegistry.components["Clock"].(content.Clock),
+		a.componentRegistry.components["ContentTypeSchemaRepository"].(content.ContentTypeSchemaRepository),
+		a.componentRegistry.components["SchemaMigrationRepository"].(content.SchemaMigrationRepository),
+		a.componentRegistry.components["ContentReferenceProjectionRepository"].(content.ContentReferenceProjectionRepository),
+		a.componentRegistry.components["AssetAggregateStore"].(eventsourcing.AggregateStore),
 	)
 	a.componentRegistry.Register("ContentService", contentService)
 
-	contentQuery := appservices.NewContentQuery(a.componentRegistry.components["ContentProjectionRepository"].(content.ContentProjectionRepository))
+	contentQuery := appservices.NewContentQuery(a.componentRegistry.components["ContentProjectionRepository"].(content.ContentProjectionRepository),
+		a.componentRegistry.components["ContentBranchProjectionRepository"].(content.ContentBranchProjectionRepository),
+		a.componentRegistry.components["ContentCommitProjectionRepository"].(content.ContentCommitProjectionRepository),
+		a.componentRegistry.components["ContentTagRepository"].(content.ContentTagRepository),
+		a.componentRegistry.components["PublishedContentProjectionRepository"].(content.PublishedContentProjectionRepository),
+		a.componentRegistry.components["ContentScheduleRepository"].(content.ContentScheduleRepository),
+		a.componentRegistry.components["ContentTypeSchemaRepository"].(content.ContentTypeSchemaRepository),
+		a.componentRegistry.components["SchemaMigrationRepository"].(content.SchemaMigrationRepository),
+		a.componentRegistry.components["ContentReferenceProjectionRepository"].(content.ContentReferenceProjectionRepository),
+		a.componentRegistry.components["LocaleFallbacks"].(content.LocaleFallbacks),
+		a.componentRegistry.components["ContentAggregateStore"].(eventsourcing.AggregateStore))
 	a.componentRegistry.Register("ContentQuery", contentQuery)
 
+	changeRequestService := appservices.NewChangeRequestService(
+		a.componentRegistry.components["ChangeRequestAggregateStore"].(eventsourcing.AggregateStore),
+		a.componentRegistry.components["ContentAggregateStore"].(eventsourcing.AggregateStore),
+		a.componentRegistry.components["ContentTypeSchemaRepository"].(content.ContentTypeSchemaRepository),
+		a.componentRegistry.components["AssetAggregateStore"].(eventsourcing.AggregateStore),
+	)
+	a.componentRegistry.Register("ChangeRequestService", changeRequestService)
+
+	changeRequestQuery := appservices.NewChangeRequestQuery(a.componentRegistry.components["ChangeRequestProjectionRepository"].(changerequest.ChangeRequestProjectionRepository),
+		a.componentRegistry.components["ContentProjectionRepository"].(content.ContentProjectionRepository))
+	a.componentRegistry.Register("ChangeRequestQuery", changeRequestQuery)
+
+	notificationService := appservices.NewNotificationService(a.componentRegistry.components["NotificationProjectionRepository"].(notification.NotificationProjectionRepository),
+		a.componentRegistry.components["Clock"].(content.Clock))
+	a.componentRegistry.Register("NotificationService", notificationService)
+
+	notificationQuery := appservices.NewNotificationQuery(a.componentRegistry.components["NotificationProjectionRepository"].(notification.NotificationProjectionRepository))
+	a.componentRegistry.Register("NotificationQuery", notificationQuery)
+
+	assetService := appservices.NewAssetService(a.componentRegistry.components["AssetAggregateStore"].(eventsourcing.AggregateStore),
+		a.componentRegistry.components["AssetStorage"].(asset.Storage))
+	a.componentRegistry.Register("AssetService", assetService)
+
+	assetQuery := appservices.NewAssetQuery(a.componentRegistry.components["AssetProjectionRepository"].(asset.AssetProjectionRepository),
+		a.componentRegistry.components["AssetAggregateStore"].(eventsourcing.AggregateStore),
+		a.componentRegistry.components["AssetStorage"].(asset.Storage))
+	a.componentRegistry.Register("AssetQuery", assetQuery)
+
+	// register schedulers
+	contentScheduler := scheduler.NewContentScheduler(a.componentRegistry.components["ContentScheduleRepository"].(content.ContentScheduleRepository),
+		contentService.Commands.ChangeContentStatus,
+		a.componentRegistry.components["Clock"].(content.Clock))
+	a.componentRegistry.Register("ContentScheduler", contentScheduler)
+
+	schemaMigrationRunner := scheduler.NewSchemaMigrationRunner(a.componentRegistry.components["SchemaMigrationRepository"].(content.SchemaMigrationRepository),
+		a.componentRegistry.components["ContentTypeSchemaRepository"].(content.ContentTypeSchemaRepository),
+		a.componentRegistry.components["ContentStreamRepository"].(content.ContentStreamRepository),
+		contentService.Commands.MigrateContent,
+		a.componentRegistry.components["Clock"].(content.Clock))
+	a.componentRegistry.Register("SchemaMigrationRunner", schemaMigrationRunner)
+
 	// register event handlers
-	contentEventHandler := content.NewContentEventHandler(content.NewEventSerializer(), a.componentRegistry.components["ContentProjectionRepository"].(content.ContentProjectionRepository))
+	contentEventHandler := content.NewContentEventHandler(a.componentRegistry.components["ContentEventSerializer"].(eventsourcing.Serializer),
+		a.componentRegistry.components["ContentProjectionRepository"].(content.ContentProjectionRepository),
+		a.componentRegistry.components["ContentBranchProjectionRepository"].(content.ContentBranchProjectionRepository),
+		a.componentRegistry.components["ContentCommitProjectionRepository"].(content.ContentCommitProjectionRepository),
+		a.componentRegistry.components["PublishedContentProjectionRepository"].(content.PublishedContentProjectionRepository))
 	a.componentRegistry.Register("ContentEventHandler", contentEventHandler)
 
+	referenceEventHandler := content.NewReferenceEventHandler(a.componentRegistry.components["ContentAggregateStore"].(eventsourcing.AggregateStore),
+		a.componentRegistry.components["ContentTypeSchemaRepository"].(content.ContentTypeSchemaRepository),
+		a.componentRegistry.components["ContentReferenceProjectionRepository"].(content.ContentReferenceProjectionRepository))
+	a.componentRegistry.Register("ReferenceEventHandler", referenceEventHandler)
+
+	changeRequestEventHandler := changerequest.NewChangeRequestEventHandler(a.componentRegistry.components["ChangeRequestEventSerializer"].(eventsourcing.Serializer),
+		a.componentRegistry.components["ChangeRequestProjectionRepository"].(changerequest.ChangeRequestProjectionRepository))
+	a.componentRegistry.Register("ChangeRequestEventHandler", changeRequestEventHandler)
+
+	contentPurgeEventHandler := changerequest.NewContentPurgeEventHandler(a.componentRegistry.components["ContentEventSerializer"].(eventsourcing.Serializer),
+		a.componentRegistry.components["ChangeRequestAggregateStore"].(eventsourcing.AggregateStore),
+		a.componentRegistry.components["ChangeRequestProjectionRepository"].(changerequest.ChangeRequestProjectionRepository))
+	a.componentRegistry.Register("ContentPurgeEventHandler", contentPurgeEventHandler)
+
+	notificationEventHandler := notification.NewNotificationEventHandler(a.componentRegistry.components["ContentEventSerializer"].(eventsourcing.Serializer),
+		a.componentRegistry.components["ContentProjectionRepository"].(content.ContentProjectionRepository),
+		a.componentRegistry.components["NotificationProjectionRepository"].(notification.NotificationProjectionRepository))
+	a.componentRegistry.Register("NotificationEventHandler", notificationEventHandler)
+
+	assetEventHandler := asset.NewAssetEventHandler(a.componentRegistry.components["AssetEventSerializer"].(eventsourcing.Serializer),
+		a.componentRegistry.components["AssetProjectionRepository"].(asset.AssetProjectionRepository))
+	a.componentRegistry.Register("AssetEventHandler", assetEventHandler)
+
 	return nil
 }
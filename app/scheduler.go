@@ -0,0 +1,25 @@
+package app
+
+import (
+	"contentgit/foundation"
+	"contentgit/ports/in/scheduler"
+	"context"
+	"time"
+)
+
+// scheduleInterval is how often the due scheduled jobs and the schema migrations to run are looked for.
+const scheduleInterval = 10 * time.Second
+
+func (a *App) startSchedulers() {
+	contentScheduler := a.componentRegistry.Get("ContentScheduler").(*scheduler.ContentScheduler)
+	go func() {
+		schedulerCtx := foundation.ContextProvider().SetDB(context.TODO(), a.gormDB)
+		contentScheduler.Start(schedulerCtx, scheduleInterval)
+	}()
+
+	schemaMigrationRunner := a.componentRegistry.Get("SchemaMigrationRunner").(*scheduler.SchemaMigrationRunner)
+	go func() {
+		runnerCtx := foundation.ContextProvider().SetDB(context.TODO(), a.gormDB)
+		schemaMigrationRunner.Start(runnerCtx, scheduleInterval)
+	}()
+}
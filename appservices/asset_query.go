@@ -0,0 +1,54 @@
+package appservices
+
+import (
+	"contentgit/domain/asset"
+	"contentgit/domain/asset/projections"
+	"contentgit/dtos"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"io"
+)
+
+type AssetQuery struct {
+	assetProjectionRepository asset.AssetProjectionRepository
+	aggregateStore            eventsourcing.AggregateStore
+	storage                   asset.Storage
+}
+
+func NewAssetQuery(assetProjectionRepository asset.AssetProjectionRepository, aggregateStore eventsourcing.AggregateStore,
+	storage asset.Storage) *AssetQuery {
+	return &AssetQuery{assetProjectionRepository: assetProjectionRepository, aggregateStore: aggregateStore, storage: storage}
+}
+
+// GetAssets returns the assets of the tenant that are not deleted, the latest uploaded first.
+func (q AssetQuery) GetAssets(ctx context.Context, tenantId string, pageable dtos.Pageable) ([]projections.AssetProjection, int64, error) {
+	return q.assetProjectionRepository.FindAll(ctx, tenantId, pageable)
+}
+
+func (q AssetQuery) GetAsset(ctx context.Context, tenantId string, id string) (*projections.AssetProjection, error) {
+	return q.assetProjectionRepository.FindByID(ctx, tenantId, id)
+}
+
+// OpenAsset opens the bytes of an asset of the tenant that is not deleted. The aggregate is read rather than its
+// projection, so that an asset can be downloaded as soon as its upload returns.
+func (q AssetQuery) OpenAsset(ctx context.Context, tenantId string, id string) (*asset.AssetAggregate, io.ReadSeekCloser, error) {
+	assetAggregate, err := asset.NewAssetAggregate(id, tenantId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := q.aggregateStore.Load(ctx, assetAggregate); err != nil {
+		return nil, nil, err
+	}
+	if assetAggregate.GetVersion() == 0 || assetAggregate.GetTenantId() != tenantId {
+		return nil, nil, eventsourcing.ErrAggregateNotFound
+	}
+	if assetAggregate.Deleted {
+		return nil, nil, asset.ErrAssetDeleted
+	}
+
+	file, err := q.storage.Open(ctx, assetAggregate.Sha256)
+	if err != nil {
+		return nil, nil, err
+	}
+	return assetAggregate, file, nil
+}
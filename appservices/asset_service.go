@@ -0,0 +1,23 @@
+package appservices
+
+import (
+	"contentgit/domain/asset"
+	"contentgit/domain/asset/commands"
+	"contentgit/ports/out/persistance/eventsourcing"
+)
+
+type AssetService struct {
+	Commands *commands.AssetCommands
+}
+
+func NewAssetService(
+	aggregateStore eventsourcing.AggregateStore,
+	storage asset.Storage,
+) *AssetService {
+	assetCommands := commands.NewAssetCommands(
+		commands.NewUploadAssetCmdHandler(aggregateStore, storage),
+		commands.NewDeleteAssetCmdHandler(aggregateStore),
+	)
+
+	return &AssetService{Commands: assetCommands}
+}
@@ -0,0 +1,42 @@
+package appservices
+
+import (
+	"contentgit/domain/changerequest"
+	"contentgit/domain/changerequest/projections"
+	"contentgit/domain/content"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+)
+
+type ChangeRequestQuery struct {
+	changeRequestProjectionRepository changerequest.ChangeRequestProjectionRepository
+	contentProjectionRepository       content.ContentProjectionRepository
+}
+
+func NewChangeRequestQuery(changeRequestProjectionRepository changerequest.ChangeRequestProjectionRepository,
+	contentProjectionRepository content.ContentProjectionRepository) *ChangeRequestQuery {
+	return &ChangeRequestQuery{changeRequestProjectionRepository: changeRequestProjectionRepository,
+		contentProjectionRepository: contentProjectionRepository}
+}
+
+// GetChangeRequests returns the change requests of the content in state, all of them when state is empty.
+func (q ChangeRequestQuery) GetChangeRequests(ctx context.Context, tenantId string, contentId string, state string) ([]projections.ChangeRequestProjection, error) {
+	if _, err := q.contentProjectionRepository.FindByID(ctx, tenantId, contentId); err != nil {
+		return nil, err
+	}
+
+	return q.changeRequestProjectionRepository.FindAllByContentId(ctx, tenantId, contentId, state)
+}
+
+// GetChangeRequest returns the change request with its reviews and comments, as not found when it belongs to another content.
+func (q ChangeRequestQuery) GetChangeRequest(ctx context.Context, tenantId string, contentId string, id string) (*projections.ChangeRequestProjection, error) {
+	changeRequest, err := q.changeRequestProjectionRepository.FindByID(ctx, tenantId, id)
+	if err != nil {
+		return nil, err
+	}
+	if changeRequest.ContentId != contentId {
+		return nil, persistence.ErrRecordNotFound
+	}
+
+	return changeRequest, nil
+}
@@ -0,0 +1,28 @@
+package appservices
+
+import (
+	"contentgit/domain/changerequest/commands"
+	"contentgit/domain/content"
+	"contentgit/ports/out/persistance/eventsourcing"
+)
+
+type ChangeRequestService struct {
+	Commands *commands.ChangeRequestCommands
+}
+
+func NewChangeRequestService(
+	aggregateStore eventsourcing.AggregateStore,
+	contentAggregateStore eventsourcing.AggregateStore,
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository,
+	assetAggregateStore eventsourcing.AggregateStore,
+) *ChangeRequestService {
+	changeRequestCommands := commands.NewChangeRequestCommands(
+		commands.NewOpenChangeRequestCmdHandler(aggregateStore, contentAggregateStore),
+		commands.NewCommentChangeRequestCmdHandler(aggregateStore),
+		commands.NewReviewChangeRequestCmdHandler(aggregateStore),
+		commands.NewMergeChangeRequestCmdHandler(aggregateStore, contentAggregateStore, contentTypeSchemaRepository, assetAggregateStore),
+		commands.NewCloseChangeRequestCmdHandler(aggregateStore),
+	)
+
+	return &ChangeRequestService{Commands: changeRequestCommands}
+}
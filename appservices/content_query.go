@@ -4,25 +4,317 @@ import (
 	"contentgit/domain/content"
 	"contentgit/domain/content/projections"
 	"contentgit/dtos"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
 	"context"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
 type ContentQuery struct {
-	contentProjectionRepository content.ContentProjectionRepository
+	contentProjectionRepository       content.ContentProjectionRepository
+	contentBranchProjectionRepository content.ContentBranchProjectionRepository
+	contentCommitProjectionRepository content.ContentCommitProjectionRepository
+	contentTagRepository              content.ContentTagRepository
+	publishedContentRepository        content.PublishedContentProjectionRepository
+	contentScheduleRepository         content.ContentScheduleRepository
+	contentTypeSchemaRepository       content.ContentTypeSchemaRepository
+	schemaMigrationRepository         content.SchemaMigrationRepository
+	contentReferenceRepository        content.ContentReferenceProjectionRepository
+	localeFallbacks                   content.LocaleFallbacks
+	aggregateStore                    eventsourcing.AggregateStore
 }
 
-func NewContentQuery(contentProjectionRepository content.ContentProjectionRepository) *ContentQuery {
-	return &ContentQuery{contentProjectionRepository: contentProjectionRepository}
+func NewContentQuery(contentProjectionRepository content.ContentProjectionRepository,
+	contentBranchProjectionRepository content.ContentBranchProjectionRepository,
+	contentCommitProjectionRepository content.ContentCommitProjectionRepository,
+	contentTagRepository content.ContentTagRepository,
+	publishedContentRepository content.PublishedContentProjectionRepository,
+	contentScheduleRepository content.ContentScheduleRepository,
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository,
+	schemaMigrationRepository content.SchemaMigrationRepository,
+	contentReferenceRepository content.ContentReferenceProjectionRepository,
+	localeFallbacks content.LocaleFallbacks,
+	aggregateStore eventsourcing.AggregateStore) *ContentQuery {
+	return &ContentQuery{contentProjectionRepository: contentProjectionRepository,
+		contentBranchProjectionRepository: contentBranchProjectionRepository,
+		contentCommitProjectionRepository: contentCommitProjectionRepository,
+		contentTagRepository:              contentTagRepository,
+		publishedContentRepository:        publishedContentRepository,
+		contentScheduleRepository:         contentScheduleRepository,
+		contentTypeSchemaRepository:       contentTypeSchemaRepository,
+		schemaMigrationRepository:         schemaMigrationRepository,
+		contentReferenceRepository:        contentReferenceRepository,
+		localeFallbacks:                   localeFallbacks,
+		aggregateStore:                    aggregateStore}
 }
 
-func (q ContentQuery) GetContents(context context.Context, tenantId string, pageable dtos.Pageable, sortable *dtos.Sort) ([]projections.ContentProjection, int64, error) {
-	contents, totalCount, err := q.contentProjectionRepository.FindAll(context, tenantId, pageable, sortable)
+// GetContents returns the contents of the tenant matching the filter, contents in the trash are included only when the filter says so.
+func (q ContentQuery) GetContents(context context.Context, tenantId string, pageable dtos.Pageable, sortable *dtos.Sort, filter dtos.ContentFilter) ([]projections.ContentProjection, int64, error) {
+	contents, totalCount, err := q.contentProjectionRepository.FindAll(context, tenantId, pageable, sortable, filter)
 	if err != nil {
 		return nil, 0, err
 	}
 	return contents, totalCount, nil
 }
 
-func (q ContentQuery) GetContent(ctx context.Context, tenantId string, id string) (*projections.ContentProjection, error) {
+func (q ContentQuery) GetContent(ctx context.Context, tenantId string, id string, includeDeleted bool) (*projections.ContentProjection, error) {
+	if includeDeleted {
+		return q.contentProjectionRepository.FindByIDIncludingDeleted(ctx, tenantId, id)
+	}
 	return q.contentProjectionRepository.FindByID(ctx, tenantId, id)
 }
+
+// GetPublishedContents returns the published contents of the content type, the latest published first.
+func (q ContentQuery) GetPublishedContents(ctx context.Context, tenantId string, contentType string, pageable dtos.Pageable) ([]projections.PublishedContentProjection, int64, error) {
+	return q.publishedContentRepository.FindAll(ctx, tenantId, contentType, pageable)
+}
+
+// GetPublishedContent returns the published version of the content, whatever its draft looks like.
+func (q ContentQuery) GetPublishedContent(ctx context.Context, tenantId string, contentType string, id string) (*projections.PublishedContentProjection, error) {
+	return q.publishedContentRepository.FindByID(ctx, tenantId, contentType, id)
+}
+
+// GetTrash returns the deleted contents of the tenant, the latest deleted first.
+func (q ContentQuery) GetTrash(ctx context.Context, tenantId string, pageable dtos.Pageable) ([]projections.ContentProjection, int64, error) {
+	return q.contentProjectionRepository.FindAllDeleted(ctx, tenantId, pageable)
+}
+
+func (q ContentQuery) GetContentBranches(ctx context.Context, tenantId string, id string) ([]projections.ContentBranchProjection, error) {
+	return q.contentBranchProjectionRepository.FindAllByContentId(ctx, tenantId, id)
+}
+
+func (q ContentQuery) GetContentBranch(ctx context.Context, tenantId string, id string, branch string) (*projections.ContentBranchProjection, error) {
+	return q.contentBranchProjectionRepository.FindByID(ctx, tenantId, id, branch)
+}
+
+// GetContentCommits returns the commit log of a branch of the content, latest first.
+func (q ContentQuery) GetContentCommits(ctx context.Context, tenantId string, id string, branch string) ([]projections.ContentCommitProjection, error) {
+	if _, err := q.contentProjectionRepository.FindByID(ctx, tenantId, id); err != nil {
+		return nil, err
+	}
+
+	return q.contentCommitProjectionRepository.FindAllByContentId(ctx, tenantId, id, branch)
+}
+
+// GetMergePreview computes the merge of sourceBranch into targetBranch without applying it.
+func (q ContentQuery) GetMergePreview(ctx context.Context, tenantId string, id string, sourceBranch string, targetBranch string) (*content.MergeParticipants, content.MergeResult, error) {
+	participants, err := content.LoadMergeParticipants(ctx, q.aggregateStore, id, tenantId, sourceBranch, targetBranch)
+	if err != nil {
+		return nil, content.MergeResult{}, err
+	}
+
+	return participants, content.ThreeWayMerge(participants.Base.Content, participants.Source.Content, participants.Target.Content), nil
+}
+
+// GetContentAtVersion rebuilds the content as it was right after the given version.
+func (q ContentQuery) GetContentAtVersion(ctx context.Context, tenantId string, id string, version uint64) (*content.ContentAggregate, error) {
+	contentAggregate, err := q.newContentAggregate(ctx, tenantId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := q.aggregateStore.LoadAtVersion(ctx, contentAggregate, version); err != nil {
+		return nil, err
+	}
+
+	return contentAggregate, nil
+}
+
+// GetContentAsOf rebuilds the content as it was at the given time.
+func (q ContentQuery) GetContentAsOf(ctx context.Context, tenantId string, id string, asOf time.Time) (*content.ContentAggregate, error) {
+	contentAggregate, err := q.newContentAggregate(ctx, tenantId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := q.aggregateStore.LoadAsOf(ctx, contentAggregate, asOf); err != nil {
+		return nil, err
+	}
+
+	return contentAggregate, nil
+}
+
+// GetContentAtRef rebuilds the content at the version pinned by the tag ref.
+func (q ContentQuery) GetContentAtRef(ctx context.Context, tenantId string, id string, ref string) (*content.ContentAggregate, error) {
+	tag, err := q.contentTagRepository.FindByName(ctx, tenantId, id, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	contentAggregate, err := q.newContentAggregate(ctx, tenantId, id)
+	if err != nil {
+		return nil, err
+	}
+	contentAggregate.SetBranch(tag.Branch)
+
+	if err := q.aggregateStore.LoadAtVersion(ctx, contentAggregate, tag.Version); err != nil {
+		return nil, err
+	}
+
+	return contentAggregate, nil
+}
+
+func (q ContentQuery) GetContentTags(ctx context.Context, tenantId string, id string) ([]content.ContentTag, error) {
+	if _, err := q.contentProjectionRepository.FindByID(ctx, tenantId, id); err != nil {
+		return nil, err
+	}
+
+	return q.contentTagRepository.FindAllByContentId(ctx, tenantId, id)
+}
+
+// GetContentSchedules returns the scheduled jobs of the content, including those that ran or were canceled.
+func (q ContentQuery) GetContentSchedules(ctx context.Context, tenantId string, id string) ([]content.ContentSchedule, error) {
+	if _, err := q.contentProjectionRepository.FindByID(ctx, tenantId, id); err != nil {
+		return nil, err
+	}
+
+	return q.contentScheduleRepository.FindAllByContentId(ctx, tenantId, id)
+}
+
+func (q ContentQuery) GetTags(ctx context.Context, tenantId string, pageable dtos.Pageable) ([]content.ContentTag, int64, error) {
+	return q.contentTagRepository.FindAll(ctx, tenantId, pageable)
+}
+
+// GetContentBlame returns the origin of the current value of every field of the content on the branch.
+func (q ContentQuery) GetContentBlame(ctx context.Context, tenantId string, id string, branch string) ([]content.FieldBlame, error) {
+	if _, err := q.contentProjectionRepository.FindByID(ctx, tenantId, id); err != nil {
+		return nil, err
+	}
+
+	esEvents, err := q.aggregateStore.LoadEvents(ctx, id, branch)
+	if err != nil {
+		return nil, err
+	}
+	if len(esEvents) == 0 {
+		return nil, eventsourcing.ErrAggregateNotFound
+	}
+
+	return content.Blame(ctx, esEvents)
+}
+
+// GetContentDiff compares the content replayed at version from with the content replayed at version to,
+// to of zero means the latest version.
+func (q ContentQuery) GetContentDiff(ctx context.Context, tenantId string, id string, from uint64, to uint64) (*content.ContentAggregate, *content.ContentAggregate, content.ContentDiff, error) {
+	fromAggregate, err := q.GetContentAtVersion(ctx, tenantId, id, from)
+	if err != nil {
+		return nil, nil, content.ContentDiff{}, err
+	}
+
+	toAggregate, err := content.NewContentAggregate(id, tenantId)
+	if err != nil {
+		return nil, nil, content.ContentDiff{}, err
+	}
+	if to == 0 {
+		err = q.aggregateStore.Load(ctx, toAggregate)
+	} else {
+		err = q.aggregateStore.LoadAtVersion(ctx, toAggregate, to)
+	}
+	if err != nil {
+		return nil, nil, content.ContentDiff{}, err
+	}
+
+	return fromAggregate, toAggregate, content.Diff(fromAggregate.Content, toAggregate.Content), nil
+}
+
+// GetContentTypes returns the latest schema of every content type of the tenant.
+func (q ContentQuery) GetContentTypes(ctx context.Context, tenantId string) ([]content.ContentTypeSchema, error) {
+	return q.contentTypeSchemaRepository.FindAllLatest(ctx, tenantId)
+}
+
+// GetContentType returns the schema of the content type at version, its latest schema when version is zero.
+// A deleted content type is not found, its previous versions are.
+func (q ContentQuery) GetContentType(ctx context.Context, tenantId string, contentType string, version uint64) (*content.ContentTypeSchema, error) {
+	var schema *content.ContentTypeSchema
+	var err error
+	if version == 0 {
+		schema, err = q.contentTypeSchemaRepository.FindLatest(ctx, tenantId, contentType)
+	} else {
+		schema, err = q.contentTypeSchemaRepository.FindByVersion(ctx, tenantId, contentType, version)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if schema.Deleted {
+		return nil, persistence.ErrRecordNotFound
+	}
+
+	return schema, nil
+}
+
+// GetContentTypeVersions returns every version of the schema of the content type, the first one first.
+func (q ContentQuery) GetContentTypeVersions(ctx context.Context, tenantId string, contentType string) ([]content.ContentTypeSchema, error) {
+	schemas, err := q.contentTypeSchemaRepository.FindAllVersions(ctx, tenantId, contentType)
+	if err != nil {
+		return nil, err
+	}
+	if len(schemas) == 0 {
+		return nil, persistence.ErrRecordNotFound
+	}
+
+	return schemas, nil
+}
+
+// GetSchemaMigrations returns the schema migrations of the content type, the latest first.
+func (q ContentQuery) GetSchemaMigrations(ctx context.Context, tenantId string, contentType string) ([]content.SchemaMigration, error) {
+	return q.schemaMigrationRepository.FindAllByContentType(ctx, tenantId, contentType)
+}
+
+func (q ContentQuery) GetSchemaMigration(ctx context.Context, tenantId string, contentType string, id string) (*content.SchemaMigration, error) {
+	return q.schemaMigrationRepository.FindByID(ctx, tenantId, contentType, id)
+}
+
+// GetReferencedBy returns the references of other contents to a content that is not deleted.
+func (q ContentQuery) GetReferencedBy(ctx context.Context, tenantId string, id string) ([]projections.ContentReferenceProjection, error) {
+	if _, err := q.contentProjectionRepository.FindByID(ctx, tenantId, id); err != nil {
+		return nil, err
+	}
+	return q.contentReferenceRepository.FindAllByTargetId(ctx, tenantId, id)
+}
+
+// LocalizeContent returns a content of the content type with the localized fields in locale, each falling back through
+// the locales of the tenant, and the locale each value was taken from. A content type without schema has nothing to localize.
+func (q ContentQuery) LocalizeContent(ctx context.Context, tenantId string, contentType string, contentValue map[string]any,
+	locale string) (map[string]any, map[string]string, error) {
+	schema, err := q.findLocalizationSchema(ctx, tenantId, contentType)
+	if err != nil || schema == nil {
+		return contentValue, nil, err
+	}
+
+	localized, fieldLocales := schema.Localize(contentValue, q.localeFallbacks.Chain(tenantId, locale))
+	return localized, fieldLocales, nil
+}
+
+// GetLocaleCompleteness returns how far a content of the content type is translated to the locales of the tenant and
+// to the other locales it holds values for.
+func (q ContentQuery) GetLocaleCompleteness(ctx context.Context, tenantId string, contentType string,
+	contentValue map[string]any) ([]content.LocaleCompleteness, error) {
+	schema, err := q.findLocalizationSchema(ctx, tenantId, contentType)
+	if err != nil || schema == nil {
+		return nil, err
+	}
+
+	return schema.LocaleCompleteness(contentValue, q.localeFallbacks.Locales(tenantId)), nil
+}
+
+// findLocalizationSchema returns the latest schema of the content type, none when it has no schema or was deleted.
+func (q ContentQuery) findLocalizationSchema(ctx context.Context, tenantId string, contentType string) (*content.ContentTypeSchema, error) {
+	schema, err := q.contentTypeSchemaRepository.FindLatest(ctx, tenantId, contentType)
+	if errors.Is(err, persistence.ErrRecordNotFound) || (err == nil && schema.Deleted) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// newContentAggregate creates an empty aggregate of a content that belongs to the tenant.
+func (q ContentQuery) newContentAggregate(ctx context.Context, tenantId string, id string) (*content.ContentAggregate, error) {
+	if _, err := q.contentProjectionRepository.FindByID(ctx, tenantId, id); err != nil {
+		return nil, err
+	}
+
+	return content.NewContentAggregate(id, tenantId)
+}
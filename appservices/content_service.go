@@ -1,6 +1,7 @@
 package appservices
 
 import (
+	"contentgit/domain/content"
 	"contentgit/domain/content/commands"
 	"contentgit/ports/out/persistance/eventsourcing"
 )
@@ -11,11 +12,43 @@ type ContentService struct {
 
 func NewContentService(
 	aggregateStore eventsourcing.AggregateStore,
+	contentTagRepository content.ContentTagRepository,
+	contentKeyRepository content.ContentKeyRepository,
+	personalDataFields content.PersonalDataFields,
+	workflows content.Workflows,
+	contentScheduleRepository content.ContentScheduleRepository,
+	clock content.Clock,
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository,
+	schemaMigrationRepository content.SchemaMigrationRepository,
+	contentReferenceProjectionRepository content.ContentReferenceProjectionRepository,
+	assetAggregateStore eventsourcing.AggregateStore,
 ) *ContentService {
 	contentCommands := commands.NewContentCommands(
-		commands.NewCreateUserSessionCmdHandler(aggregateStore),
-		commands.NewUpdateContentFieldCmdHandler(aggregateStore),
+		commands.NewCreateUserSessionCmdHandler(aggregateStore, contentTypeSchemaRepository, assetAggregateStore),
+		commands.NewUpdateContentFieldCmdHandler(aggregateStore, contentTypeSchemaRepository, assetAggregateStore),
 		commands.NewAddContentFieldCommentCmdHandler(aggregateStore),
+		commands.NewCreateContentBranchCmdHandler(aggregateStore),
+		commands.NewMergeContentBranchCmdHandler(aggregateStore, contentTypeSchemaRepository, assetAggregateStore),
+		commands.NewRevertContentCmdHandler(aggregateStore),
+		commands.NewCommitContentCmdHandler(aggregateStore, contentTypeSchemaRepository, assetAggregateStore),
+		commands.NewCreateContentTagCmdHandler(aggregateStore, contentTagRepository),
+		commands.NewDeleteContentTagCmdHandler(contentTagRepository),
+		commands.NewAddContentFieldCmdHandler(aggregateStore, contentTypeSchemaRepository, assetAggregateStore),
+		commands.NewRemoveContentFieldCmdHandler(aggregateStore, contentTypeSchemaRepository, assetAggregateStore),
+		commands.NewDeleteContentCmdHandler(aggregateStore, contentReferenceProjectionRepository),
+		commands.NewRestoreContentCmdHandler(aggregateStore),
+		commands.NewPurgeContentCmdHandler(aggregateStore, contentKeyRepository, personalDataFields),
+		commands.NewChangeContentStatusCmdHandler(aggregateStore, workflows),
+		commands.NewScheduleContentCmdHandler(aggregateStore, contentScheduleRepository, workflows, clock),
+		commands.NewCancelContentScheduleCmdHandler(contentScheduleRepository),
+		commands.NewEditContentFieldCommentCmdHandler(aggregateStore),
+		commands.NewDeleteContentFieldCommentCmdHandler(aggregateStore),
+		commands.NewResolveContentFieldCommentCmdHandler(aggregateStore),
+		commands.NewCreateContentTypeCmdHandler(contentTypeSchemaRepository),
+		commands.NewUpdateContentTypeCmdHandler(contentTypeSchemaRepository),
+		commands.NewDeleteContentTypeCmdHandler(contentTypeSchemaRepository),
+		commands.NewStartSchemaMigrationCmdHandler(contentTypeSchemaRepository, schemaMigrationRepository),
+		commands.NewMigrateContentCmdHandler(aggregateStore),
 	)
 
 	return &ContentService{Commands: contentCommands}
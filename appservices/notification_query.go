@@ -0,0 +1,22 @@
+package appservices
+
+import (
+	"contentgit/domain/notification"
+	"contentgit/domain/notification/projections"
+	"contentgit/dtos"
+	"context"
+)
+
+type NotificationQuery struct {
+	notificationProjectionRepository notification.NotificationProjectionRepository
+}
+
+func NewNotificationQuery(notificationProjectionRepository notification.NotificationProjectionRepository) *NotificationQuery {
+	return &NotificationQuery{notificationProjectionRepository: notificationProjectionRepository}
+}
+
+// GetNotifications returns the inbox of the user, only the unread notifications when unreadOnly is set.
+func (q NotificationQuery) GetNotifications(ctx context.Context, tenantId string, userId string, unreadOnly bool,
+	pageable dtos.Pageable) ([]projections.NotificationProjection, int64, error) {
+	return q.notificationProjectionRepository.FindAllByUserId(ctx, tenantId, userId, unreadOnly, pageable)
+}
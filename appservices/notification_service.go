@@ -0,0 +1,35 @@
+package appservices
+
+import (
+	"contentgit/domain/content"
+	"contentgit/domain/notification"
+	"context"
+)
+
+// NotificationService keeps track of the notifications the users have read, the inbox itself is filled from the events.
+type NotificationService struct {
+	notificationProjectionRepository notification.NotificationProjectionRepository
+	clock                            content.Clock
+}
+
+func NewNotificationService(notificationProjectionRepository notification.NotificationProjectionRepository,
+	clock content.Clock) *NotificationService {
+	return &NotificationService{notificationProjectionRepository: notificationProjectionRepository, clock: clock}
+}
+
+func (s NotificationService) MarkAsRead(ctx context.Context, tenantId string, userId string, id uint) error {
+	notificationProjection, err := s.notificationProjectionRepository.FindByID(ctx, tenantId, userId, id)
+	if err != nil {
+		return err
+	}
+	if notificationProjection.IsRead() {
+		return nil
+	}
+
+	notificationProjection.MarkAsRead(s.clock.Now())
+	return s.notificationProjectionRepository.Save(ctx, notificationProjection)
+}
+
+func (s NotificationService) MarkAllAsRead(ctx context.Context, tenantId string, userId string) error {
+	return s.notificationProjectionRepository.MarkAllAsRead(ctx, tenantId, userId, s.clock.Now())
+}
@@ -17,6 +17,18 @@ var Config = struct {
 		UserName     string
 		Password     string
 	}
+	// PersonalDataFields are the fields of each content type that are stored encrypted so that a purge can erase them.
+	PersonalDataFields map[string][]string
+	// Workflows are the statuses each editorial transition may start from, by content type.
+	// Content types without a workflow follow the default draft, review, approve and publish workflow.
+	Workflows map[string]map[string][]string
+	// Locales are the locales of each tenant in the order reads of localized fields fall back through them,
+	// the first one is the default locale of the tenant.
+	Locales map[string][]string
+	// AssetStorage is where the bytes of the assets are kept, Path is the root directory of the local storage.
+	AssetStorage struct {
+		Path string
+	}
 }{}
 
 func InitConfig(path string) error {
@@ -0,0 +1,108 @@
+package asset
+
+import (
+	"contentgit/domain/asset/events"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	AssetAggregateType eventsourcing.AggregateType = "asset"
+)
+
+// AssetAggregate is a file attached to contents, images or PDFs. Its bytes are kept by the storage, the aggregate
+// records what they are and who uploaded them.
+type AssetAggregate struct {
+	*eventsourcing.AggregateBase
+	FileName    string `json:"fileName"`
+	MediaType   string `json:"mediaType"`
+	Size        int64  `json:"size"`
+	Sha256      string `json:"sha256"`
+	Deleted     bool   `json:"deleted,omitempty"`
+	CreatedById string `json:"createdById"`
+}
+
+func NewAssetAggregate(id string, tenantId string) (*AssetAggregate, error) {
+	if id == "" || tenantId == "" {
+		return nil, errors.New("id and tenantId are required.")
+	}
+
+	assetAggregate := &AssetAggregate{}
+
+	aggregateBase := eventsourcing.NewAggregateBase(assetAggregate.When)
+	aggregateBase.SetType(AssetAggregateType)
+	aggregateBase.SetID(id)
+	aggregateBase.SetTenantId(tenantId)
+	assetAggregate.AggregateBase = aggregateBase
+
+	return assetAggregate, nil
+}
+
+// Upload records the stored blob as the bytes of the new asset fileName.
+func (a *AssetAggregate) Upload(ctx context.Context, blob Blob, fileName string, mediaType string, createdById string, createdByName string) error {
+	if a.GetVersion() > 0 {
+		return errors.Wrapf(ErrAssetAlreadyExists, "id: %s", a.GetID())
+	}
+	if strings.TrimSpace(fileName) == "" || strings.ContainsAny(fileName, `/\`) {
+		return errors.Wrapf(ErrInvalidAsset, "fileName: %s", fileName)
+	}
+	if mediaType == "" {
+		return errors.Wrap(ErrInvalidAsset, "mediaType is required")
+	}
+	if !IsSha256(blob.Sha256) || blob.Size < 0 {
+		return errors.Wrapf(ErrInvalidAsset, "sha256: %s, size: %d", blob.Sha256, blob.Size)
+	}
+
+	event := &events.AssetUploadedEventV1{
+		FileName:      fileName,
+		MediaType:     mediaType,
+		Size:          blob.Size,
+		Sha256:        blob.Sha256,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// Delete deletes the asset, the contents referencing it keep their references.
+func (a *AssetAggregate) Delete(ctx context.Context, createdById string, createdByName string) error {
+	if a.Deleted {
+		return ErrAssetDeleted
+	}
+
+	event := &events.AssetDeletedEventV1{
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+func (a *AssetAggregate) When(event any) error {
+	switch evt := event.(type) {
+	case *events.AssetUploadedEventV1:
+		return a.handleAssetUploadedEvent(evt)
+	case *events.AssetDeletedEventV1:
+		return a.handleAssetDeletedEvent(evt)
+	default:
+		return errors.Wrapf(ErrUnknownEventType, "type: %T", event)
+	}
+}
+
+func (a *AssetAggregate) handleAssetUploadedEvent(evt *events.AssetUploadedEventV1) error {
+	a.FileName = evt.FileName
+	a.MediaType = evt.MediaType
+	a.Size = evt.Size
+	a.Sha256 = evt.Sha256
+	a.CreatedById = evt.CreatedById
+	return nil
+}
+
+func (a *AssetAggregate) handleAssetDeletedEvent(evt *events.AssetDeletedEventV1) error {
+	a.Deleted = true
+	return nil
+}
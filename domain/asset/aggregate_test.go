@@ -0,0 +1,69 @@
+package asset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var helloBlob = Blob{Sha256: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", Size: 5}
+
+func TestAssetAggregate_Upload(t *testing.T) {
+	t.Run("저장된 바이트를 에셋으로 업로드한다", func(t *testing.T) {
+		// given
+		sut, _ := NewAssetAggregate(uuid.New().String(), "lumen")
+
+		// when
+		err := sut.Upload(context.Background(), helloBlob, "hello.txt", "text/plain", "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "hello.txt", sut.FileName)
+		assert.Equal(t, "text/plain", sut.MediaType)
+		assert.Equal(t, int64(5), sut.Size)
+		assert.Equal(t, helloBlob.Sha256, sut.Sha256)
+		assert.Equal(t, uint64(1), sut.GetVersion())
+	})
+
+	t.Run("파일 이름이나 미디어 타입이 잘못되면 ErrInvalidAsset을 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewAssetAggregate(uuid.New().String(), "lumen")
+
+		// then
+		assert.ErrorIs(t, sut.Upload(context.Background(), helloBlob, "", "text/plain", "testerId", "testerName"), ErrInvalidAsset)
+		assert.ErrorIs(t, sut.Upload(context.Background(), helloBlob, "../hello.txt", "text/plain", "testerId", "testerName"), ErrInvalidAsset)
+		assert.ErrorIs(t, sut.Upload(context.Background(), helloBlob, "hello.txt", "", "testerId", "testerName"), ErrInvalidAsset)
+		assert.ErrorIs(t, sut.Upload(context.Background(), Blob{Sha256: "hello"}, "hello.txt", "text/plain", "testerId", "testerName"), ErrInvalidAsset)
+	})
+
+	t.Run("이미 업로드된 에셋이면 ErrAssetAlreadyExists를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewAssetAggregate(uuid.New().String(), "lumen")
+		require.NoError(t, sut.Upload(context.Background(), helloBlob, "hello.txt", "text/plain", "testerId", "testerName"))
+
+		// when
+		err := sut.Upload(context.Background(), helloBlob, "hello.txt", "text/plain", "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrAssetAlreadyExists)
+	})
+}
+
+func TestAssetAggregate_Delete(t *testing.T) {
+	t.Run("삭제된 에셋을 다시 삭제하면 ErrAssetDeleted를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewAssetAggregate(uuid.New().String(), "lumen")
+		require.NoError(t, sut.Upload(context.Background(), helloBlob, "hello.txt", "text/plain", "testerId", "testerName"))
+		require.NoError(t, sut.Delete(context.Background(), "testerId", "testerName"))
+
+		// when
+		err := sut.Delete(context.Background(), "testerId", "testerName")
+
+		// then
+		assert.True(t, sut.Deleted)
+		assert.ErrorIs(t, err, ErrAssetDeleted)
+	})
+}
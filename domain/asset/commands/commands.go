@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"contentgit/domain/asset"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+// maxConcurrencyRetries is how many times a command is reloaded and re-applied when another writer saved the same aggregate first.
+const maxConcurrencyRetries = 3
+
+type AssetCommands struct {
+	UploadAsset
+	DeleteAsset
+}
+
+func NewAssetCommands(
+	uploadAsset UploadAsset,
+	deleteAsset DeleteAsset,
+) *AssetCommands {
+	return &AssetCommands{
+		UploadAsset: uploadAsset,
+		DeleteAsset: deleteAsset,
+	}
+}
+
+// loadAsset loads an asset that was uploaded by the tenant, as not found when it belongs to another tenant.
+func loadAsset(ctx context.Context, aggregateStore eventsourcing.AggregateStore, id string, tenantId string) (*asset.AssetAggregate, error) {
+	assetAggregate, err := asset.NewAssetAggregate(id, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := aggregateStore.Load(ctx, assetAggregate); err != nil {
+		return nil, err
+	}
+	if assetAggregate.GetVersion() == 0 || assetAggregate.GetTenantId() != tenantId {
+		return nil, eventsourcing.ErrAggregateNotFound
+	}
+
+	return assetAggregate, nil
+}
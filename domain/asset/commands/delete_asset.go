@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type DeleteAsset interface {
+	Handle(ctx context.Context, cmd DeleteAssetCommand) error
+}
+
+type DeleteAssetCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type deleteAssetCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+}
+
+func (c *deleteAssetCmdHandler) Handle(ctx context.Context, cmd DeleteAssetCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		assetAggregate, err := loadAsset(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId)
+		if err != nil {
+			return err
+		}
+		expectedVersion := assetAggregate.GetVersion()
+
+		if err := assetAggregate.Delete(ctx, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, assetAggregate, expectedVersion)
+	})
+}
+
+func NewDeleteAssetCmdHandler(aggregateStore eventsourcing.AggregateStore) *deleteAssetCmdHandler {
+	return &deleteAssetCmdHandler{aggregateStore: aggregateStore}
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"contentgit/domain/asset"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"io"
+)
+
+type UploadAsset interface {
+	Handle(ctx context.Context, cmd UploadAssetCommand) error
+}
+
+// UploadAssetCommand stores the bytes read from File as the new asset FileName.
+type UploadAssetCommand struct {
+	AggregateID   string    `json:"id"`
+	TenantId      string    `json:"tenantId"`
+	FileName      string    `json:"fileName"`
+	MediaType     string    `json:"mediaType"`
+	File          io.Reader `json:"-"`
+	CreatedById   string    `json:"createdById"`
+	CreatedByName string    `json:"createdByName"`
+}
+
+type uploadAssetCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+	storage        asset.Storage
+}
+
+// Handle stores the bytes before the asset is saved, bytes left behind by an asset that failed to save are stored
+// once like any other and shared with the next upload of the same file.
+func (c *uploadAssetCmdHandler) Handle(ctx context.Context, cmd UploadAssetCommand) error {
+	assetAggregate, err := asset.NewAssetAggregate(cmd.AggregateID, cmd.TenantId)
+	if err != nil {
+		return err
+	}
+
+	blob, err := c.storage.Store(ctx, cmd.File)
+	if err != nil {
+		return err
+	}
+
+	if err := assetAggregate.Upload(ctx, blob, cmd.FileName, cmd.MediaType, cmd.CreatedById, cmd.CreatedByName); err != nil {
+		return err
+	}
+
+	return c.aggregateStore.Save(ctx, assetAggregate, 0)
+}
+
+func NewUploadAssetCmdHandler(aggregateStore eventsourcing.AggregateStore, storage asset.Storage) *uploadAssetCmdHandler {
+	return &uploadAssetCmdHandler{aggregateStore: aggregateStore, storage: storage}
+}
@@ -0,0 +1,11 @@
+package asset
+
+import "github.com/pkg/errors"
+
+var (
+	ErrInvalidAsset       = errors.New("invalid asset")
+	ErrAssetAlreadyExists = errors.New("asset already exists")
+	ErrAssetDeleted       = errors.New("asset is deleted")
+	ErrBlobNotFound       = errors.New("not found asset blob")
+	ErrUnknownEventType   = errors.New("unknown event type")
+)
@@ -0,0 +1,77 @@
+package asset
+
+import (
+	"contentgit/domain/asset/events"
+	"contentgit/domain/asset/projections"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+type AssetEventHandler struct {
+	serializer                eventsourcing.Serializer
+	assetProjectionRepository AssetProjectionRepository
+}
+
+func NewAssetEventHandler(serializer eventsourcing.Serializer, assetProjectionRepository AssetProjectionRepository) *AssetEventHandler {
+	return &AssetEventHandler{serializer: serializer, assetProjectionRepository: assetProjectionRepository}
+}
+
+func (c *AssetEventHandler) Handle(ctx context.Context, esEvent eventsourcing.Event) error {
+	deserializedEvent, err := c.serializer.DeserializeEvent(ctx, esEvent)
+	if err != nil {
+		return errors.Wrapf(err, "serializer.DeserializeEvent aggregateID: %s, type: %s", esEvent.GetAggregateID(), esEvent.GetEventType())
+	}
+
+	switch event := deserializedEvent.(type) {
+	case *events.AssetUploadedEventV1:
+		return c.onAssetUploaded(ctx, esEvent, event)
+	case *events.AssetDeletedEventV1:
+		return c.onAssetDeleted(ctx, esEvent, event)
+	default:
+		return errors.New(fmt.Sprintf("unknown event type: %s", esEvent.GetEventType()))
+	}
+}
+
+func (c *AssetEventHandler) GetAggregateType() eventsourcing.AggregateType {
+	return AssetAggregateType
+}
+
+func (c *AssetEventHandler) onAssetUploaded(ctx context.Context, esEvent eventsourcing.Event, event *events.AssetUploadedEventV1) error {
+	if esEvent.GetVersion() != 1 {
+		return errors.Wrapf(eventsourcing.ErrInvalidEventVersion, "type: %s, version: %d", esEvent.GetEventType(), esEvent.GetVersion())
+	}
+
+	assetProjection := projections.NewAssetProjection(
+		esEvent.AggregateID,
+		esEvent.TenantId,
+		event.FileName,
+		event.MediaType,
+		event.Size,
+		event.Sha256,
+		event.CreatedById,
+		event.CreatedByName,
+		esEvent.GetCreatedAt(),
+		uint(esEvent.Version),
+	)
+
+	if err := c.assetProjectionRepository.Create(ctx, assetProjection); err != nil {
+		return errors.Wrap(err, "failed to create asset projection")
+	}
+	return nil
+}
+
+func (c *AssetEventHandler) onAssetDeleted(ctx context.Context, esEvent eventsourcing.Event, event *events.AssetDeletedEventV1) error {
+	assetProjection, err := c.assetProjectionRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find asset projection")
+	}
+
+	deletedAt := esEvent.GetCreatedAt()
+	assetProjection.DeletedAt = &deletedAt
+	assetProjection.Version = uint(esEvent.Version)
+
+	return c.assetProjectionRepository.Save(ctx, assetProjection)
+}
@@ -0,0 +1,14 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	AssetDeletedEventType eventsourcing.EventType = "ASSET_DELETED_V1"
+)
+
+// AssetDeletedEventV1 deletes an asset, its bytes are kept for the other assets holding them.
+type AssetDeletedEventV1 struct {
+	CreatedById   string  `json:"createdById"`
+	CreatedByName string  `json:"createdByName"`
+	Metadata      *string `json:"-"`
+}
@@ -0,0 +1,19 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	AssetUploadedEventType eventsourcing.EventType = "ASSET_UPLOADED_V1"
+)
+
+// AssetUploadedEventV1 records a file uploaded as an asset, its bytes are stored once by their SHA-256 for all the
+// assets holding the same bytes.
+type AssetUploadedEventV1 struct {
+	FileName      string  `json:"fileName"`
+	MediaType     string  `json:"mediaType"`
+	Size          int64   `json:"size"`
+	Sha256        string  `json:"sha256"`
+	CreatedById   string  `json:"createdById"`
+	CreatedByName string  `json:"createdByName"`
+	Metadata      *string `json:"-"`
+}
@@ -0,0 +1,38 @@
+package projections
+
+import "time"
+
+// AssetProjection is the metadata of an asset, Sha256 addresses its bytes in the storage.
+type AssetProjection struct {
+	Id            string `gorm:"type:varchar(100);primaryKey"`
+	TenantId      string `gorm:"type:varchar(100);not null;index:idx_assets_tenant"`
+	FileName      string `gorm:"type:varchar(255);not null"`
+	MediaType     string `gorm:"type:varchar(255);not null"`
+	Size          int64
+	Sha256        string `gorm:"type:char(64);not null;index"`
+	Version       uint
+	CreatedById   string
+	CreatedByName string
+	CreatedAt     time.Time `gorm:"index:idx_assets_tenant"`
+	DeletedAt     *time.Time
+}
+
+func NewAssetProjection(id string, tenantId string, fileName string, mediaType string, size int64, sha256 string,
+	createdById string, createdByName string, createdAt time.Time, version uint) AssetProjection {
+	return AssetProjection{
+		Id:            id,
+		TenantId:      tenantId,
+		FileName:      fileName,
+		MediaType:     mediaType,
+		Size:          size,
+		Sha256:        sha256,
+		Version:       version,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+		CreatedAt:     createdAt,
+	}
+}
+
+func (*AssetProjection) TableName() string {
+	return "assets"
+}
@@ -0,0 +1,15 @@
+package asset
+
+import (
+	"contentgit/domain/asset/projections"
+	"contentgit/dtos"
+	"context"
+)
+
+// AssetProjectionRepository stores the metadata of the assets of the tenants.
+type AssetProjectionRepository interface {
+	Create(ctx context.Context, projection projections.AssetProjection) error
+	FindByID(ctx context.Context, tenantId string, id string) (*projections.AssetProjection, error)
+	FindAll(ctx context.Context, tenantId string, pageable dtos.Pageable) ([]projections.AssetProjection, int64, error)
+	Save(ctx context.Context, projection *projections.AssetProjection) error
+}
@@ -0,0 +1,55 @@
+package asset
+
+import (
+	"contentgit/domain/asset/events"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"contentgit/ports/out/persistance/eventsourcing/serializer"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidEvent = errors.New("invalid event")
+)
+
+type eventSerializer struct {
+}
+
+func NewEventSerializer() *eventSerializer {
+	return &eventSerializer{}
+}
+
+func (s *eventSerializer) SerializeEvent(ctx context.Context, aggregate eventsourcing.Aggregate, event any) (eventsourcing.Event, error) {
+	eventJson, err := serializer.Marshal(event)
+	if err != nil {
+		return eventsourcing.Event{}, errors.Wrapf(err, "serializer.Marshal aggregateID: %s", aggregate.GetID())
+	}
+
+	switch evt := event.(type) {
+	case *events.AssetUploadedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.AssetUploadedEventType, eventJson, evt.Metadata), nil
+	case *events.AssetDeletedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.AssetDeletedEventType, eventJson, evt.Metadata), nil
+	default:
+		return eventsourcing.Event{}, errors.Wrapf(ErrInvalidEvent, "aggregateID: %s, type: %T", aggregate.GetID(), event)
+	}
+}
+
+func (s *eventSerializer) DeserializeEvent(ctx context.Context, event eventsourcing.Event) (any, error) {
+	switch event.GetEventType() {
+	case events.AssetUploadedEventType:
+		return deserializeEvent(event, new(events.AssetUploadedEventV1))
+	case events.AssetDeletedEventType:
+		return deserializeEvent(event, new(events.AssetDeletedEventV1))
+	default:
+		return nil, errors.Wrapf(ErrInvalidEvent, "type: %s", event.GetEventType())
+	}
+}
+
+func deserializeEvent(event eventsourcing.Event, targetEvent any) (any, error) {
+	if err := event.GetJsonData(&targetEvent); err != nil {
+		return nil, errors.Wrapf(err, "event.GetJsonData type: %s", event.GetEventType())
+	}
+	return targetEvent, nil
+}
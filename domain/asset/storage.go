@@ -0,0 +1,29 @@
+package asset
+
+import (
+	"context"
+	"io"
+	"regexp"
+)
+
+// sha256Pattern matches the lowercase hex SHA-256 addressing a blob.
+var sha256Pattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// Blob is the bytes of a file as the storage keeps them, once for all the assets holding the same bytes.
+type Blob struct {
+	Sha256 string
+	Size   int64
+}
+
+// Storage keeps the bytes of the assets addressed by their SHA-256.
+type Storage interface {
+	// Store writes the bytes read from r, unless the same bytes are stored already, and returns their blob.
+	Store(ctx context.Context, r io.Reader) (Blob, error)
+	// Open opens the blob with the SHA-256 for reading, ErrBlobNotFound when it is not stored.
+	Open(ctx context.Context, sha256 string) (io.ReadSeekCloser, error)
+}
+
+// IsSha256 tells whether sha256 is the lowercase hex SHA-256 a blob is addressed by.
+func IsSha256(sha256 string) bool {
+	return sha256Pattern.MatchString(sha256)
+}
@@ -0,0 +1,270 @@
+package changerequest
+
+import (
+	"contentgit/domain/changerequest/events"
+	"contentgit/domain/content/jsonpointer"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"slices"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ChangeRequestAggregateType eventsourcing.AggregateType = "change_request"
+)
+
+// State is where a change request is in its review, only open change requests are reviewed, merged or closed.
+type State string
+
+const (
+	StateOpen   State = "open"
+	StateMerged State = "merged"
+	StateClosed State = "closed"
+)
+
+// Decision is what a reviewer decided about the changes.
+type Decision string
+
+const (
+	DecisionApprove Decision = "approve"
+	DecisionReject  Decision = "reject"
+)
+
+func ParseDecision(decision string) (Decision, error) {
+	switch d := Decision(decision); d {
+	case DecisionApprove, DecisionReject:
+		return d, nil
+	default:
+		return "", errors.Wrapf(ErrInvalidDecision, "decision: %s", decision)
+	}
+}
+
+// ChangeRequestAggregate is a set of field changes proposed for a content, they are applied to the content
+// as one commit once the reviewers approved them.
+type ChangeRequestAggregate struct {
+	*eventsourcing.AggregateBase
+	ContentId   string                `json:"contentId"`
+	ContentType string                `json:"contentType"`
+	Title       string                `json:"title"`
+	Changes     []events.ChangedField `json:"changes"`
+	Reviewers   []events.Reviewer     `json:"reviewers"`
+	// Decisions are the latest decision of each reviewer, by reviewer id.
+	Decisions      map[string]Decision `json:"decisions"`
+	State          State               `json:"state"`
+	CreatedById    string              `json:"createdById"`
+	CommitId       string              `json:"commitId,omitempty"`
+	ContentVersion uint64              `json:"contentVersion,omitempty"`
+}
+
+func NewChangeRequestAggregate(id string, tenantId string) (*ChangeRequestAggregate, error) {
+	if id == "" || tenantId == "" {
+		return nil, errors.New("id and tenantId are required.")
+	}
+
+	changeRequestAggregate := &ChangeRequestAggregate{
+		Changes:   []events.ChangedField{},
+		Reviewers: []events.Reviewer{},
+		Decisions: make(map[string]Decision),
+	}
+
+	aggregateBase := eventsourcing.NewAggregateBase(changeRequestAggregate.When)
+	aggregateBase.SetType(ChangeRequestAggregateType)
+	aggregateBase.SetID(id)
+	aggregateBase.SetTenantId(tenantId)
+	changeRequestAggregate.AggregateBase = aggregateBase
+
+	return changeRequestAggregate, nil
+}
+
+// Open proposes the changes to the content. Each field is changed once and reviewed by someone other than its author.
+func (a *ChangeRequestAggregate) Open(ctx context.Context, contentId string, contentType string, title string, description string,
+	changes []events.ChangedField, reviewers []events.Reviewer, createdById string, createdByName string) error {
+	if title == "" {
+		return errors.Wrap(ErrInvalidChangeRequest, "title is required")
+	}
+	if len(changes) == 0 {
+		return errors.Wrap(ErrInvalidChangeRequest, "changes are required")
+	}
+	if len(reviewers) == 0 {
+		return errors.Wrap(ErrInvalidChangeRequest, "reviewers are required")
+	}
+
+	changedFields := make([]events.ChangedField, 0, len(changes))
+	fieldNames := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		path, err := jsonpointer.Parse(change.FieldName)
+		if err != nil || len(path) == 0 {
+			return errors.Wrapf(ErrInvalidChangeRequest, "fieldName: %s", change.FieldName)
+		}
+		if fieldNames[path.String()] {
+			return errors.Wrapf(ErrInvalidChangeRequest, "fieldName: %s is changed more than once", change.FieldName)
+		}
+		fieldNames[path.String()] = true
+
+		change.FieldName = path.String()
+		changedFields = append(changedFields, change)
+	}
+
+	reviewerIds := make(map[string]bool, len(reviewers))
+	for _, reviewer := range reviewers {
+		if reviewer.Id == "" || reviewer.Id == createdById || reviewerIds[reviewer.Id] {
+			return errors.Wrapf(ErrInvalidChangeRequest, "reviewer: %s", reviewer.Id)
+		}
+		reviewerIds[reviewer.Id] = true
+	}
+
+	event := &events.ChangeRequestOpenedEventV1{
+		ContentId:     contentId,
+		ContentType:   contentType,
+		Title:         title,
+		Description:   description,
+		Changes:       changedFields,
+		Reviewers:     reviewers,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// Comment adds a comment to the change request, or to one of its changes. Comments are kept after it is merged or closed.
+func (a *ChangeRequestAggregate) Comment(ctx context.Context, fieldName string, comment string, createdById string, createdByName string) error {
+	if comment == "" {
+		return ErrCommentRequired
+	}
+	if fieldName != "" {
+		path, err := jsonpointer.Parse(fieldName)
+		if err != nil || len(path) == 0 {
+			return errors.Wrapf(ErrInvalidChangeRequest, "fieldName: %s", fieldName)
+		}
+		fieldName = path.String()
+	}
+
+	event := &events.ChangeRequestCommentedEventV1{
+		FieldName:     fieldName,
+		Comment:       comment,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// Review records the decision of one of the reviewers, a rejection must say why.
+func (a *ChangeRequestAggregate) Review(ctx context.Context, decision Decision, comment string, reviewerId string, reviewerName string) error {
+	if a.State != StateOpen {
+		return errors.Wrapf(ErrChangeRequestNotOpen, "state: %s", a.State)
+	}
+	if !a.IsReviewer(reviewerId) {
+		return errors.Wrapf(ErrNotReviewer, "reviewer: %s", reviewerId)
+	}
+	if decision == DecisionReject && comment == "" {
+		return ErrCommentRequired
+	}
+
+	event := &events.ChangeRequestReviewedEventV1{
+		Decision:     string(decision),
+		Comment:      comment,
+		ReviewerId:   reviewerId,
+		ReviewerName: reviewerName,
+	}
+
+	return a.Apply(event)
+}
+
+// Merge records that the changes were applied to the content as the commit commitId, which is contentVersion of the content.
+// A change request is merged once approved by a reviewer and rejected by none.
+func (a *ChangeRequestAggregate) Merge(ctx context.Context, commitId string, contentVersion uint64, createdById string, createdByName string) error {
+	if a.State != StateOpen {
+		return errors.Wrapf(ErrChangeRequestNotOpen, "state: %s", a.State)
+	}
+	if !a.IsApproved() {
+		return ErrNotApproved
+	}
+
+	event := &events.ChangeRequestMergedEventV1{
+		CommitId:       commitId,
+		ContentVersion: contentVersion,
+		CreatedById:    createdById,
+		CreatedByName:  createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+func (a *ChangeRequestAggregate) Close(ctx context.Context, createdById string, createdByName string) error {
+	if a.State != StateOpen {
+		return errors.Wrapf(ErrChangeRequestNotOpen, "state: %s", a.State)
+	}
+
+	event := &events.ChangeRequestClosedEventV1{
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+func (a *ChangeRequestAggregate) IsReviewer(reviewerId string) bool {
+	return slices.ContainsFunc(a.Reviewers, func(reviewer events.Reviewer) bool {
+		return reviewer.Id == reviewerId
+	})
+}
+
+// IsApproved reports whether a reviewer approved the changes and no reviewer rejected them.
+func (a *ChangeRequestAggregate) IsApproved() bool {
+	approved := false
+	for _, decision := range a.Decisions {
+		if decision == DecisionReject {
+			return false
+		}
+		approved = approved || decision == DecisionApprove
+	}
+	return approved
+}
+
+func (a *ChangeRequestAggregate) When(event any) error {
+	switch evt := event.(type) {
+	case *events.ChangeRequestOpenedEventV1:
+		return a.handleChangeRequestOpenedEvent(evt)
+	case *events.ChangeRequestCommentedEventV1:
+		return nil
+	case *events.ChangeRequestReviewedEventV1:
+		return a.handleChangeRequestReviewedEvent(evt)
+	case *events.ChangeRequestMergedEventV1:
+		return a.handleChangeRequestMergedEvent(evt)
+	case *events.ChangeRequestClosedEventV1:
+		return a.handleChangeRequestClosedEvent(evt)
+	default:
+		return errors.Wrapf(ErrUnknownEventType, "type: %T", event)
+	}
+}
+
+func (a *ChangeRequestAggregate) handleChangeRequestOpenedEvent(evt *events.ChangeRequestOpenedEventV1) error {
+	a.ContentId = evt.ContentId
+	a.ContentType = evt.ContentType
+	a.Title = evt.Title
+	a.Changes = evt.Changes
+	a.Reviewers = evt.Reviewers
+	a.CreatedById = evt.CreatedById
+	a.State = StateOpen
+	return nil
+}
+
+func (a *ChangeRequestAggregate) handleChangeRequestReviewedEvent(evt *events.ChangeRequestReviewedEventV1) error {
+	a.Decisions[evt.ReviewerId] = Decision(evt.Decision)
+	return nil
+}
+
+func (a *ChangeRequestAggregate) handleChangeRequestMergedEvent(evt *events.ChangeRequestMergedEventV1) error {
+	a.State = StateMerged
+	a.CommitId = evt.CommitId
+	a.ContentVersion = evt.ContentVersion
+	return nil
+}
+
+func (a *ChangeRequestAggregate) handleChangeRequestClosedEvent(evt *events.ChangeRequestClosedEventV1) error {
+	a.State = StateClosed
+	return nil
+}
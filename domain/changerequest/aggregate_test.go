@@ -0,0 +1,192 @@
+package changerequest
+
+import (
+	"contentgit/domain/changerequest/events"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func openChangeRequest(t *testing.T) *ChangeRequestAggregate {
+	sut, _ := NewChangeRequestAggregate(uuid.New().String(), "mellow")
+	err := sut.Open(context.Background(), uuid.New().String(), "dresses", "가격 인하", "",
+		[]events.ChangedField{{FieldName: "price", BeforeValue: float64(35000), AfterValue: float64(29000)}},
+		[]events.Reviewer{{Id: "2", Name: "이수민"}, {Id: "3", Name: "박지훈"}}, "1", "김영희")
+	assert.NoError(t, err)
+	return sut
+}
+
+func TestNewChangeRequestAggregate(t *testing.T) {
+	t.Run("id가 없으면 error를 반환한다", func(t *testing.T) {
+		// when
+		_, err := NewChangeRequestAggregate("", "mellow")
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("필수 값이 있으면 aggregate를 반환한다", func(t *testing.T) {
+		// given
+		aggregateId := uuid.New().String()
+
+		// when
+		aggregate, err := NewChangeRequestAggregate(aggregateId, "mellow")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, ChangeRequestAggregateType, aggregate.GetType())
+		assert.Equal(t, aggregateId, aggregate.GetID())
+		assert.Equal(t, "mellow", aggregate.GetTenantId())
+	})
+}
+
+func TestChangeRequestAggregate_Open(t *testing.T) {
+	t.Run("변경 요청을 열면 필드 경로를 정규화하고 open 상태가 된다", func(t *testing.T) {
+		// given
+		sut, _ := NewChangeRequestAggregate(uuid.New().String(), "mellow")
+
+		// when
+		err := sut.Open(context.Background(), "content-1", "dresses", "색상 추가", "",
+			[]events.ChangedField{{FieldName: "/color", BeforeValue: nil, AfterValue: "ivory"}},
+			[]events.Reviewer{{Id: "2", Name: "이수민"}}, "1", "김영희")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, StateOpen, sut.State)
+		assert.Equal(t, "color", sut.Changes[0].FieldName)
+		assert.Equal(t, uint64(1), sut.GetVersion())
+	})
+
+	t.Run("같은 필드를 두 번 바꾸면 ErrInvalidChangeRequest를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewChangeRequestAggregate(uuid.New().String(), "mellow")
+
+		// when
+		err := sut.Open(context.Background(), "content-1", "dresses", "가격 인하", "",
+			[]events.ChangedField{{FieldName: "price", AfterValue: 1}, {FieldName: "/price", AfterValue: 2}},
+			[]events.Reviewer{{Id: "2", Name: "이수민"}}, "1", "김영희")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidChangeRequest)
+	})
+
+	t.Run("작성자가 리뷰어면 ErrInvalidChangeRequest를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewChangeRequestAggregate(uuid.New().String(), "mellow")
+
+		// when
+		err := sut.Open(context.Background(), "content-1", "dresses", "가격 인하", "",
+			[]events.ChangedField{{FieldName: "price", AfterValue: 1}},
+			[]events.Reviewer{{Id: "1", Name: "김영희"}}, "1", "김영희")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidChangeRequest)
+	})
+
+	t.Run("변경이 없으면 ErrInvalidChangeRequest를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewChangeRequestAggregate(uuid.New().String(), "mellow")
+
+		// when
+		err := sut.Open(context.Background(), "content-1", "dresses", "가격 인하", "", nil,
+			[]events.Reviewer{{Id: "2", Name: "이수민"}}, "1", "김영희")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidChangeRequest)
+	})
+}
+
+func TestChangeRequestAggregate_Review(t *testing.T) {
+	t.Run("리뷰어가 아니면 ErrNotReviewer를 반환한다", func(t *testing.T) {
+		// given
+		sut := openChangeRequest(t)
+
+		// when
+		err := sut.Review(context.Background(), DecisionApprove, "", "1", "김영희")
+
+		// then
+		assert.ErrorIs(t, err, ErrNotReviewer)
+	})
+
+	t.Run("사유 없이 반려하면 ErrCommentRequired를 반환한다", func(t *testing.T) {
+		// given
+		sut := openChangeRequest(t)
+
+		// when
+		err := sut.Review(context.Background(), DecisionReject, "", "2", "이수민")
+
+		// then
+		assert.ErrorIs(t, err, ErrCommentRequired)
+	})
+
+	t.Run("닫힌 변경 요청은 리뷰할 수 없다", func(t *testing.T) {
+		// given
+		sut := openChangeRequest(t)
+		_ = sut.Close(context.Background(), "1", "김영희")
+
+		// when
+		err := sut.Review(context.Background(), DecisionApprove, "", "2", "이수민")
+
+		// then
+		assert.ErrorIs(t, err, ErrChangeRequestNotOpen)
+	})
+}
+
+func TestChangeRequestAggregate_Merge(t *testing.T) {
+	t.Run("승인이 없으면 ErrNotApproved를 반환한다", func(t *testing.T) {
+		// given
+		sut := openChangeRequest(t)
+
+		// when
+		err := sut.Merge(context.Background(), "commit-1", 6, "1", "김영희")
+
+		// then
+		assert.ErrorIs(t, err, ErrNotApproved)
+	})
+
+	t.Run("한 리뷰어라도 반려했으면 ErrNotApproved를 반환한다", func(t *testing.T) {
+		// given
+		sut := openChangeRequest(t)
+		_ = sut.Review(context.Background(), DecisionApprove, "", "2", "이수민")
+		_ = sut.Review(context.Background(), DecisionReject, "가격 정책 확인 필요", "3", "박지훈")
+
+		// when
+		err := sut.Merge(context.Background(), "commit-1", 6, "1", "김영희")
+
+		// then
+		assert.ErrorIs(t, err, ErrNotApproved)
+	})
+
+	t.Run("반려한 리뷰어가 다시 승인하면 머지할 수 있다", func(t *testing.T) {
+		// given
+		sut := openChangeRequest(t)
+		_ = sut.Review(context.Background(), DecisionReject, "가격 정책 확인 필요", "3", "박지훈")
+		_ = sut.Review(context.Background(), DecisionApprove, "", "3", "박지훈")
+
+		// when
+		err := sut.Merge(context.Background(), "commit-1", 6, "1", "김영희")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, StateMerged, sut.State)
+		assert.Equal(t, "commit-1", sut.CommitId)
+		assert.Equal(t, uint64(6), sut.ContentVersion)
+	})
+
+	t.Run("머지된 변경 요청은 다시 머지하거나 닫을 수 없다", func(t *testing.T) {
+		// given
+		sut := openChangeRequest(t)
+		_ = sut.Review(context.Background(), DecisionApprove, "", "2", "이수민")
+		_ = sut.Merge(context.Background(), "commit-1", 6, "1", "김영희")
+
+		// when
+		mergeErr := sut.Merge(context.Background(), "commit-2", 7, "1", "김영희")
+		closeErr := sut.Close(context.Background(), "1", "김영희")
+
+		// then
+		assert.ErrorIs(t, mergeErr, ErrChangeRequestNotOpen)
+		assert.ErrorIs(t, closeErr, ErrChangeRequestNotOpen)
+	})
+}
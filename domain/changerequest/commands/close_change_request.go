@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type CloseChangeRequest interface {
+	Handle(ctx context.Context, cmd CloseChangeRequestCommand) error
+}
+
+// CloseChangeRequestCommand abandons the change request without applying its changes.
+type CloseChangeRequestCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	ContentId     string `json:"contentId"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type closeChangeRequestCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+}
+
+func (c *closeChangeRequestCmdHandler) Handle(ctx context.Context, cmd CloseChangeRequestCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		changeRequestAggregate, err := loadChangeRequest(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.ContentId)
+		if err != nil {
+			return err
+		}
+		expectedVersion := changeRequestAggregate.GetVersion()
+
+		if err := changeRequestAggregate.Close(ctx, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, changeRequestAggregate, expectedVersion)
+	})
+}
+
+func NewCloseChangeRequestCmdHandler(aggregateStore eventsourcing.AggregateStore) *closeChangeRequestCmdHandler {
+	return &closeChangeRequestCmdHandler{aggregateStore: aggregateStore}
+}
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"contentgit/domain/changerequest"
+	"contentgit/domain/content"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+// maxConcurrencyRetries is how many times a command is reloaded and re-applied when another writer saved the same aggregate first.
+const maxConcurrencyRetries = 3
+
+type ChangeRequestCommands struct {
+	OpenChangeRequest
+	CommentChangeRequest
+	ReviewChangeRequest
+	MergeChangeRequest
+	CloseChangeRequest
+}
+
+func NewChangeRequestCommands(
+	openChangeRequest OpenChangeRequest,
+	commentChangeRequest CommentChangeRequest,
+	reviewChangeRequest ReviewChangeRequest,
+	mergeChangeRequest MergeChangeRequest,
+	closeChangeRequest CloseChangeRequest,
+) *ChangeRequestCommands {
+	return &ChangeRequestCommands{
+		OpenChangeRequest:    openChangeRequest,
+		CommentChangeRequest: commentChangeRequest,
+		ReviewChangeRequest:  reviewChangeRequest,
+		MergeChangeRequest:   mergeChangeRequest,
+		CloseChangeRequest:   closeChangeRequest,
+	}
+}
+
+// loadChangeRequest loads a change request opened for the content contentId, as not found when it was opened for another content
+// or by another tenant.
+func loadChangeRequest(ctx context.Context, aggregateStore eventsourcing.AggregateStore, id string, tenantId string, contentId string) (*changerequest.ChangeRequestAggregate, error) {
+	changeRequestAggregate, err := changerequest.NewChangeRequestAggregate(id, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := aggregateStore.Load(ctx, changeRequestAggregate); err != nil {
+		return nil, err
+	}
+	if changeRequestAggregate.GetVersion() == 0 || changeRequestAggregate.ContentId != contentId ||
+		changeRequestAggregate.GetTenantId() != tenantId {
+		return nil, eventsourcing.ErrAggregateNotFound
+	}
+
+	return changeRequestAggregate, nil
+}
+
+// loadContent loads the content a change request is opened for or merged into, which must belong to the tenant and must not be
+// deleted or purged.
+func loadContent(ctx context.Context, contentAggregateStore eventsourcing.AggregateStore, id string, tenantId string) (*content.ContentAggregate, error) {
+	contentAggregate, err := content.NewContentAggregate(id, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := contentAggregateStore.Load(ctx, contentAggregate); err != nil {
+		return nil, err
+	}
+	if contentAggregate.GetVersion() == 0 || contentAggregate.GetTenantId() != tenantId {
+		return nil, eventsourcing.ErrAggregateNotFound
+	}
+	if contentAggregate.Purged {
+		return nil, content.ErrContentPurged
+	}
+	if contentAggregate.Deleted {
+		return nil, content.ErrContentDeleted
+	}
+
+	return contentAggregate, nil
+}
@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type CommentChangeRequest interface {
+	Handle(ctx context.Context, cmd CommentChangeRequestCommand) error
+}
+
+// CommentChangeRequestCommand comments on the change request, or on its change of FieldName when it is set.
+type CommentChangeRequestCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	ContentId     string `json:"contentId"`
+	FieldName     string `json:"fieldName"`
+	Comment       string `json:"comment"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type commentChangeRequestCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+}
+
+func (c *commentChangeRequestCmdHandler) Handle(ctx context.Context, cmd CommentChangeRequestCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		changeRequestAggregate, err := loadChangeRequest(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.ContentId)
+		if err != nil {
+			return err
+		}
+		expectedVersion := changeRequestAggregate.GetVersion()
+
+		if err := changeRequestAggregate.Comment(ctx, cmd.FieldName, cmd.Comment, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, changeRequestAggregate, expectedVersion)
+	})
+}
+
+func NewCommentChangeRequestCmdHandler(aggregateStore eventsourcing.AggregateStore) *commentChangeRequestCmdHandler {
+	return &commentChangeRequestCmdHandler{aggregateStore: aggregateStore}
+}
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	contentcommands "contentgit/domain/content/commands"
+	"contentgit/domain/content/events"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type MergeChangeRequest interface {
+	Handle(ctx context.Context, cmd MergeChangeRequestCommand) error
+}
+
+// MergeChangeRequestCommand applies the changes of an approved change request to its content as the commit CommitId.
+// The commit is rejected when a field no longer has the BeforeValue the change was proposed on, or when the changed fields
+// no longer satisfy the latest schema of the content type.
+type MergeChangeRequestCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	ContentId     string `json:"contentId"`
+	CommitId      string `json:"commitId"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type mergeChangeRequestCmdHandler struct {
+	aggregateStore              eventsourcing.AggregateStore
+	contentAggregateStore       eventsourcing.AggregateStore
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository
+	assetAggregateStore         eventsourcing.AggregateStore
+}
+
+func (c *mergeChangeRequestCmdHandler) Handle(ctx context.Context, cmd MergeChangeRequestCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		changeRequestAggregate, err := loadChangeRequest(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.ContentId)
+		if err != nil {
+			return err
+		}
+		expectedVersion := changeRequestAggregate.GetVersion()
+
+		contentAggregate, err := loadContent(ctx, c.contentAggregateStore, cmd.ContentId, cmd.TenantId)
+		if err != nil {
+			return err
+		}
+		expectedContentVersion := contentAggregate.GetVersion()
+
+		fields := make([]events.CommittedField, 0, len(changeRequestAggregate.Changes))
+		fieldNames := make([]string, 0, len(changeRequestAggregate.Changes))
+		for _, change := range changeRequestAggregate.Changes {
+			fields = append(fields, events.CommittedField{FieldName: change.FieldName, BeforeValue: change.BeforeValue, AfterValue: change.AfterValue})
+			fieldNames = append(fieldNames, change.FieldName)
+		}
+
+		// the change request is checked first so that an unapproved one does not touch the content
+		if err := changeRequestAggregate.Merge(ctx, cmd.CommitId, expectedContentVersion+1, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+		if err := contentAggregate.Commit(ctx, cmd.CommitId, changeRequestAggregate.Title, expectedContentVersion, fields,
+			cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+		if err := contentcommands.ValidateContentFields(ctx, c.contentTypeSchemaRepository, c.contentAggregateStore, c.assetAggregateStore,
+			contentAggregate, fieldNames...); err != nil {
+			return err
+		}
+
+		if err := c.contentAggregateStore.Save(ctx, contentAggregate, expectedContentVersion); err != nil {
+			return err
+		}
+		return c.aggregateStore.Save(ctx, changeRequestAggregate, expectedVersion)
+	})
+}
+
+func NewMergeChangeRequestCmdHandler(aggregateStore eventsourcing.AggregateStore, contentAggregateStore eventsourcing.AggregateStore,
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository, assetAggregateStore eventsourcing.AggregateStore) *mergeChangeRequestCmdHandler {
+	return &mergeChangeRequestCmdHandler{aggregateStore: aggregateStore, contentAggregateStore: contentAggregateStore,
+		contentTypeSchemaRepository: contentTypeSchemaRepository, assetAggregateStore: assetAggregateStore}
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"contentgit/domain/changerequest"
+	"contentgit/domain/changerequest/events"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type OpenChangeRequest interface {
+	Handle(ctx context.Context, cmd OpenChangeRequestCommand) error
+}
+
+// OpenChangeRequestCommand proposes Changes to the content ContentId without applying them.
+type OpenChangeRequestCommand struct {
+	AggregateID   string                `json:"id"`
+	TenantId      string                `json:"tenantId"`
+	ContentId     string                `json:"contentId"`
+	Title         string                `json:"title"`
+	Description   string                `json:"description"`
+	Changes       []events.ChangedField `json:"changes"`
+	Reviewers     []events.Reviewer     `json:"reviewers"`
+	CreatedById   string                `json:"createdById"`
+	CreatedByName string                `json:"createdByName"`
+}
+
+type openChangeRequestCmdHandler struct {
+	aggregateStore        eventsourcing.AggregateStore
+	contentAggregateStore eventsourcing.AggregateStore
+}
+
+func (c *openChangeRequestCmdHandler) Handle(ctx context.Context, cmd OpenChangeRequestCommand) error {
+	contentAggregate, err := loadContent(ctx, c.contentAggregateStore, cmd.ContentId, cmd.TenantId)
+	if err != nil {
+		return err
+	}
+
+	changeRequestAggregate, err := changerequest.NewChangeRequestAggregate(cmd.AggregateID, cmd.TenantId)
+	if err != nil {
+		return err
+	}
+
+	if err := changeRequestAggregate.Open(ctx, cmd.ContentId, contentAggregate.ContentType, cmd.Title, cmd.Description,
+		cmd.Changes, cmd.Reviewers, cmd.CreatedById, cmd.CreatedByName); err != nil {
+		return err
+	}
+
+	return c.aggregateStore.Save(ctx, changeRequestAggregate, 0)
+}
+
+func NewOpenChangeRequestCmdHandler(aggregateStore eventsourcing.AggregateStore, contentAggregateStore eventsourcing.AggregateStore) *openChangeRequestCmdHandler {
+	return &openChangeRequestCmdHandler{aggregateStore: aggregateStore, contentAggregateStore: contentAggregateStore}
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"contentgit/domain/changerequest"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type ReviewChangeRequest interface {
+	Handle(ctx context.Context, cmd ReviewChangeRequestCommand) error
+}
+
+// ReviewChangeRequestCommand approves or rejects the change request as one of its reviewers.
+type ReviewChangeRequestCommand struct {
+	AggregateID  string `json:"id"`
+	TenantId     string `json:"tenantId"`
+	ContentId    string `json:"contentId"`
+	Decision     string `json:"decision"`
+	Comment      string `json:"comment"`
+	ReviewerId   string `json:"reviewerId"`
+	ReviewerName string `json:"reviewerName"`
+}
+
+type reviewChangeRequestCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+}
+
+func (c *reviewChangeRequestCmdHandler) Handle(ctx context.Context, cmd ReviewChangeRequestCommand) error {
+	decision, err := changerequest.ParseDecision(cmd.Decision)
+	if err != nil {
+		return err
+	}
+
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		changeRequestAggregate, err := loadChangeRequest(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.ContentId)
+		if err != nil {
+			return err
+		}
+		expectedVersion := changeRequestAggregate.GetVersion()
+
+		if err := changeRequestAggregate.Review(ctx, decision, cmd.Comment, cmd.ReviewerId, cmd.ReviewerName); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, changeRequestAggregate, expectedVersion)
+	})
+}
+
+func NewReviewChangeRequestCmdHandler(aggregateStore eventsourcing.AggregateStore) *reviewChangeRequestCmdHandler {
+	return &reviewChangeRequestCmdHandler{aggregateStore: aggregateStore}
+}
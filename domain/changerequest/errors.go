@@ -0,0 +1,13 @@
+package changerequest
+
+import "github.com/pkg/errors"
+
+var (
+	ErrInvalidChangeRequest = errors.New("invalid change request")
+	ErrChangeRequestNotOpen = errors.New("change request is not open")
+	ErrNotReviewer          = errors.New("not a reviewer of the change request")
+	ErrInvalidDecision      = errors.New("invalid decision")
+	ErrCommentRequired      = errors.New("comment is required")
+	ErrNotApproved          = errors.New("change request is not approved")
+	ErrUnknownEventType     = errors.New("unknown event type")
+)
@@ -0,0 +1,138 @@
+package changerequest
+
+import (
+	"contentgit/domain/changerequest/events"
+	"contentgit/domain/changerequest/projections"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+type ChangeRequestEventHandler struct {
+	serializer                        eventsourcing.Serializer
+	changeRequestProjectionRepository ChangeRequestProjectionRepository
+}
+
+func NewChangeRequestEventHandler(serializer eventsourcing.Serializer,
+	changeRequestProjectionRepository ChangeRequestProjectionRepository) *ChangeRequestEventHandler {
+	return &ChangeRequestEventHandler{serializer: serializer, changeRequestProjectionRepository: changeRequestProjectionRepository}
+}
+
+func (c *ChangeRequestEventHandler) Handle(ctx context.Context, esEvent eventsourcing.Event) error {
+	deserializedEvent, err := c.serializer.DeserializeEvent(ctx, esEvent)
+	if err != nil {
+		return errors.Wrapf(err, "serializer.DeserializeEvent aggregateID: %s, type: %s", esEvent.GetAggregateID(), esEvent.GetEventType())
+	}
+
+	switch event := deserializedEvent.(type) {
+	case *events.ChangeRequestOpenedEventV1:
+		return c.onChangeRequestOpened(ctx, esEvent, event)
+	case *events.ChangeRequestCommentedEventV1:
+		return c.onChangeRequestCommented(ctx, esEvent, event)
+	case *events.ChangeRequestReviewedEventV1:
+		return c.onChangeRequestReviewed(ctx, esEvent, event)
+	case *events.ChangeRequestMergedEventV1:
+		return c.onChangeRequestMerged(ctx, esEvent, event)
+	case *events.ChangeRequestClosedEventV1:
+		return c.onChangeRequestClosed(ctx, esEvent, event)
+	default:
+		return errors.New(fmt.Sprintf("unknown event type: %s", esEvent.GetEventType()))
+	}
+}
+
+func (c *ChangeRequestEventHandler) GetAggregateType() eventsourcing.AggregateType {
+	return ChangeRequestAggregateType
+}
+
+func (c *ChangeRequestEventHandler) onChangeRequestOpened(ctx context.Context, esEvent eventsourcing.Event, event *events.ChangeRequestOpenedEventV1) error {
+	if esEvent.GetVersion() != 1 {
+		return errors.Wrapf(eventsourcing.ErrInvalidEventVersion, "type: %s, version: %d", esEvent.GetEventType(), esEvent.GetVersion())
+	}
+
+	changes := make(projections.ChangedFieldVO, 0, len(event.Changes))
+	for _, change := range event.Changes {
+		changes = append(changes, projections.ChangedField{Name: change.FieldName, BeforeValue: change.BeforeValue, AfterValue: change.AfterValue})
+	}
+	reviewers := make(projections.ReviewerVO, 0, len(event.Reviewers))
+	for _, reviewer := range event.Reviewers {
+		reviewers = append(reviewers, projections.Reviewer{Id: reviewer.Id, Name: reviewer.Name})
+	}
+
+	changeRequestProjection := projections.NewChangeRequestProjection(
+		esEvent.AggregateID,
+		esEvent.TenantId,
+		event.ContentId,
+		event.ContentType,
+		event.Title,
+		event.Description,
+		changes,
+		reviewers,
+		event.CreatedById,
+		event.CreatedByName,
+		esEvent.GetCreatedAt(),
+		uint(esEvent.Version),
+	)
+
+	if err := c.changeRequestProjectionRepository.Create(ctx, changeRequestProjection); err != nil {
+		return errors.Wrap(err, "failed to create change request projection")
+	}
+	return nil
+}
+
+func (c *ChangeRequestEventHandler) onChangeRequestCommented(ctx context.Context, esEvent eventsourcing.Event, event *events.ChangeRequestCommentedEventV1) error {
+	changeRequestProjection, err := c.findChangeRequestProjection(ctx, esEvent)
+	if err != nil {
+		return err
+	}
+
+	changeRequestProjection.AddComment(event.FieldName, event.Comment, event.CreatedById, event.CreatedByName, esEvent.GetCreatedAt())
+	changeRequestProjection.Version = uint(esEvent.Version)
+
+	return c.changeRequestProjectionRepository.Save(ctx, changeRequestProjection)
+}
+
+func (c *ChangeRequestEventHandler) onChangeRequestReviewed(ctx context.Context, esEvent eventsourcing.Event, event *events.ChangeRequestReviewedEventV1) error {
+	changeRequestProjection, err := c.findChangeRequestProjection(ctx, esEvent)
+	if err != nil {
+		return err
+	}
+
+	changeRequestProjection.AddReview(event.Decision, event.Comment, event.ReviewerId, event.ReviewerName, esEvent.GetCreatedAt())
+	changeRequestProjection.Version = uint(esEvent.Version)
+
+	return c.changeRequestProjectionRepository.Save(ctx, changeRequestProjection)
+}
+
+func (c *ChangeRequestEventHandler) onChangeRequestMerged(ctx context.Context, esEvent eventsourcing.Event, event *events.ChangeRequestMergedEventV1) error {
+	changeRequestProjection, err := c.findChangeRequestProjection(ctx, esEvent)
+	if err != nil {
+		return err
+	}
+
+	changeRequestProjection.Merge(event.CommitId, uint(event.ContentVersion), esEvent.GetCreatedAt())
+	changeRequestProjection.Version = uint(esEvent.Version)
+
+	return c.changeRequestProjectionRepository.Save(ctx, changeRequestProjection)
+}
+
+func (c *ChangeRequestEventHandler) onChangeRequestClosed(ctx context.Context, esEvent eventsourcing.Event, event *events.ChangeRequestClosedEventV1) error {
+	changeRequestProjection, err := c.findChangeRequestProjection(ctx, esEvent)
+	if err != nil {
+		return err
+	}
+
+	changeRequestProjection.Close(esEvent.GetCreatedAt())
+	changeRequestProjection.Version = uint(esEvent.Version)
+
+	return c.changeRequestProjectionRepository.Save(ctx, changeRequestProjection)
+}
+
+func (c *ChangeRequestEventHandler) findChangeRequestProjection(ctx context.Context, esEvent eventsourcing.Event) (*projections.ChangeRequestProjection, error) {
+	changeRequestProjection, err := c.changeRequestProjectionRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find change request projection")
+	}
+	return changeRequestProjection, nil
+}
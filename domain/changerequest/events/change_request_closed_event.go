@@ -0,0 +1,14 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ChangeRequestClosedEventType eventsourcing.EventType = "CHANGE_REQUEST_CLOSED_V1"
+)
+
+// ChangeRequestClosedEventV1 abandons the change request without applying its changes.
+type ChangeRequestClosedEventV1 struct {
+	CreatedById   string  `json:"createdById"`
+	CreatedByName string  `json:"createdByName"`
+	Metadata      *string `json:"-"`
+}
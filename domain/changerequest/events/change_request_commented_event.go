@@ -0,0 +1,16 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ChangeRequestCommentedEventType eventsourcing.EventType = "CHANGE_REQUEST_COMMENTED_V1"
+)
+
+// ChangeRequestCommentedEventV1 is a comment on the change request, or on one of its changes when FieldName is set.
+type ChangeRequestCommentedEventV1 struct {
+	FieldName     string  `json:"fieldName,omitempty"`
+	Comment       string  `json:"comment"`
+	CreatedById   string  `json:"createdById"`
+	CreatedByName string  `json:"createdByName"`
+	Metadata      *string `json:"-"`
+}
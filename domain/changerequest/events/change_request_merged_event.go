@@ -0,0 +1,17 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ChangeRequestMergedEventType eventsourcing.EventType = "CHANGE_REQUEST_MERGED_V1"
+)
+
+// ChangeRequestMergedEventV1 records that the changes were applied to the content as the commit CommitId,
+// which is ContentVersion of the content.
+type ChangeRequestMergedEventV1 struct {
+	CommitId       string  `json:"commitId"`
+	ContentVersion uint64  `json:"contentVersion"`
+	CreatedById    string  `json:"createdById"`
+	CreatedByName  string  `json:"createdByName"`
+	Metadata       *string `json:"-"`
+}
@@ -0,0 +1,32 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ChangeRequestOpenedEventType eventsourcing.EventType = "CHANGE_REQUEST_OPENED_V1"
+)
+
+// ChangeRequestOpenedEventV1 proposes Changes to the content without applying them, Reviewers decide whether they are merged.
+type ChangeRequestOpenedEventV1 struct {
+	ContentId     string         `json:"contentId"`
+	ContentType   string         `json:"contentType"`
+	Title         string         `json:"title"`
+	Description   string         `json:"description"`
+	Changes       []ChangedField `json:"changes"`
+	Reviewers     []Reviewer     `json:"reviewers"`
+	CreatedById   string         `json:"createdById"`
+	CreatedByName string         `json:"createdByName"`
+	Metadata      *string        `json:"-"`
+}
+
+// ChangedField is a proposed update of a field, BeforeValue is checked against the content when the change request is merged.
+type ChangedField struct {
+	FieldName   string `json:"fieldName"`
+	BeforeValue any    `json:"beforeValue"`
+	AfterValue  any    `json:"afterValue"`
+}
+
+type Reviewer struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
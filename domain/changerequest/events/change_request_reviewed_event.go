@@ -0,0 +1,16 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ChangeRequestReviewedEventType eventsourcing.EventType = "CHANGE_REQUEST_REVIEWED_V1"
+)
+
+// ChangeRequestReviewedEventV1 is the decision of a reviewer, a later decision of the same reviewer replaces it.
+type ChangeRequestReviewedEventV1 struct {
+	Decision     string  `json:"decision"`
+	Comment      string  `json:"comment,omitempty"`
+	ReviewerId   string  `json:"reviewerId"`
+	ReviewerName string  `json:"reviewerName"`
+	Metadata     *string `json:"-"`
+}
@@ -0,0 +1,173 @@
+package projections
+
+import (
+	"contentgit/domain/content/jsonpointer"
+	"database/sql/driver"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ChangeRequestProjection is a change request of a content with its reviews and comments.
+type ChangeRequestProjection struct {
+	Id             string                 `gorm:"primarykey"`
+	TenantId       string                 `gorm:"not null;index:idx_change_requests_content"`
+	ContentId      string                 `gorm:"not null;index:idx_change_requests_content"`
+	ContentType    string                 `gorm:"type:varchar(100)"`
+	Title          string                 `gorm:"not null"`
+	Description    string                 `gorm:"type:text"`
+	Changes        ChangedFieldVO         `gorm:"type:jsonb"`
+	Reviewers      ReviewerVO             `gorm:"type:jsonb"`
+	State          string                 `gorm:"type:varchar(20);not null;default:open"`
+	CommitId       string                 `gorm:"type:varchar(100)"`
+	ContentVersion *uint                  // ContentVersion is the version of the content the changes were merged as.
+	Reviews        []ChangeRequestReview  `gorm:"foreignKey:ChangeRequestId"`
+	Comments       []ChangeRequestComment `gorm:"foreignKey:ChangeRequestId"`
+	Version        uint
+	CreatedById    string
+	CreatedByName  string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	ClosedAt       *time.Time
+}
+
+func NewChangeRequestProjection(id string, tenantId string, contentId string, contentType string, title string, description string,
+	changes ChangedFieldVO, reviewers ReviewerVO, createdById string, createdByName string, createdAt time.Time, version uint) ChangeRequestProjection {
+	return ChangeRequestProjection{
+		Id:            id,
+		TenantId:      tenantId,
+		ContentId:     contentId,
+		ContentType:   contentType,
+		Title:         title,
+		Description:   description,
+		Changes:       changes,
+		Reviewers:     reviewers,
+		State:         "open",
+		Version:       version,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+		CreatedAt:     createdAt,
+	}
+}
+
+func (*ChangeRequestProjection) TableName() string {
+	return "change_requests"
+}
+
+func (e *ChangeRequestProjection) AddComment(fieldName, comment, createdById, createdByName string, createdAt time.Time) {
+	e.Comments = append(e.Comments, ChangeRequestComment{
+		Model:         gorm.Model{CreatedAt: createdAt},
+		Name:          fieldName,
+		Comment:       comment,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	})
+}
+
+func (e *ChangeRequestProjection) AddReview(decision, comment, reviewerId, reviewerName string, createdAt time.Time) {
+	e.Reviews = append(e.Reviews, ChangeRequestReview{
+		Model:        gorm.Model{CreatedAt: createdAt},
+		Decision:     decision,
+		Comment:      comment,
+		ReviewerId:   reviewerId,
+		ReviewerName: reviewerName,
+	})
+}
+
+func (e *ChangeRequestProjection) Merge(commitId string, contentVersion uint, mergedAt time.Time) {
+	e.State = "merged"
+	e.CommitId = commitId
+	e.ContentVersion = &contentVersion
+	e.ClosedAt = &mergedAt
+}
+
+func (e *ChangeRequestProjection) Close(closedAt time.Time) {
+	e.State = "closed"
+	e.ClosedAt = &closedAt
+}
+
+// Purge nulls the proposed values of the purged fields of the content, and of the values nested in them.
+func (e *ChangeRequestProjection) Purge(fields []string) {
+	for i, change := range e.Changes {
+		path, err := jsonpointer.Parse(change.Name)
+		if err != nil || !slices.Contains(fields, path.Root()) {
+			continue
+		}
+		e.Changes[i].BeforeValue = nil
+		e.Changes[i].AfterValue = nil
+	}
+}
+
+// ChangeRequestReview is a decision of a reviewer, a reviewer who reviews again keeps the earlier reviews.
+type ChangeRequestReview struct {
+	gorm.Model
+	ChangeRequestId string `gorm:"not null;index"`
+	Decision        string `gorm:"type:varchar(20);not null"`
+	Comment         string `gorm:"type:text"`
+	ReviewerId      string
+	ReviewerName    string
+}
+
+func (ChangeRequestReview) TableName() string {
+	return "change_request_reviews"
+}
+
+// ChangeRequestComment is a comment on the change request, or on the change of the field Name when it is set.
+type ChangeRequestComment struct {
+	gorm.Model
+	ChangeRequestId string `gorm:"not null;index"`
+	Name            string
+	Comment         string `gorm:"not null;type:text"`
+	CreatedById     string
+	CreatedByName   string
+}
+
+func (ChangeRequestComment) TableName() string {
+	return "change_request_comments"
+}
+
+type ChangedFieldVO []ChangedField
+
+type ChangedField struct {
+	Name        string `json:"name"`
+	BeforeValue any    `json:"beforeValue"`
+	AfterValue  any    `json:"afterValue"`
+}
+
+// Value Marshal
+func (jsonField ChangedFieldVO) Value() (driver.Value, error) {
+	return json.Marshal(jsonField)
+}
+
+// Scan Unmarshal
+func (jsonField *ChangedFieldVO) Scan(value any) error {
+	data, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(data, &jsonField)
+}
+
+type ReviewerVO []Reviewer
+
+type Reviewer struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Value Marshal
+func (jsonField ReviewerVO) Value() (driver.Value, error) {
+	return json.Marshal(jsonField)
+}
+
+// Scan Unmarshal
+func (jsonField *ReviewerVO) Scan(value any) error {
+	data, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(data, &jsonField)
+}
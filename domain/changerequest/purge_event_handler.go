@@ -0,0 +1,67 @@
+package changerequest
+
+import (
+	"contentgit/domain/content"
+	"contentgit/domain/content/events"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// ContentPurgeEventHandler erases the personal data a purged content leaves in its change requests. Their events hold
+// the proposed values encrypted with the key of the content, but their projections and snapshots hold them decrypted.
+type ContentPurgeEventHandler struct {
+	serializer                        eventsourcing.Serializer
+	aggregateStore                    eventsourcing.AggregateStore
+	changeRequestProjectionRepository ChangeRequestProjectionRepository
+}
+
+func NewContentPurgeEventHandler(serializer eventsourcing.Serializer, aggregateStore eventsourcing.AggregateStore,
+	changeRequestProjectionRepository ChangeRequestProjectionRepository) *ContentPurgeEventHandler {
+	return &ContentPurgeEventHandler{serializer: serializer, aggregateStore: aggregateStore,
+		changeRequestProjectionRepository: changeRequestProjectionRepository}
+}
+
+func (c *ContentPurgeEventHandler) Handle(ctx context.Context, esEvent eventsourcing.Event) error {
+	// only the purges are deserialized
+	if esEvent.GetEventType() != events.ContentPurgedEventType {
+		return nil
+	}
+
+	deserializedEvent, err := c.serializer.DeserializeEvent(ctx, esEvent)
+	if err != nil {
+		return errors.Wrapf(err, "serializer.DeserializeEvent aggregateID: %s, type: %s", esEvent.GetAggregateID(), esEvent.GetEventType())
+	}
+
+	event, ok := deserializedEvent.(*events.ContentPurgedEventV1)
+	if !ok {
+		return nil
+	}
+	return c.onContentPurged(ctx, esEvent, event)
+}
+
+func (c *ContentPurgeEventHandler) GetAggregateType() eventsourcing.AggregateType {
+	return content.ContentAggregateType
+}
+
+// onContentPurged nulls the purged fields in the change requests of the content and deletes their snapshots,
+// the change requests are rebuilt from their events, whose values of the purged fields read as null from then on.
+func (c *ContentPurgeEventHandler) onContentPurged(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentPurgedEventV1) error {
+	changeRequestProjections, err := c.changeRequestProjectionRepository.FindAllByContentId(ctx, esEvent.TenantId, esEvent.AggregateID, "")
+	if err != nil {
+		return errors.Wrap(err, "failed to find change request projections")
+	}
+
+	for i := range changeRequestProjections {
+		changeRequestProjections[i].Purge(event.Fields)
+		if err := c.changeRequestProjectionRepository.Save(ctx, &changeRequestProjections[i]); err != nil {
+			return err
+		}
+		if err := c.aggregateStore.DeleteSnapshots(ctx, changeRequestProjections[i].Id); err != nil {
+			return errors.Wrapf(err, "failed to delete snapshots of change request %s", changeRequestProjections[i].Id)
+		}
+	}
+
+	return nil
+}
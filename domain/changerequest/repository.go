@@ -0,0 +1,14 @@
+package changerequest
+
+import (
+	"contentgit/domain/changerequest/projections"
+	"context"
+)
+
+// ChangeRequestProjectionRepository stores the change requests of contents with their reviews and comments.
+type ChangeRequestProjectionRepository interface {
+	Create(ctx context.Context, projection projections.ChangeRequestProjection) error
+	FindByID(ctx context.Context, tenantId string, id string) (*projections.ChangeRequestProjection, error)
+	FindAllByContentId(ctx context.Context, tenantId string, contentId string, state string) ([]projections.ChangeRequestProjection, error)
+	Save(ctx context.Context, projection *projections.ChangeRequestProjection) error
+}
@@ -0,0 +1,111 @@
+package changerequest
+
+import (
+	"contentgit/domain/changerequest/events"
+	"contentgit/domain/content"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"contentgit/ports/out/persistance/eventsourcing/serializer"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidEvent = errors.New("invalid event")
+)
+
+type eventSerializer struct {
+	shredder *content.FieldShredder
+}
+
+func NewEventSerializer() *eventSerializer {
+	return &eventSerializer{}
+}
+
+// NewShreddingEventSerializer returns a serializer that stores the proposed values of personal data fields encrypted
+// with the key of their content, so that purging the content erases them from the change requests too.
+func NewShreddingEventSerializer(keyRepository content.ContentKeyRepository, personalDataFields content.PersonalDataFields) *eventSerializer {
+	return &eventSerializer{shredder: content.NewFieldShredder(keyRepository, personalDataFields)}
+}
+
+func (s *eventSerializer) SerializeEvent(ctx context.Context, aggregate eventsourcing.Aggregate, event any) (eventsourcing.Event, error) {
+	data := event
+	if evt, ok := event.(*events.ChangeRequestOpenedEventV1); ok && s.shredder != nil {
+		encrypted, err := mapChangedValues(evt, s.shredder.Encrypter(ctx, aggregate.GetTenantId(), evt.ContentId, evt.ContentType))
+		if err != nil {
+			return eventsourcing.Event{}, errors.Wrapf(err, "shredder.Encrypter aggregateID: %s", aggregate.GetID())
+		}
+		data = encrypted
+	}
+
+	eventJson, err := serializer.Marshal(data)
+	if err != nil {
+		return eventsourcing.Event{}, errors.Wrapf(err, "serializer.Marshal aggregateID: %s", aggregate.GetID())
+	}
+
+	switch evt := event.(type) {
+	case *events.ChangeRequestOpenedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ChangeRequestOpenedEventType, eventJson, evt.Metadata), nil
+	case *events.ChangeRequestCommentedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ChangeRequestCommentedEventType, eventJson, evt.Metadata), nil
+	case *events.ChangeRequestReviewedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ChangeRequestReviewedEventType, eventJson, evt.Metadata), nil
+	case *events.ChangeRequestMergedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ChangeRequestMergedEventType, eventJson, evt.Metadata), nil
+	case *events.ChangeRequestClosedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ChangeRequestClosedEventType, eventJson, evt.Metadata), nil
+	default:
+		return eventsourcing.Event{}, errors.Wrapf(ErrInvalidEvent, "aggregateID: %s, type: %T", aggregate.GetID(), event)
+	}
+}
+
+func (s *eventSerializer) DeserializeEvent(ctx context.Context, event eventsourcing.Event) (any, error) {
+	switch event.GetEventType() {
+	case events.ChangeRequestOpenedEventType:
+		deserializedEvent, err := deserializeEvent(event, new(events.ChangeRequestOpenedEventV1))
+		if err != nil || s.shredder == nil {
+			return deserializedEvent, err
+		}
+		openedEvent := deserializedEvent.(*events.ChangeRequestOpenedEventV1)
+		decrypted, err := mapChangedValues(openedEvent, s.shredder.Decrypter(ctx, openedEvent.ContentId))
+		if err != nil {
+			return nil, errors.Wrapf(err, "shredder.Decrypter aggregateID: %s", event.GetAggregateID())
+		}
+		return decrypted, nil
+	case events.ChangeRequestCommentedEventType:
+		return deserializeEvent(event, new(events.ChangeRequestCommentedEventV1))
+	case events.ChangeRequestReviewedEventType:
+		return deserializeEvent(event, new(events.ChangeRequestReviewedEventV1))
+	case events.ChangeRequestMergedEventType:
+		return deserializeEvent(event, new(events.ChangeRequestMergedEventV1))
+	case events.ChangeRequestClosedEventType:
+		return deserializeEvent(event, new(events.ChangeRequestClosedEventV1))
+	default:
+		return nil, errors.Wrapf(ErrInvalidEvent, "type: %s", event.GetEventType())
+	}
+}
+
+func deserializeEvent(event eventsourcing.Event, targetEvent any) (any, error) {
+	if err := event.GetJsonData(&targetEvent); err != nil {
+		return nil, errors.Wrapf(err, "event.GetJsonData type: %s", event.GetEventType())
+	}
+	return targetEvent, nil
+}
+
+// mapChangedValues returns a copy of the event with the proposed values replaced by fn, the event itself is left
+// untouched since its changes are shared with the aggregate.
+func mapChangedValues(event *events.ChangeRequestOpenedEventV1, fn func(fieldName string, value any) (any, error)) (*events.ChangeRequestOpenedEventV1, error) {
+	mapped := *event
+	mapped.Changes = make([]events.ChangedField, len(event.Changes))
+	for i, change := range event.Changes {
+		var err error
+		if change.BeforeValue, err = fn(change.FieldName, change.BeforeValue); err != nil {
+			return nil, err
+		}
+		if change.AfterValue, err = fn(change.FieldName, change.AfterValue); err != nil {
+			return nil, err
+		}
+		mapped.Changes[i] = change
+	}
+	return &mapped, nil
+}
@@ -0,0 +1,103 @@
+package changerequest
+
+import (
+	"contentgit/domain/changerequest/events"
+	"contentgit/domain/content"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// inMemoryContentKeyRepository is a content.ContentKeyRepository kept in a map.
+type inMemoryContentKeyRepository struct {
+	keys map[string]*content.ContentKey
+}
+
+func (r *inMemoryContentKeyRepository) FindOrCreate(ctx context.Context, tenantId string, contentId string) (*content.ContentKey, error) {
+	if key, ok := r.keys[contentId]; ok {
+		return key, nil
+	}
+	key, err := content.NewContentKey(tenantId, contentId)
+	if err != nil {
+		return nil, err
+	}
+	r.keys[contentId] = key
+	return key, nil
+}
+
+func (r *inMemoryContentKeyRepository) FindByContentId(ctx context.Context, contentId string) (*content.ContentKey, error) {
+	if key, ok := r.keys[contentId]; ok {
+		return key, nil
+	}
+	return nil, persistence.ErrRecordNotFound
+}
+
+func (r *inMemoryContentKeyRepository) Destroy(ctx context.Context, tenantId string, contentId string) error {
+	destroyedAt := time.Now()
+	r.keys[contentId] = &content.ContentKey{ContentId: contentId, TenantId: tenantId, DestroyedAt: &destroyedAt}
+	return nil
+}
+
+func TestShreddingEventSerializer(t *testing.T) {
+	personalDataFields := content.NewPersonalDataFields(map[string][]string{"customers": {"name", "address"}})
+	openChangeRequest := func(t *testing.T, contentId string) *ChangeRequestAggregate {
+		sut, _ := NewChangeRequestAggregate(uuid.New().String(), "bettercode")
+		require.NoError(t, sut.Open(context.Background(), contentId, "customers", "주소 변경", "", []events.ChangedField{
+			{FieldName: "/address/city", BeforeValue: "서울", AfterValue: "부산"},
+			{FieldName: "grade", BeforeValue: "silver", AfterValue: "gold"},
+		}, []events.Reviewer{{Id: "2", Name: "김영희"}}, "1", "홍길동"))
+		return sut
+	}
+
+	t.Run("개인정보 필드의 제안된 값은 컨텐츠의 키로 암호화해서 저장한다", func(t *testing.T) {
+		// given
+		keyRepository := &inMemoryContentKeyRepository{keys: make(map[string]*content.ContentKey)}
+		sut := NewShreddingEventSerializer(keyRepository, personalDataFields)
+		contentId := uuid.New().String()
+		aggregate := openChangeRequest(t, contentId)
+
+		// when
+		esEvent, err := sut.SerializeEvent(context.Background(), aggregate, aggregate.GetChanges()[0])
+		require.NoError(t, err)
+		deserializedEvent, deserializeErr := sut.DeserializeEvent(context.Background(), esEvent)
+
+		// then
+		assert.NotContains(t, esEvent.GetData(), "서울")
+		assert.NotContains(t, esEvent.GetData(), "부산")
+		assert.Contains(t, esEvent.GetData(), "gold")
+		assert.Contains(t, keyRepository.keys, contentId)
+		assert.Equal(t, "서울", aggregate.Changes[0].BeforeValue)
+
+		assert.NoError(t, deserializeErr)
+		assert.Equal(t, []events.ChangedField{
+			{FieldName: "/address/city", BeforeValue: "서울", AfterValue: "부산"},
+			{FieldName: "grade", BeforeValue: "silver", AfterValue: "gold"},
+		}, deserializedEvent.(*events.ChangeRequestOpenedEventV1).Changes)
+	})
+
+	t.Run("컨텐츠가 영구 삭제되면 제안된 값은 null로 읽힌다", func(t *testing.T) {
+		// given
+		keyRepository := &inMemoryContentKeyRepository{keys: make(map[string]*content.ContentKey)}
+		sut := NewShreddingEventSerializer(keyRepository, personalDataFields)
+		contentId := uuid.New().String()
+		aggregate := openChangeRequest(t, contentId)
+		esEvent, err := sut.SerializeEvent(context.Background(), aggregate, aggregate.GetChanges()[0])
+		require.NoError(t, err)
+
+		// when
+		_ = keyRepository.Destroy(context.Background(), "bettercode", contentId)
+		deserializedEvent, deserializeErr := sut.DeserializeEvent(context.Background(), esEvent)
+
+		// then
+		assert.NoError(t, deserializeErr)
+		assert.Equal(t, []events.ChangedField{
+			{FieldName: "/address/city"},
+			{FieldName: "grade", BeforeValue: "silver", AfterValue: "gold"},
+		}, deserializedEvent.(*events.ChangeRequestOpenedEventV1).Changes)
+	})
+}
@@ -2,8 +2,12 @@ package content
 
 import (
 	"contentgit/domain/content/events"
+	"contentgit/domain/content/jsonpointer"
+	"contentgit/domain/content/jsonvalue"
 	"contentgit/ports/out/persistance/eventsourcing"
 	"context"
+	"regexp"
+	"slices"
 
 	"github.com/pkg/errors"
 )
@@ -12,11 +16,25 @@ const (
 	ContentAggregateType eventsourcing.AggregateType = "content"
 )
 
+// refNamePattern is the allowed form of branch and tag names.
+var refNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,99}$`)
+
 type ContentAggregate struct {
 	*eventsourcing.AggregateBase
-	Content       map[string]any `json:"content"`
-	ContentType   string         `json:"contentType"`
-	FieldComments []FieldComment `json:"fieldComments"`
+	Content       map[string]any       `json:"content"`
+	ContentType   string               `json:"contentType"`
+	FieldComments []FieldComment       `json:"fieldComments"`
+	SourceBranch  string               `json:"sourceBranch,omitempty"`
+	SourceVersion uint64               `json:"sourceVersion,omitempty"`
+	MergeBases    map[string]MergeBase `json:"mergeBases,omitempty"`
+	Deleted       bool                 `json:"deleted,omitempty"`
+	Purged        bool                 `json:"purged,omitempty"`
+	Status        Status               `json:"status,omitempty"`
+	// PublishedVersion is the version that was promoted to the published content, zero when the content is not published.
+	PublishedVersion uint64 `json:"publishedVersion,omitempty"`
+	// SchemaVersion is the version of the schema of the content type a schema migration brought the content to,
+	// zero when the content was never migrated.
+	SchemaVersion uint64 `json:"schemaVersion,omitempty"`
 }
 
 func NewContentAggregate(id string, tenantId string) (*ContentAggregate, error) {
@@ -27,6 +45,7 @@ func NewContentAggregate(id string, tenantId string) (*ContentAggregate, error)
 	contentAggregate := &ContentAggregate{
 		Content:       make(map[string]any),
 		FieldComments: []FieldComment{},
+		MergeBases:    make(map[string]MergeBase),
 	}
 
 	aggregateBase := eventsourcing.NewAggregateBase(contentAggregate.When)
@@ -48,6 +67,26 @@ func NewContentAggregateWithType(id string, tenantId string, contentType string)
 	return aggregate, nil
 }
 
+// NewContentAggregateOnBranch creates an aggregate bound to the event stream of the given branch.
+// An empty branch means the default branch.
+func NewContentAggregateOnBranch(id string, tenantId string, branch string) (*ContentAggregate, error) {
+	aggregate, err := NewContentAggregate(id, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if branch == "" {
+		return aggregate, nil
+	}
+
+	if !refNamePattern.MatchString(branch) {
+		return nil, errors.Wrapf(ErrInvalidBranchName, "branch: %s", branch)
+	}
+
+	aggregate.SetBranch(branch)
+	return aggregate, nil
+}
+
 func (a *ContentAggregate) CreateContent(ctx context.Context, content map[string]any) error {
 	if content == nil {
 		return errors.New("content is required.")
@@ -61,9 +100,15 @@ func (a *ContentAggregate) CreateContent(ctx context.Context, content map[string
 	return a.Apply(event)
 }
 
+// UpdateField updates the value of a field, fieldName is a top-level field name or a JSON Pointer to a nested value.
 func (a *ContentAggregate) UpdateField(ctx context.Context, fieldName string, beforeValue any, afterValue any, createdById string, createdByName string) error {
+	path, err := parseFieldPath(fieldName)
+	if err != nil {
+		return err
+	}
+
 	event := &events.FieldUpdatedEventV1{
-		FieldName:     fieldName,
+		FieldName:     path.String(),
 		BeforeValue:   beforeValue,
 		AfterValue:    afterValue,
 		CreatedById:   createdById,
@@ -73,9 +118,65 @@ func (a *ContentAggregate) UpdateField(ctx context.Context, fieldName string, be
 	return a.Apply(event)
 }
 
-func (a *ContentAggregate) AddFieldComment(ctx context.Context, fieldName string, comment string, createdById string, createdByName string) error {
+// AddField adds a field, a JSON Pointer adds a key to a nested object or inserts an element into a nested array.
+func (a *ContentAggregate) AddField(ctx context.Context, fieldName string, value any, createdById string, createdByName string) error {
+	path, err := parseFieldPath(fieldName)
+	if err != nil {
+		return err
+	}
+
+	event := &events.FieldAddedEventV1{
+		FieldName:     path.String(),
+		Value:         value,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// RemoveField removes a field from the content, its comments are kept.
+func (a *ContentAggregate) RemoveField(ctx context.Context, fieldName string, createdById string, createdByName string) error {
+	if a.Purged {
+		return ErrContentPurged
+	}
+	if a.Deleted {
+		return ErrContentDeleted
+	}
+
+	path, err := parseFieldPath(fieldName)
+	if err != nil {
+		return err
+	}
+
+	value, ok := path.Get(a.Content)
+	if !ok {
+		return ErrFieldNotFound
+	}
+
+	event := &events.FieldRemovedEventV1{
+		FieldName:     path.String(),
+		Value:         value,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// AddFieldComment starts the comment thread commentId on a field.
+func (a *ContentAggregate) AddFieldComment(ctx context.Context, commentId string, fieldName string, comment string, createdById string, createdByName string) error {
+	path, err := parseFieldPath(fieldName)
+	if err != nil {
+		return err
+	}
+	if err := a.validateNewComment(commentId, comment); err != nil {
+		return err
+	}
+
 	event := &events.FieldCommentAddedEventV1{
-		FieldName:     fieldName,
+		FieldName:     path.String(),
+		CommentId:     commentId,
 		Comment:       comment,
 		CreatedById:   createdById,
 		CreatedByName: createdByName,
@@ -84,7 +185,331 @@ func (a *ContentAggregate) AddFieldComment(ctx context.Context, fieldName string
 	return a.Apply(event)
 }
 
+// CreateBranch starts the branch stream of the aggregate from the current state of the source aggregate.
+func (a *ContentAggregate) CreateBranch(ctx context.Context, source *ContentAggregate, createdById string, createdByName string) error {
+	if a.GetVersion() != 0 || a.GetBranch() == source.GetBranch() {
+		return ErrBranchAlreadyExists
+	}
+	if source.Purged {
+		return ErrContentPurged
+	}
+	if source.Deleted {
+		return ErrContentDeleted
+	}
+
+	event := &events.ContentBranchCreatedEventV1{
+		Content:       copyContent(source.Content),
+		ContentType:   source.ContentType,
+		SourceBranch:  source.GetBranch(),
+		SourceVersion: source.GetVersion(),
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// Merge merges the source aggregate into the aggregate using base as their common ancestor.
+// resolutions holds the chosen values of conflicting fields and removals the conflicting fields chosen to be removed,
+// a *MergeConflictError is returned for any conflict left unresolved.
+func (a *ContentAggregate) Merge(ctx context.Context, source *ContentAggregate, base *ContentAggregate, resolutions map[string]any,
+	removals []string, createdById string, createdByName string) error {
+	if a.Purged {
+		return ErrContentPurged
+	}
+	if a.Deleted {
+		return ErrContentDeleted
+	}
+
+	if base.GetBranch() == source.GetBranch() && base.GetVersion() == source.GetVersion() {
+		return ErrNothingToMerge
+	}
+
+	result := ThreeWayMerge(base.Content, source.Content, a.Content)
+
+	unresolved := make([]FieldConflict, 0)
+	for _, conflict := range result.Conflicts {
+		if slices.Contains(removals, conflict.FieldName) {
+			if !conflict.TargetRemoved {
+				result.Fields = append(result.Fields, events.MergedField{
+					FieldName:   conflict.FieldName,
+					BeforeValue: conflict.TargetValue,
+					Removed:     true,
+				})
+			}
+			continue
+		}
+
+		resolvedValue, ok := resolutions[conflict.FieldName]
+		if !ok {
+			unresolved = append(unresolved, conflict)
+			continue
+		}
+
+		result.Fields = append(result.Fields, events.MergedField{
+			FieldName:   conflict.FieldName,
+			BeforeValue: conflict.TargetValue,
+			AfterValue:  resolvedValue,
+		})
+	}
+	if len(unresolved) > 0 {
+		return &MergeConflictError{Conflicts: unresolved}
+	}
+
+	event := &events.ContentMergedEventV1{
+		SourceBranch:  source.GetBranch(),
+		SourceVersion: source.GetVersion(),
+		TargetVersion: a.GetVersion(),
+		Fields:        result.Fields,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// Commit applies the field updates together as a single change on top of parentVersion.
+func (a *ContentAggregate) Commit(ctx context.Context, commitId string, message string, parentVersion uint64, fields []events.CommittedField,
+	createdById string, createdByName string) error {
+	if a.Purged {
+		return ErrContentPurged
+	}
+	if a.Deleted {
+		return ErrContentDeleted
+	}
+
+	if parentVersion != a.GetVersion() {
+		return errors.Wrapf(ErrStaleParentVersion, "parentVersion: %d, version: %d", parentVersion, a.GetVersion())
+	}
+
+	if len(fields) == 0 {
+		return ErrInvalidCommit
+	}
+	committedFields := make([]events.CommittedField, 0, len(fields))
+	fieldNames := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		path, err := parseFieldPath(field.FieldName)
+		if err != nil {
+			return err
+		}
+		if fieldNames[path.String()] {
+			return errors.Wrapf(ErrInvalidCommit, "fieldName: %s", field.FieldName)
+		}
+		fieldNames[path.String()] = true
+
+		field.FieldName = path.String()
+		committedFields = append(committedFields, field)
+	}
+
+	event := &events.ContentCommittedEventV1{
+		CommitId:      commitId,
+		Message:       message,
+		ParentVersion: parentVersion,
+		Fields:        committedFields,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// Revert brings the fields of the aggregate back to the values of target, an earlier version of the same stream.
+// Comments are kept, the revert is recorded as a new event on top of the history.
+func (a *ContentAggregate) Revert(ctx context.Context, target *ContentAggregate, createdById string, createdByName string) error {
+	if a.Purged {
+		return ErrContentPurged
+	}
+	if a.Deleted {
+		return ErrContentDeleted
+	}
+
+	diff := Diff(a.Content, target.Content)
+
+	fields := make([]events.RevertedField, 0, len(diff.Added)+len(diff.Removed)+len(diff.Changed))
+	for _, field := range diff.Changed {
+		fields = append(fields, events.RevertedField{FieldName: field.FieldName, BeforeValue: field.BeforeValue, AfterValue: field.AfterValue})
+	}
+	for _, field := range diff.Added {
+		fields = append(fields, events.RevertedField{FieldName: field.FieldName, AfterValue: field.Value})
+	}
+	for _, field := range diff.Removed {
+		fields = append(fields, events.RevertedField{FieldName: field.FieldName, BeforeValue: field.Value})
+	}
+	if len(fields) == 0 {
+		return ErrNothingToRevert
+	}
+
+	event := &events.ContentRevertedEventV1{
+		TargetVersion: target.GetVersion(),
+		Content:       copyContent(target.Content),
+		Fields:        fields,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// Migrate brings the content to the last of schemas by applying the migration steps of the schemas it was not migrated
+// to yet, schemas are the consecutive versions following the version the content follows. The migrated content has
+// to satisfy the last schema, ErrNothingToMigrate is returned when the migration changes no field.
+func (a *ContentAggregate) Migrate(ctx context.Context, migrationId string, schemas []ContentTypeSchema,
+	createdById string, createdByName string) error {
+	if a.Purged {
+		return ErrContentPurged
+	}
+	if a.Deleted {
+		return ErrContentDeleted
+	}
+	if len(schemas) == 0 {
+		return ErrNothingToMigrate
+	}
+
+	target := schemas[len(schemas)-1]
+	pending := make([]ContentTypeSchema, 0, len(schemas))
+	for _, schema := range schemas {
+		if schema.Version > a.SchemaVersion {
+			pending = append(pending, schema)
+		}
+	}
+	if len(pending) == 0 {
+		return ErrNothingToMigrate
+	}
+
+	migrated, err := MigrateContent(a.Content, pending)
+	if err != nil {
+		return err
+	}
+	if err := target.Validate(migrated); err != nil {
+		return err
+	}
+
+	diff := Diff(a.Content, migrated)
+	fields := make([]events.MigratedField, 0, len(diff.Added)+len(diff.Removed)+len(diff.Changed))
+	for _, field := range diff.Changed {
+		fields = append(fields, events.MigratedField{FieldName: field.FieldName, BeforeValue: field.BeforeValue, AfterValue: field.AfterValue})
+	}
+	for _, field := range diff.Added {
+		fields = append(fields, events.MigratedField{FieldName: field.FieldName, AfterValue: field.Value})
+	}
+	for _, field := range diff.Removed {
+		fields = append(fields, events.MigratedField{FieldName: field.FieldName, BeforeValue: field.Value})
+	}
+	if len(fields) == 0 {
+		return ErrNothingToMigrate
+	}
+
+	event := &events.ContentMigratedEventV1{
+		MigrationId:       migrationId,
+		FromSchemaVersion: pending[0].Version - 1,
+		ToSchemaVersion:   target.Version,
+		Content:           migrated,
+		Fields:            fields,
+		CreatedById:       createdById,
+		CreatedByName:     createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// Delete moves the content to the trash, every command but Restore is rejected until it is restored.
+func (a *ContentAggregate) Delete(ctx context.Context, createdById string, createdByName string) error {
+	event := &events.ContentDeletedEventV1{
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+func (a *ContentAggregate) Restore(ctx context.Context, createdById string, createdByName string) error {
+	if !a.Deleted {
+		return ErrContentNotDeleted
+	}
+
+	event := &events.ContentRestoredEventV1{
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// Purge erases the values of the given personal data fields, the content is read-only afterwards.
+// A deleted content can be purged as well.
+func (a *ContentAggregate) Purge(ctx context.Context, fields []string, createdById string, createdByName string) error {
+	event := &events.ContentPurgedEventV1{
+		Fields:        fields,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// ChangeStatus moves the content through the editorial workflow, a transition must be allowed by the workflow
+// from the current status and a rejection must give its reason. Publishing promotes the current version of the draft
+// to the published content, which readers keep seeing while the draft is edited.
+func (a *ContentAggregate) ChangeStatus(ctx context.Context, workflow Workflow, transition Transition, reason string,
+	createdById string, createdByName string) error {
+	if a.Purged {
+		return ErrContentPurged
+	}
+	if a.Deleted {
+		return ErrContentDeleted
+	}
+	from := a.CurrentStatus()
+	if !workflow.Allows(transition, from) {
+		return errors.Wrapf(ErrTransitionNotAllowed, "transition: %s, status: %s", transition, from)
+	}
+	if transition == TransitionReject && reason == "" {
+		return ErrReasonRequired
+	}
+	if transition == TransitionUnpublish && a.PublishedVersion == 0 {
+		return ErrContentNotPublished
+	}
+
+	event := &events.ContentStatusChangedEventV1{
+		Transition:    string(transition),
+		FromStatus:    string(from),
+		ToStatus:      string(transition.Target()),
+		Reason:        reason,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+	switch transition {
+	case TransitionPublish:
+		event.PublishedVersion = a.GetVersion()
+	case TransitionUnpublish:
+		// taking the published content offline leaves a draft that was edited since it was published as it is
+		if from != StatusPublished {
+			event.ToStatus = string(from)
+		}
+	}
+
+	return a.Apply(event)
+}
+
+// CurrentStatus returns the editorial status of the content, contents created before the workflow are drafts.
+func (a *ContentAggregate) CurrentStatus() Status {
+	if a.Status == "" {
+		return StatusDraft
+	}
+	return a.Status
+}
+
 func (a *ContentAggregate) When(event any) error {
+	if a.Purged {
+		return ErrContentPurged
+	}
+	switch event.(type) {
+	case *events.ContentRestoredEventV1, *events.ContentPurgedEventV1:
+	default:
+		if a.Deleted {
+			return ErrContentDeleted
+		}
+	}
+
 	switch evt := event.(type) {
 	case *events.ContentCreatedEventV1:
 		return a.handleContentCreatedEvent(evt)
@@ -92,6 +517,36 @@ func (a *ContentAggregate) When(event any) error {
 		return a.handleFieldUpdatedEvent(evt)
 	case *events.FieldCommentAddedEventV1:
 		return a.handleFieldCommentAddedEvent(evt)
+	case *events.FieldCommentEditedEventV1:
+		return a.handleFieldCommentEditedEvent(evt)
+	case *events.FieldCommentDeletedEventV1:
+		return a.handleFieldCommentDeletedEvent(evt)
+	case *events.FieldCommentResolvedEventV1:
+		return a.handleFieldCommentResolvedEvent(evt)
+	case *events.FieldCommentReopenedEventV1:
+		return a.handleFieldCommentReopenedEvent(evt)
+	case *events.FieldAddedEventV1:
+		return a.handleFieldAddedEvent(evt)
+	case *events.FieldRemovedEventV1:
+		return a.handleFieldRemovedEvent(evt)
+	case *events.ContentBranchCreatedEventV1:
+		return a.handleContentBranchCreatedEvent(evt)
+	case *events.ContentMergedEventV1:
+		return a.handleContentMergedEvent(evt)
+	case *events.ContentRevertedEventV1:
+		return a.handleContentRevertedEvent(evt)
+	case *events.ContentCommittedEventV1:
+		return a.handleContentCommittedEvent(evt)
+	case *events.ContentDeletedEventV1:
+		return a.handleContentDeletedEvent(evt)
+	case *events.ContentRestoredEventV1:
+		return a.handleContentRestoredEvent(evt)
+	case *events.ContentPurgedEventV1:
+		return a.handleContentPurgedEvent(evt)
+	case *events.ContentStatusChangedEventV1:
+		return a.handleContentStatusChangedEvent(evt)
+	case *events.ContentMigratedEventV1:
+		return a.handleContentMigratedEvent(evt)
 	default:
 		return errors.Wrapf(ErrUnknownEventType, "event: %#v", event)
 	}
@@ -100,30 +555,63 @@ func (a *ContentAggregate) When(event any) error {
 func (a *ContentAggregate) handleContentCreatedEvent(evt *events.ContentCreatedEventV1) error {
 	a.Content = evt.Content
 	a.ContentType = evt.ContentType
+	a.Status = StatusDraft
 	return nil
 }
 
 func (a *ContentAggregate) handleFieldUpdatedEvent(evt *events.FieldUpdatedEventV1) error {
-	contentFieldValue, ok := a.Content[evt.FieldName]
-	if !ok {
-		return ErrFieldNotFound
+	if err := a.updateFieldValue(a.Content, evt.FieldName, evt.BeforeValue, evt.AfterValue); err != nil {
+		return err
 	}
+	a.markEdited()
+	return nil
+}
 
-	if contentFieldValue != evt.BeforeValue {
-		return ErrFieldUpdateConflict
+func (a *ContentAggregate) handleFieldAddedEvent(evt *events.FieldAddedEventV1) error {
+	path, err := parseFieldPath(evt.FieldName)
+	if err != nil {
+		return err
+	}
+
+	if err := path.Add(a.Content, copyValue(evt.Value)); err != nil {
+		return fieldPathError(err)
+	}
+	a.markEdited()
+	return nil
+}
+
+func (a *ContentAggregate) handleFieldRemovedEvent(evt *events.FieldRemovedEventV1) error {
+	path, err := parseFieldPath(evt.FieldName)
+	if err != nil {
+		return err
 	}
 
-	a.Content[evt.FieldName] = evt.AfterValue
+	if _, err := path.Remove(a.Content); err != nil {
+		return fieldPathError(err)
+	}
+	a.markEdited()
 	return nil
 }
 
+// handleFieldCommentAddedEvent requires the field to exist only to start a thread, a thread outlives the removal of its field.
 func (a *ContentAggregate) handleFieldCommentAddedEvent(evt *events.FieldCommentAddedEventV1) error {
-	_, ok := a.Content[evt.FieldName]
-	if !ok {
+	path, err := parseFieldPath(evt.FieldName)
+	if err != nil {
+		return err
+	}
+
+	// a comment on a locale the field is not translated to yet only needs the field
+	fieldPath := path
+	if evt.Locale != "" && len(path) > 1 {
+		fieldPath = path[:len(path)-1]
+	}
+	if _, ok := fieldPath.Get(a.Content); !ok && evt.ParentId == "" {
 		return ErrFieldNotFound
 	}
 
 	fieldComment := Comment{
+		Id:            FieldCommentId(evt.CommentId, a.GetVersion()+1),
+		ParentId:      evt.ParentId,
 		Comment:       evt.Comment,
 		CreatedById:   evt.CreatedById,
 		CreatedByName: evt.CreatedByName,
@@ -144,13 +632,184 @@ func (a *ContentAggregate) handleFieldCommentAddedEvent(evt *events.FieldComment
 	return nil
 }
 
+func (a *ContentAggregate) handleContentBranchCreatedEvent(evt *events.ContentBranchCreatedEventV1) error {
+	a.Content = evt.Content
+	a.ContentType = evt.ContentType
+	a.SourceBranch = evt.SourceBranch
+	a.SourceVersion = evt.SourceVersion
+	a.MergeBases[evt.SourceBranch] = MergeBase{Version: evt.SourceVersion, AtVersion: a.GetVersion() + 1}
+	return nil
+}
+
+func (a *ContentAggregate) handleContentMergedEvent(evt *events.ContentMergedEventV1) error {
+	for _, field := range evt.Fields {
+		if field.Removed {
+			delete(a.Content, field.FieldName)
+			continue
+		}
+		a.Content[field.FieldName] = field.AfterValue
+	}
+	a.MergeBases[evt.SourceBranch] = MergeBase{Version: evt.SourceVersion, AtVersion: a.GetVersion() + 1}
+	a.markEdited()
+	return nil
+}
+
+func (a *ContentAggregate) handleContentRevertedEvent(evt *events.ContentRevertedEventV1) error {
+	a.Content = copyContent(evt.Content)
+	a.markEdited()
+	return nil
+}
+
+// handleContentCommittedEvent applies the fields in order on a copy of the content so that a commit is applied as a whole or not at all.
+func (a *ContentAggregate) handleContentCommittedEvent(evt *events.ContentCommittedEventV1) error {
+	content := copyContent(a.Content)
+	for _, field := range evt.Fields {
+		if err := a.updateFieldValue(content, field.FieldName, field.BeforeValue, field.AfterValue); err != nil {
+			return errors.Wrapf(err, "fieldName: %s", field.FieldName)
+		}
+	}
+
+	a.Content = content
+	a.markEdited()
+	return nil
+}
+
+// handleContentDeletedEvent takes the content offline, a restored content has to be published again.
+func (a *ContentAggregate) handleContentDeletedEvent(evt *events.ContentDeletedEventV1) error {
+	a.Deleted = true
+	a.PublishedVersion = 0
+	if a.Status == StatusPublished {
+		a.Status = StatusDraft
+	}
+	return nil
+}
+
+func (a *ContentAggregate) handleContentRestoredEvent(evt *events.ContentRestoredEventV1) error {
+	a.Deleted = false
+	return nil
+}
+
+// handleContentPurgedEvent keeps the purged fields with a null value, as they read when the event stream is replayed.
+func (a *ContentAggregate) handleContentPurgedEvent(evt *events.ContentPurgedEventV1) error {
+	content := copyContent(a.Content)
+	for _, fieldName := range evt.Fields {
+		if _, ok := content[fieldName]; ok {
+			content[fieldName] = nil
+		}
+	}
+	a.Content = content
+	a.Purged = true
+	return nil
+}
+
+func (a *ContentAggregate) handleContentStatusChangedEvent(evt *events.ContentStatusChangedEventV1) error {
+	a.Status = Status(evt.ToStatus)
+	switch Transition(evt.Transition) {
+	case TransitionPublish:
+		a.PublishedVersion = evt.PublishedVersion
+	case TransitionUnpublish:
+		a.PublishedVersion = 0
+	}
+	return nil
+}
+
+// handleContentMigratedEvent treats a migration as an edit, the published content keeps its previous form until
+// the migrated draft is published.
+func (a *ContentAggregate) handleContentMigratedEvent(evt *events.ContentMigratedEventV1) error {
+	a.Content = copyContent(evt.Content)
+	a.SchemaVersion = evt.ToSchemaVersion
+	a.markEdited()
+	return nil
+}
+
+// markEdited sends a content that is under review, approved or published back to draft, its draft is no longer what was
+// reviewed or published. The published content stays as it is until the draft is published again.
+// Branches have no status of their own and are left as they are.
+func (a *ContentAggregate) markEdited() {
+	if a.Status != "" {
+		a.Status = StatusDraft
+	}
+}
+
+// updateFieldValue replaces the value of the field in content after checking it still holds beforeValue.
+func (a *ContentAggregate) updateFieldValue(content map[string]any, fieldName string, beforeValue any, afterValue any) error {
+	path, err := parseFieldPath(fieldName)
+	if err != nil {
+		return err
+	}
+
+	contentFieldValue, ok := path.Get(content)
+	if !ok {
+		return ErrFieldNotFound
+	}
+
+	if !jsonvalue.Equal(contentFieldValue, beforeValue) {
+		return ErrFieldUpdateConflict
+	}
+
+	return fieldPathError(path.Set(content, copyValue(afterValue)))
+}
+
 type FieldComment struct {
 	FieldName string    `json:"fieldName"`
 	Comments  []Comment `json:"comments"`
 }
 
 type Comment struct {
+	Id            string `json:"id,omitempty"`
+	ParentId      string `json:"parentId,omitempty"`
 	Comment       string `json:"comment"`
 	CreatedById   string `json:"createdById"`
 	CreatedByName string `json:"createdByName"`
+	Resolved      bool   `json:"resolved,omitempty"`
+	Deleted       bool   `json:"deleted,omitempty"`
+}
+
+// parseFieldPath parses a top-level field name or a JSON Pointer to a value nested in the content.
+func parseFieldPath(fieldName string) (jsonpointer.Pointer, error) {
+	path, err := jsonpointer.Parse(fieldName)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidFieldName, err.Error())
+	}
+	return path, nil
+}
+
+// fieldPathError translates the errors of a JSON Pointer operation on the content to the errors of the aggregate.
+func fieldPathError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, jsonpointer.ErrNotFound):
+		return errors.Wrap(ErrFieldNotFound, err.Error())
+	case errors.Is(err, jsonpointer.ErrAlreadyExists):
+		return errors.Wrap(ErrFieldAlreadyExists, err.Error())
+	case errors.Is(err, jsonpointer.ErrInvalidPointer):
+		return errors.Wrap(ErrInvalidFieldName, err.Error())
+	default:
+		return err
+	}
+}
+
+// copyContent deep copies the JSON values of a content so that branches never share nested maps or slices.
+func copyContent(content map[string]any) map[string]any {
+	copied := make(map[string]any, len(content))
+	for key, value := range content {
+		copied[key] = copyValue(value)
+	}
+	return copied
+}
+
+func copyValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return copyContent(v)
+	case []any:
+		copied := make([]any, len(v))
+		for i := range v {
+			copied[i] = copyValue(v[i])
+		}
+		return copied
+	default:
+		return v
+	}
 }
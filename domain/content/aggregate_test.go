@@ -1,6 +1,8 @@
 package content
 
 import (
+	"contentgit/domain/content/events"
+	"contentgit/ports/out/persistance/eventsourcing"
 	"context"
 	"testing"
 
@@ -144,6 +146,88 @@ func TestContentAggregate_UpdateField(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "고길동", sut.Content["name"])
 	})
+
+	t.Run("JSON Pointer로 중첩된 필드를 업데이트한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{
+			"name":     "홍길동",
+			"variants": []any{map[string]any{"price": 1000.0}, map[string]any{"price": 2000.0}},
+		})
+
+		// when
+		err := sut.UpdateField(context.Background(), "/variants/1/price", 2000.0, 2500.0, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []any{map[string]any{"price": 1000.0}, map[string]any{"price": 2500.0}}, sut.Content["variants"])
+		assert.Equal(t, "/variants/1/price", sut.GetChanges()[1].(*events.FieldUpdatedEventV1).FieldName)
+	})
+
+	t.Run("최상위 필드를 가리키는 JSON Pointer는 필드명으로 기록된다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+
+		// when
+		err := sut.UpdateField(context.Background(), "/name", "홍길동", "고길동", "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "name", sut.GetChanges()[1].(*events.FieldUpdatedEventV1).FieldName)
+	})
+
+	t.Run("객체와 배열 값은 깊은 비교로 충돌을 검사한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"tags": []any{"a", "b"}, "size": map[string]any{"width": 10.0}})
+
+		// when
+		conflictErr := sut.UpdateField(context.Background(), "tags", []any{"a"}, []any{"c"}, "testerId", "testerName")
+		err := sut.UpdateField(context.Background(), "size", map[string]any{"width": 10.0}, map[string]any{"width": 20.0}, "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, conflictErr, ErrFieldUpdateConflict)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"width": 20.0}, sut.Content["size"])
+	})
+
+	t.Run("숫자 타입이 달라도 값이 같으면 충돌이 아니다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"prices": []any{1000.0, 2000.0}})
+
+		// when
+		err := sut.UpdateField(context.Background(), "prices", []any{1000, 2000}, []any{1500, 2000}, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []any{1500, 2000}, sut.Content["prices"])
+	})
+
+	t.Run("존재하지 않는 배열 요소면 ErrFieldNotFound를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"variants": []any{map[string]any{"price": 1000.0}}})
+
+		// when
+		err := sut.UpdateField(context.Background(), "/variants/3/price", 1000.0, 2000.0, "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrFieldNotFound)
+	})
+
+	t.Run("잘못된 JSON Pointer면 ErrInvalidFieldName을 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+
+		// when
+		err := sut.UpdateField(context.Background(), "/name~2", "홍길동", "고길동", "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidFieldName)
+	})
 }
 
 func TestContentAggregate_AddFieldComment(t *testing.T) {
@@ -153,7 +237,7 @@ func TestContentAggregate_AddFieldComment(t *testing.T) {
 		sut.Content = map[string]any{"name": "홍길동"}
 
 		// when
-		err := sut.AddFieldComment(context.Background(), "unknownField", "comment", "testerId", "testerName")
+		err := sut.AddFieldComment(context.Background(), uuid.New().String(), "unknownField", "comment", "testerId", "testerName")
 
 		// then
 		assert.Equal(t, ErrFieldNotFound, err)
@@ -165,7 +249,7 @@ func TestContentAggregate_AddFieldComment(t *testing.T) {
 		sut.Content = map[string]any{"name": "홍길동"}
 
 		// when
-		err := sut.AddFieldComment(context.Background(), "name", "comment", "testerId", "testerName")
+		err := sut.AddFieldComment(context.Background(), uuid.New().String(), "name", "comment", "testerId", "testerName")
 
 		// then
 		assert.NoError(t, err)
@@ -181,10 +265,10 @@ func TestContentAggregate_AddFieldComment(t *testing.T) {
 		// given
 		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
 		sut.Content = map[string]any{"name": "홍길동"}
-		_ = sut.AddFieldComment(context.Background(), "name", "첫번째 댓글", "user1", "사용자1")
+		_ = sut.AddFieldComment(context.Background(), uuid.New().String(), "name", "첫번째 댓글", "user1", "사용자1")
 
 		// when
-		err := sut.AddFieldComment(context.Background(), "name", "두번째 댓글", "user2", "사용자2")
+		err := sut.AddFieldComment(context.Background(), uuid.New().String(), "name", "두번째 댓글", "user2", "사용자2")
 
 		// then
 		assert.NoError(t, err)
@@ -196,6 +280,310 @@ func TestContentAggregate_AddFieldComment(t *testing.T) {
 	})
 }
 
+func TestContentAggregate_AddField(t *testing.T) {
+	t.Run("새 필드를 추가한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+
+		// when
+		err := sut.AddField(context.Background(), "price", "1000", "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), sut.GetVersion())
+		assert.Equal(t, map[string]any{"name": "홍길동", "price": "1000"}, sut.Content)
+	})
+
+	t.Run("이미 존재하는 필드면 ErrFieldAlreadyExists를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+
+		// when
+		err := sut.AddField(context.Background(), "name", "고길동", "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrFieldAlreadyExists)
+		assert.Equal(t, "홍길동", sut.Content["name"])
+	})
+
+	t.Run("JSON Pointer로 배열에 요소를 삽입한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"tags": []any{"a", "c"}})
+
+		// when
+		insertErr := sut.AddField(context.Background(), "/tags/1", "b", "testerId", "testerName")
+		appendErr := sut.AddField(context.Background(), "/tags/-", "d", "testerId", "testerName")
+
+		// then
+		assert.NoError(t, insertErr)
+		assert.NoError(t, appendErr)
+		assert.Equal(t, []any{"a", "b", "c", "d"}, sut.Content["tags"])
+	})
+}
+
+func TestContentAggregate_RemoveField(t *testing.T) {
+	t.Run("필드를 삭제해도 코멘트는 남는다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동", "price": "1000"})
+		_ = sut.AddFieldComment(context.Background(), uuid.New().String(), "price", "가격 확인 부탁드려요", "testerId", "testerName")
+
+		// when
+		err := sut.RemoveField(context.Background(), "price", "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": "홍길동"}, sut.Content)
+		assert.Equal(t, "price", sut.FieldComments[0].FieldName)
+		assert.Equal(t, "1000", sut.GetChanges()[2].(*events.FieldRemovedEventV1).Value)
+	})
+
+	t.Run("삭제된 필드를 다시 추가할 수 있다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동", "price": "1000"})
+		_ = sut.RemoveField(context.Background(), "price", "testerId", "testerName")
+
+		// when
+		err := sut.AddField(context.Background(), "price", "2000", "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "2000", sut.Content["price"])
+	})
+
+	t.Run("존재하지 않는 필드면 ErrFieldNotFound를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+
+		// when
+		err := sut.RemoveField(context.Background(), "price", "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrFieldNotFound)
+	})
+
+	t.Run("JSON Pointer로 중첩된 필드를 삭제한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"variants": []any{map[string]any{"price": 1000.0}, map[string]any{"price": 2000.0}}})
+
+		// when
+		err := sut.RemoveField(context.Background(), "/variants/0", "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []any{map[string]any{"price": 2000.0}}, sut.Content["variants"])
+		assert.Equal(t, map[string]any{"price": 1000.0}, sut.GetChanges()[1].(*events.FieldRemovedEventV1).Value)
+	})
+}
+
+func TestNewContentAggregateOnBranch(t *testing.T) {
+	t.Run("branch가 없으면 기본 branch의 aggregate를 반환한다", func(t *testing.T) {
+		// when
+		aggregate, err := NewContentAggregateOnBranch(uuid.New().String(), "bettercode", "")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, eventsourcing.DefaultBranch, aggregate.GetBranch())
+	})
+
+	t.Run("branch 이름이 유효하지 않으면 error를 반환한다", func(t *testing.T) {
+		// when
+		_, err := NewContentAggregateOnBranch(uuid.New().String(), "bettercode", "summer/sale")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidBranchName)
+	})
+
+	t.Run("branch의 aggregate를 반환한다", func(t *testing.T) {
+		// when
+		aggregate, err := NewContentAggregateOnBranch(uuid.New().String(), "bettercode", "summer-sale")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "summer-sale", aggregate.GetBranch())
+	})
+}
+
+func TestContentAggregate_CreateBranch(t *testing.T) {
+	t.Run("source의 현재 content로 branch를 생성한다", func(t *testing.T) {
+		// given
+		aggregateId := uuid.New().String()
+		source, _ := NewContentAggregateWithType(aggregateId, "bettercode", "Product")
+		_ = source.CreateContent(context.Background(), map[string]any{"name": "홍길동", "tags": []any{"a"}})
+		_ = source.UpdateField(context.Background(), "name", "홍길동", "고길동", "testerId", "testerName")
+		sut, _ := NewContentAggregateOnBranch(aggregateId, "bettercode", "summer-sale")
+
+		// when
+		err := sut.CreateBranch(context.Background(), source, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), sut.GetVersion())
+		assert.Equal(t, map[string]any{"name": "고길동", "tags": []any{"a"}}, sut.Content)
+		assert.Equal(t, "Product", sut.ContentType)
+		assert.Equal(t, eventsourcing.DefaultBranch, sut.SourceBranch)
+		assert.Equal(t, uint64(2), sut.SourceVersion)
+	})
+
+	t.Run("branch의 변경은 source에 영향을 주지 않는다", func(t *testing.T) {
+		// given
+		aggregateId := uuid.New().String()
+		source, _ := NewContentAggregate(aggregateId, "bettercode")
+		_ = source.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		sut, _ := NewContentAggregateOnBranch(aggregateId, "bettercode", "summer-sale")
+		_ = sut.CreateBranch(context.Background(), source, "testerId", "testerName")
+
+		// when
+		err := sut.UpdateField(context.Background(), "name", "홍길동", "고길동", "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "고길동", sut.Content["name"])
+		assert.Equal(t, "홍길동", source.Content["name"])
+	})
+
+	t.Run("같은 branch로는 생성할 수 없다", func(t *testing.T) {
+		// given
+		aggregateId := uuid.New().String()
+		source, _ := NewContentAggregate(aggregateId, "bettercode")
+		_ = source.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		sut, _ := NewContentAggregate(aggregateId, "bettercode")
+
+		// when
+		err := sut.CreateBranch(context.Background(), source, "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrBranchAlreadyExists)
+	})
+}
+
+func TestContentAggregate_Commit(t *testing.T) {
+	t.Run("여러 필드를 하나의 이벤트로 변경한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동", "price": "1000", "taxRate": "10"})
+		fields := []events.CommittedField{
+			{FieldName: "name", BeforeValue: "홍길동", AfterValue: "고길동"},
+			{FieldName: "price", BeforeValue: "1000", AfterValue: "2000"},
+		}
+
+		// when
+		err := sut.Commit(context.Background(), "commitId", "가격 인상", 1, fields, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), sut.GetVersion())
+		assert.Equal(t, map[string]any{"name": "고길동", "price": "2000", "taxRate": "10"}, sut.Content)
+		event := sut.GetChanges()[1].(*events.ContentCommittedEventV1)
+		assert.Equal(t, "commitId", event.CommitId)
+		assert.Equal(t, "가격 인상", event.Message)
+		assert.Equal(t, uint64(1), event.ParentVersion)
+	})
+
+	t.Run("하나의 필드라도 충돌하면 어떤 필드도 변경하지 않는다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동", "price": "1000"})
+		fields := []events.CommittedField{
+			{FieldName: "name", BeforeValue: "홍길동", AfterValue: "고길동"},
+			{FieldName: "price", BeforeValue: "3000", AfterValue: "2000"},
+		}
+
+		// when
+		err := sut.Commit(context.Background(), "commitId", "가격 인상", 1, fields, "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrFieldUpdateConflict)
+		assert.Equal(t, uint64(1), sut.GetVersion())
+		assert.Equal(t, map[string]any{"name": "홍길동", "price": "1000"}, sut.Content)
+	})
+
+	t.Run("parent 버전이 현재 버전이 아니면 ErrStaleParentVersion을 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		_ = sut.UpdateField(context.Background(), "name", "홍길동", "둘리", "testerId", "testerName")
+
+		// when
+		err := sut.Commit(context.Background(), "commitId", "이름 변경", 1,
+			[]events.CommittedField{{FieldName: "name", BeforeValue: "홍길동", AfterValue: "고길동"}}, "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrStaleParentVersion)
+	})
+
+	t.Run("변경할 필드가 없거나 같은 필드가 중복되면 ErrInvalidCommit을 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		duplicated := []events.CommittedField{
+			{FieldName: "name", BeforeValue: "홍길동", AfterValue: "고길동"},
+			{FieldName: "name", BeforeValue: "고길동", AfterValue: "둘리"},
+		}
+
+		// when
+		emptyErr := sut.Commit(context.Background(), "commitId", "빈 커밋", 1, nil, "testerId", "testerName")
+		duplicatedErr := sut.Commit(context.Background(), "commitId", "중복 커밋", 1, duplicated, "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, emptyErr, ErrInvalidCommit)
+		assert.ErrorIs(t, duplicatedErr, ErrInvalidCommit)
+	})
+}
+
+func TestContentAggregate_Revert(t *testing.T) {
+	t.Run("target 버전의 필드 값으로 되돌린다", func(t *testing.T) {
+		// given
+		aggregateId := uuid.New().String()
+		target, _ := NewContentAggregate(aggregateId, "bettercode")
+		_ = target.CreateContent(context.Background(), map[string]any{"name": "홍길동", "price": "1000"})
+		sut, _ := NewContentAggregate(aggregateId, "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동", "price": "1000"})
+		_ = sut.UpdateField(context.Background(), "name", "홍길동", "고길동", "testerId", "testerName")
+		_ = sut.AddFieldComment(context.Background(), uuid.New().String(), "name", "이름이 바뀌었어요", "testerId", "testerName")
+		_ = sut.UpdateField(context.Background(), "price", "1000", "2000", "testerId", "testerName")
+
+		// when
+		err := sut.Revert(context.Background(), target, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(5), sut.GetVersion())
+		assert.Equal(t, map[string]any{"name": "홍길동", "price": "1000"}, sut.Content)
+		assert.Equal(t, 1, len(sut.FieldComments))
+
+		event := sut.GetChanges()[4].(*events.ContentRevertedEventV1)
+		assert.Equal(t, uint64(1), event.TargetVersion)
+		assert.Equal(t, []events.RevertedField{
+			{FieldName: "name", BeforeValue: "고길동", AfterValue: "홍길동"},
+			{FieldName: "price", BeforeValue: "2000", AfterValue: "1000"},
+		}, event.Fields)
+	})
+
+	t.Run("필드 값이 같으면 ErrNothingToRevert를 반환한다", func(t *testing.T) {
+		// given
+		aggregateId := uuid.New().String()
+		target, _ := NewContentAggregate(aggregateId, "bettercode")
+		_ = target.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		sut, _ := NewContentAggregate(aggregateId, "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		_ = sut.AddFieldComment(context.Background(), uuid.New().String(), "name", "확인했습니다", "testerId", "testerName")
+
+		// when
+		err := sut.Revert(context.Background(), target, "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrNothingToRevert)
+		assert.Equal(t, uint64(2), sut.GetVersion())
+	})
+}
+
 func TestContentAggregate_When(t *testing.T) {
 	t.Run("알 수 없는 이벤트 타입이면 ErrUnknownEventType을 반환한다", func(t *testing.T) {
 		// given
@@ -209,3 +597,227 @@ func TestContentAggregate_When(t *testing.T) {
 		assert.ErrorIs(t, err, ErrUnknownEventType)
 	})
 }
+
+func TestContentAggregate_Delete(t *testing.T) {
+	t.Run("삭제된 컨텐츠는 변경할 수 없다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+
+		// when
+		err := sut.Delete(context.Background(), "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, sut.Deleted)
+		assert.ErrorIs(t, sut.UpdateField(context.Background(), "name", "홍길동", "고길동", "testerId", "testerName"), ErrContentDeleted)
+		assert.ErrorIs(t, sut.RemoveField(context.Background(), "name", "testerId", "testerName"), ErrContentDeleted)
+		assert.ErrorIs(t, sut.Delete(context.Background(), "testerId", "testerName"), ErrContentDeleted)
+		assert.Equal(t, uint64(2), sut.GetVersion())
+	})
+
+	t.Run("삭제된 컨텐츠에서 branch를 만들 수 없다", func(t *testing.T) {
+		// given
+		source, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = source.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		_ = source.Delete(context.Background(), "testerId", "testerName")
+		sut, _ := NewContentAggregateOnBranch(source.GetID(), "bettercode", "summer-sale")
+
+		// when
+		err := sut.CreateBranch(context.Background(), source, "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrContentDeleted)
+	})
+}
+
+func TestContentAggregate_Restore(t *testing.T) {
+	t.Run("복원한 컨텐츠는 다시 변경할 수 있다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		_ = sut.Delete(context.Background(), "testerId", "testerName")
+
+		// when
+		err := sut.Restore(context.Background(), "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, sut.Deleted)
+		assert.NoError(t, sut.UpdateField(context.Background(), "name", "홍길동", "고길동", "testerId", "testerName"))
+	})
+
+	t.Run("삭제되지 않은 컨텐츠면 ErrContentNotDeleted를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+
+		// when
+		err := sut.Restore(context.Background(), "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrContentNotDeleted)
+	})
+}
+
+func TestContentAggregate_Purge(t *testing.T) {
+	t.Run("개인정보 필드를 null로 지우고 이후의 변경을 막는다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregateWithType(uuid.New().String(), "bettercode", "customers")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동", "email": "hong@example.com", "grade": "gold"})
+
+		// when
+		err := sut.Purge(context.Background(), []string{"name", "email", "phone"}, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, sut.Purged)
+		assert.Equal(t, map[string]any{"name": nil, "email": nil, "grade": "gold"}, sut.Content)
+		assert.ErrorIs(t, sut.UpdateField(context.Background(), "grade", "gold", "silver", "testerId", "testerName"), ErrContentPurged)
+		assert.ErrorIs(t, sut.Purge(context.Background(), []string{"name"}, "testerId", "testerName"), ErrContentPurged)
+		assert.Equal(t, uint64(2), sut.GetVersion())
+	})
+
+	t.Run("삭제된 컨텐츠도 purge할 수 있고 복원할 수 없다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregateWithType(uuid.New().String(), "bettercode", "customers")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		_ = sut.Delete(context.Background(), "testerId", "testerName")
+
+		// when
+		err := sut.Purge(context.Background(), []string{"name"}, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.ErrorIs(t, sut.Restore(context.Background(), "testerId", "testerName"), ErrContentPurged)
+	})
+}
+
+func TestContentAggregate_ChangeStatus(t *testing.T) {
+	t.Run("검토, 승인을 거쳐 게시한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+
+		// when
+		submitErr := sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionSubmit, "", "1", "김영희")
+		approveErr := sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionApprove, "", "2", "이수민")
+		publishErr := sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionPublish, "", "2", "이수민")
+
+		// then
+		assert.NoError(t, submitErr)
+		assert.NoError(t, approveErr)
+		assert.NoError(t, publishErr)
+		assert.Equal(t, StatusPublished, sut.CurrentStatus())
+		assert.Equal(t, uint64(4), sut.GetVersion())
+	})
+
+	t.Run("워크플로가 허용하지 않는 전이면 ErrTransitionNotAllowed를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+
+		// when
+		err := sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionPublish, "", "1", "김영희")
+
+		// then
+		assert.ErrorIs(t, err, ErrTransitionNotAllowed)
+		assert.Equal(t, StatusDraft, sut.CurrentStatus())
+	})
+
+	t.Run("컨텐츠 타입의 워크플로를 따른다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		workflow := Workflow{TransitionPublish: {StatusDraft}}
+
+		// when
+		err := sut.ChangeStatus(context.Background(), workflow, TransitionPublish, "", "1", "김영희")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, StatusPublished, sut.CurrentStatus())
+	})
+
+	t.Run("반려는 사유가 있어야 하고 초안으로 되돌린다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		_ = sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionSubmit, "", "1", "김영희")
+
+		// when
+		withoutReasonErr := sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionReject, "", "2", "이수민")
+		err := sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionReject, "가격을 확인해 주세요", "2", "이수민")
+
+		// then
+		assert.ErrorIs(t, withoutReasonErr, ErrReasonRequired)
+		assert.NoError(t, err)
+		assert.Equal(t, StatusDraft, sut.CurrentStatus())
+		assert.Equal(t, "가격을 확인해 주세요", sut.GetChanges()[2].(*events.ContentStatusChangedEventV1).Reason)
+	})
+}
+
+func TestContentAggregate_Publish(t *testing.T) {
+	publish := func(sut *ContentAggregate) {
+		_ = sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionSubmit, "", "1", "김영희")
+		_ = sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionApprove, "", "2", "이수민")
+		_ = sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionPublish, "", "2", "이수민")
+	}
+
+	t.Run("게시하면 현재 초안 버전을 게시 버전으로 올린다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"price": "39000"})
+
+		// when
+		publish(sut)
+
+		// then
+		assert.Equal(t, uint64(3), sut.PublishedVersion)
+		assert.Equal(t, uint64(3), sut.GetChanges()[3].(*events.ContentStatusChangedEventV1).PublishedVersion)
+	})
+
+	t.Run("게시 후 수정하면 초안으로 돌아가고 게시 버전은 유지한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"price": "39000"})
+		publish(sut)
+
+		// when
+		err := sut.UpdateField(context.Background(), "price", "39000", "35000", "1", "김영희")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, StatusDraft, sut.CurrentStatus())
+		assert.Equal(t, uint64(3), sut.PublishedVersion)
+	})
+
+	t.Run("수정된 초안도 게시를 내릴 수 있고 초안 상태는 유지한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"price": "39000"})
+		publish(sut)
+		_ = sut.UpdateField(context.Background(), "price", "39000", "35000", "1", "김영희")
+		_ = sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionSubmit, "", "1", "김영희")
+
+		// when
+		err := sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionUnpublish, "", "2", "이수민")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, StatusInReview, sut.CurrentStatus())
+		assert.Zero(t, sut.PublishedVersion)
+	})
+
+	t.Run("게시되지 않은 컨텐츠는 게시를 내릴 수 없다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"price": "39000"})
+
+		// when
+		err := sut.ChangeStatus(context.Background(), DefaultWorkflow, TransitionUnpublish, "", "2", "이수민")
+
+		// then
+		assert.ErrorIs(t, err, ErrContentNotPublished)
+	})
+}
@@ -0,0 +1,112 @@
+package content
+
+import (
+	"contentgit/domain/content/events"
+	"contentgit/domain/content/jsonpointer"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"time"
+)
+
+// FieldBlame is the origin of the current value of a field: the event that last set it.
+// CreatedById and CreatedByName are empty for values set by events that do not record an author, such as the creation of the content.
+type FieldBlame struct {
+	FieldName     string
+	Version       uint64
+	Timestamp     time.Time
+	CreatedById   string
+	CreatedByName string
+}
+
+// Blame replays an event stream and returns the origin of every field of the resulting content, ordered by field name.
+// A change to a value nested at a JSON Pointer is attributed to the top-level field it belongs to.
+func Blame(ctx context.Context, esEvents []eventsourcing.Event) ([]FieldBlame, error) {
+	serializer := NewEventSerializer()
+	origins := make(map[string]FieldBlame)
+
+	for _, esEvent := range esEvents {
+		deserializedEvent, err := serializer.DeserializeEvent(ctx, esEvent)
+		if err != nil {
+			return nil, err
+		}
+
+		origin := func(fieldName string, createdById string, createdByName string) FieldBlame {
+			fieldName = rootFieldName(fieldName)
+			return FieldBlame{
+				FieldName:     fieldName,
+				Version:       esEvent.GetVersion(),
+				Timestamp:     esEvent.GetCreatedAt(),
+				CreatedById:   createdById,
+				CreatedByName: createdByName,
+			}
+		}
+
+		switch event := deserializedEvent.(type) {
+		case *events.ContentCreatedEventV1:
+			origins = make(map[string]FieldBlame)
+			for fieldName := range event.Content {
+				origins[fieldName] = origin(fieldName, "", "")
+			}
+		case *events.ContentBranchCreatedEventV1:
+			origins = make(map[string]FieldBlame)
+			for fieldName := range event.Content {
+				origins[fieldName] = origin(fieldName, event.CreatedById, event.CreatedByName)
+			}
+		case *events.FieldUpdatedEventV1:
+			origins[rootFieldName(event.FieldName)] = origin(event.FieldName, event.CreatedById, event.CreatedByName)
+		case *events.FieldAddedEventV1:
+			origins[rootFieldName(event.FieldName)] = origin(event.FieldName, event.CreatedById, event.CreatedByName)
+		case *events.FieldRemovedEventV1:
+			if path, err := jsonpointer.Parse(event.FieldName); err == nil && len(path) > 1 {
+				origins[path.Root()] = origin(event.FieldName, event.CreatedById, event.CreatedByName)
+			} else {
+				delete(origins, rootFieldName(event.FieldName))
+			}
+		case *events.ContentMergedEventV1:
+			for _, field := range event.Fields {
+				if field.Removed {
+					delete(origins, rootFieldName(field.FieldName))
+					continue
+				}
+				origins[rootFieldName(field.FieldName)] = origin(field.FieldName, event.CreatedById, event.CreatedByName)
+			}
+		case *events.ContentCommittedEventV1:
+			for _, field := range event.Fields {
+				origins[rootFieldName(field.FieldName)] = origin(field.FieldName, event.CreatedById, event.CreatedByName)
+			}
+		case *events.ContentRevertedEventV1:
+			for _, field := range event.Fields {
+				origins[rootFieldName(field.FieldName)] = origin(field.FieldName, event.CreatedById, event.CreatedByName)
+			}
+			for fieldName := range origins {
+				if _, ok := event.Content[fieldName]; !ok {
+					delete(origins, fieldName)
+				}
+			}
+		case *events.ContentMigratedEventV1:
+			for _, field := range event.Fields {
+				origins[rootFieldName(field.FieldName)] = origin(field.FieldName, event.CreatedById, event.CreatedByName)
+			}
+			for fieldName := range origins {
+				if _, ok := event.Content[fieldName]; !ok {
+					delete(origins, fieldName)
+				}
+			}
+		}
+	}
+
+	blames := make([]FieldBlame, 0, len(origins))
+	for _, fieldName := range sortedKeys(origins) {
+		blames = append(blames, origins[fieldName])
+	}
+	return blames, nil
+}
+
+// rootFieldName returns the top-level field a field name or a JSON Pointer belongs to.
+func rootFieldName(fieldName string) string {
+	path, err := jsonpointer.Parse(fieldName)
+	if err != nil {
+		return fieldName
+	}
+	return path.Root()
+}
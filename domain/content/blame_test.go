@@ -0,0 +1,72 @@
+package content
+
+import (
+	"contentgit/domain/content/events"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlame(t *testing.T) {
+	t.Run("필드마다 현재 값을 설정한 이벤트를 반환한다", func(t *testing.T) {
+		// given
+		aggregate, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = aggregate.CreateContent(context.Background(), map[string]any{"name": "홍길동", "price": "1000", "taxRate": "10"})
+		_ = aggregate.UpdateField(context.Background(), "name", "홍길동", "고길동", "1", "김영희")
+		_ = aggregate.AddFieldComment(context.Background(), uuid.New().String(), "price", "가격 확인 부탁드려요", "2", "이수민")
+		_ = aggregate.Commit(context.Background(), "commitId", "가격 인상", 3, []events.CommittedField{
+			{FieldName: "price", BeforeValue: "1000", AfterValue: "2000"},
+		}, "3", "박지민")
+		esEvents := toEsEvents(t, aggregate)
+
+		// when
+		blames, err := Blame(context.Background(), esEvents)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []FieldBlame{
+			{FieldName: "name", Version: 2, Timestamp: esEvents[1].CreatedAt, CreatedById: "1", CreatedByName: "김영희"},
+			{FieldName: "price", Version: 4, Timestamp: esEvents[3].CreatedAt, CreatedById: "3", CreatedByName: "박지민"},
+			{FieldName: "taxRate", Version: 1, Timestamp: esEvents[0].CreatedAt},
+		}, blames)
+	})
+
+	t.Run("되돌린 필드는 되돌린 이벤트가 설정한 것으로 본다", func(t *testing.T) {
+		// given
+		aggregateId := uuid.New().String()
+		target, _ := NewContentAggregate(aggregateId, "bettercode")
+		_ = target.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		aggregate, _ := NewContentAggregate(aggregateId, "bettercode")
+		_ = aggregate.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+		_ = aggregate.UpdateField(context.Background(), "name", "홍길동", "고길동", "1", "김영희")
+		_ = aggregate.Revert(context.Background(), target, "2", "이수민")
+
+		// when
+		blames, err := Blame(context.Background(), toEsEvents(t, aggregate))
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(blames))
+		assert.Equal(t, uint64(3), blames[0].Version)
+		assert.Equal(t, "이수민", blames[0].CreatedByName)
+	})
+}
+
+// toEsEvents serializes the uncommitted events of the aggregate as they would be stored, an hour apart.
+func toEsEvents(t *testing.T, aggregate *ContentAggregate) []eventsourcing.Event {
+	createdAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	esEvents := make([]eventsourcing.Event, 0)
+	for i, change := range aggregate.GetChanges() {
+		esEvent, err := NewEventSerializer().SerializeEvent(context.Background(), aggregate, change)
+		assert.NoError(t, err)
+		esEvent.SetVersion(uint64(i + 1))
+		esEvent.CreatedAt = createdAt.Add(time.Duration(i) * time.Hour)
+		esEvents = append(esEvents, esEvent)
+	}
+	return esEvents
+}
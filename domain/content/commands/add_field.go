@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type AddContentField interface {
+	Handle(ctx context.Context, cmd AddContentFieldCommand) error
+}
+
+type AddContentFieldCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	Branch        string `json:"branch"`
+	FieldName     string `json:"fieldName"`
+	Value         any    `json:"value"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type addContentFieldCmdHandler struct {
+	aggregateStore              eventsourcing.AggregateStore
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository
+	assetAggregateStore         eventsourcing.AggregateStore
+}
+
+func (c *addContentFieldCmdHandler) Handle(ctx context.Context, cmd AddContentFieldCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		if err := ensureContentNotDeleted(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.Branch); err != nil {
+			return err
+		}
+
+		contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.Branch)
+		if err != nil {
+			return err
+		}
+		expectedVersion := contentAggregate.GetVersion()
+
+		if err := contentAggregate.AddField(ctx, cmd.FieldName, cmd.Value, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+		if err := ValidateContentFields(ctx, c.contentTypeSchemaRepository, c.aggregateStore, c.assetAggregateStore, contentAggregate, cmd.FieldName); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
+}
+
+func NewAddContentFieldCmdHandler(aggregateStore eventsourcing.AggregateStore,
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository, assetAggregateStore eventsourcing.AggregateStore) *addContentFieldCmdHandler {
+	return &addContentFieldCmdHandler{aggregateStore: aggregateStore, contentTypeSchemaRepository: contentTypeSchemaRepository,
+		assetAggregateStore: assetAggregateStore}
+}
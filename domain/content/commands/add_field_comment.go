@@ -10,10 +10,15 @@ type AddContentFieldComment interface {
 	Handle(ctx context.Context, cmd AddContentFieldCommentCommand) error
 }
 
+// AddContentFieldCommentCommand starts a comment thread on FieldName, on its value in Locale when it is set, or replies
+// to ParentId when it is set.
 type AddContentFieldCommentCommand struct {
 	AggregateID   string `json:"id"`
 	TenantId      string `json:"tenantId"`
+	CommentId     string `json:"commentId"`
+	ParentId      string `json:"parentId"`
 	FieldName     string `json:"fieldName"`
+	Locale        string `json:"locale"`
 	Comment       string `json:"comment"`
 	CreatedById   string `json:"createdById"`
 	CreatedByName string `json:"createdByName"`
@@ -24,21 +29,34 @@ type addContentFieldCommentCmdHandler struct {
 }
 
 func (c *addContentFieldCommentCmdHandler) Handle(ctx context.Context, cmd AddContentFieldCommentCommand) error {
-	contentAggregate, err := content.NewContentAggregate(cmd.AggregateID, cmd.TenantId)
-	if err != nil {
-		return err
-	}
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		contentAggregate, err := content.NewContentAggregate(cmd.AggregateID, cmd.TenantId)
+		if err != nil {
+			return err
+		}
 
-	err = c.aggregateStore.Load(ctx, contentAggregate)
-	if err != nil {
-		return err
-	}
+		err = c.aggregateStore.Load(ctx, contentAggregate)
+		if err != nil {
+			return err
+		}
+		if contentAggregate.GetTenantId() != cmd.TenantId {
+			return eventsourcing.ErrAggregateNotFound
+		}
+		expectedVersion := contentAggregate.GetVersion()
 
-	if err := contentAggregate.AddFieldComment(ctx, cmd.FieldName, cmd.Comment, cmd.CreatedById, cmd.CreatedByName); err != nil {
-		return err
-	}
+		if cmd.ParentId != "" {
+			err = contentAggregate.ReplyToFieldComment(ctx, cmd.CommentId, cmd.ParentId, cmd.Comment, cmd.CreatedById, cmd.CreatedByName)
+		} else if cmd.Locale != "" {
+			err = contentAggregate.AddLocalizedFieldComment(ctx, cmd.CommentId, cmd.FieldName, cmd.Locale, cmd.Comment, cmd.CreatedById, cmd.CreatedByName)
+		} else {
+			err = contentAggregate.AddFieldComment(ctx, cmd.CommentId, cmd.FieldName, cmd.Comment, cmd.CreatedById, cmd.CreatedByName)
+		}
+		if err != nil {
+			return err
+		}
 
-	return c.aggregateStore.Save(ctx, contentAggregate)
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
 }
 
 func NewAddContentFieldCommentCmdHandler(aggregateStore eventsourcing.AggregateStore) *addContentFieldCommentCmdHandler {
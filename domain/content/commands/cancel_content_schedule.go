@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"context"
+)
+
+type CancelContentSchedule interface {
+	Handle(ctx context.Context, cmd CancelContentScheduleCommand) error
+}
+
+// CancelContentScheduleCommand cancels a job that has not run yet, the job is kept as canceled.
+type CancelContentScheduleCommand struct {
+	AggregateID string `json:"id"`
+	TenantId    string `json:"tenantId"`
+	ScheduleId  string `json:"scheduleId"`
+}
+
+type cancelContentScheduleCmdHandler struct {
+	contentScheduleRepository content.ContentScheduleRepository
+}
+
+func (c *cancelContentScheduleCmdHandler) Handle(ctx context.Context, cmd CancelContentScheduleCommand) error {
+	schedule, err := c.contentScheduleRepository.FindByID(ctx, cmd.TenantId, cmd.AggregateID, cmd.ScheduleId)
+	if err != nil {
+		return err
+	}
+
+	if err := schedule.Cancel(); err != nil {
+		return err
+	}
+
+	return c.contentScheduleRepository.Save(ctx, schedule)
+}
+
+func NewCancelContentScheduleCmdHandler(contentScheduleRepository content.ContentScheduleRepository) *cancelContentScheduleCmdHandler {
+	return &cancelContentScheduleCmdHandler{contentScheduleRepository: contentScheduleRepository}
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type ChangeContentStatus interface {
+	Handle(ctx context.Context, cmd ChangeContentStatusCommand) error
+}
+
+// ChangeContentStatusCommand applies an editorial transition to a content following the workflow of its content type.
+// When Version is set the transition applies only while the values of the content are those of that version, the
+// workflow transitions made since do not count. Publishing a version that is already published does nothing.
+type ChangeContentStatusCommand struct {
+	AggregateID   string             `json:"id"`
+	TenantId      string             `json:"tenantId"`
+	Transition    content.Transition `json:"transition"`
+	Reason        string             `json:"reason"`
+	Version       uint64             `json:"version"`
+	CreatedById   string             `json:"createdById"`
+	CreatedByName string             `json:"createdByName"`
+}
+
+type changeContentStatusCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+	workflows      content.Workflows
+}
+
+func (c *changeContentStatusCmdHandler) Handle(ctx context.Context, cmd ChangeContentStatusCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, "")
+		if err != nil {
+			return err
+		}
+		expectedVersion := contentAggregate.GetVersion()
+
+		if cmd.Version != 0 {
+			if cmd.Transition == content.TransitionPublish && contentAggregate.PublishedVersion == cmd.Version {
+				return nil
+			}
+			if err := ensureContentUnchangedSince(ctx, c.aggregateStore, contentAggregate, cmd.Version); err != nil {
+				return err
+			}
+			// the content was published later on, as it was at the version
+			if cmd.Transition == content.TransitionPublish && contentAggregate.PublishedVersion > cmd.Version {
+				return nil
+			}
+		}
+
+		workflow := c.workflows.Of(contentAggregate.ContentType)
+		if err := contentAggregate.ChangeStatus(ctx, workflow, cmd.Transition, cmd.Reason, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
+}
+
+func NewChangeContentStatusCmdHandler(aggregateStore eventsourcing.AggregateStore, workflows content.Workflows) *changeContentStatusCmdHandler {
+	return &changeContentStatusCmdHandler{aggregateStore: aggregateStore, workflows: workflows}
+}
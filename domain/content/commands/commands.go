@@ -1,19 +1,162 @@
 package commands
 
+import (
+	"contentgit/domain/content"
+	"contentgit/domain/content/jsonvalue"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// maxConcurrencyRetries is how many times a command is reloaded and re-applied when another writer saved the same content first.
+const maxConcurrencyRetries = 3
+
 type ContentCommands struct {
 	CreateContent
 	UpdateContentField
 	AddContentFieldComment
+	CreateContentBranch
+	MergeContentBranch
+	RevertContent
+	CommitContent
+	CreateContentTag
+	DeleteContentTag
+	AddContentField
+	RemoveContentField
+	DeleteContent
+	RestoreContent
+	PurgeContent
+	ChangeContentStatus
+	ScheduleContent
+	CancelContentSchedule
+	EditContentFieldComment
+	DeleteContentFieldComment
+	ResolveContentFieldComment
+	CreateContentType
+	UpdateContentType
+	DeleteContentType
+	StartSchemaMigration
+	MigrateContent
 }
 
 func NewContentCommands(
 	createContent CreateContent,
 	updateContentField UpdateContentField,
 	addContentFieldComment AddContentFieldComment,
+	createContentBranch CreateContentBranch,
+	mergeContentBranch MergeContentBranch,
+	revertContent RevertContent,
+	commitContent CommitContent,
+	createContentTag CreateContentTag,
+	deleteContentTag DeleteContentTag,
+	addContentField AddContentField,
+	removeContentField RemoveContentField,
+	deleteContent DeleteContent,
+	restoreContent RestoreContent,
+	purgeContent PurgeContent,
+	changeContentStatus ChangeContentStatus,
+	scheduleContent ScheduleContent,
+	cancelContentSchedule CancelContentSchedule,
+	editContentFieldComment EditContentFieldComment,
+	deleteContentFieldComment DeleteContentFieldComment,
+	resolveContentFieldComment ResolveContentFieldComment,
+	createContentType CreateContentType,
+	updateContentType UpdateContentType,
+	deleteContentType DeleteContentType,
+	startSchemaMigration StartSchemaMigration,
+	migrateContent MigrateContent,
 ) *ContentCommands {
 	return &ContentCommands{
-		CreateContent:          createContent,
-		UpdateContentField:     updateContentField,
-		AddContentFieldComment: addContentFieldComment,
+		CreateContent:              createContent,
+		UpdateContentField:         updateContentField,
+		AddContentFieldComment:     addContentFieldComment,
+		CreateContentBranch:        createContentBranch,
+		MergeContentBranch:         mergeContentBranch,
+		RevertContent:              revertContent,
+		CommitContent:              commitContent,
+		CreateContentTag:           createContentTag,
+		DeleteContentTag:           deleteContentTag,
+		AddContentField:            addContentField,
+		RemoveContentField:         removeContentField,
+		DeleteContent:              deleteContent,
+		RestoreContent:             restoreContent,
+		PurgeContent:               purgeContent,
+		ChangeContentStatus:        changeContentStatus,
+		ScheduleContent:            scheduleContent,
+		CancelContentSchedule:      cancelContentSchedule,
+		EditContentFieldComment:    editContentFieldComment,
+		DeleteContentFieldComment:  deleteContentFieldComment,
+		ResolveContentFieldComment: resolveContentFieldComment,
+		CreateContentType:          createContentType,
+		UpdateContentType:          updateContentType,
+		DeleteContentType:          deleteContentType,
+		StartSchemaMigration:       startSchemaMigration,
+		MigrateContent:             migrateContent,
+	}
+}
+
+// loadContent loads the head of a branch of a content of the tenant, as not found when the content belongs to another tenant.
+// The default branch is loaded when branch is empty.
+func loadContent(ctx context.Context, aggregateStore eventsourcing.AggregateStore, id string, tenantId string, branch string) (*content.ContentAggregate, error) {
+	contentAggregate, err := content.NewContentAggregateOnBranch(id, tenantId, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := aggregateStore.Load(ctx, contentAggregate); err != nil {
+		return nil, err
+	}
+	if contentAggregate.GetVersion() == 0 || contentAggregate.GetTenantId() != tenantId {
+		return nil, eventsourcing.ErrAggregateNotFound
+	}
+
+	return contentAggregate, nil
+}
+
+// ensureContentNotDeleted rejects commands on a branch of a content deleted or purged on the default branch.
+// Commands on the default branch are rejected by the aggregate itself.
+func ensureContentNotDeleted(ctx context.Context, aggregateStore eventsourcing.AggregateStore, id string, tenantId string, branch string) error {
+	if branch == "" || branch == eventsourcing.DefaultBranch {
+		return nil
+	}
+
+	contentAggregate, err := content.NewContentAggregate(id, tenantId)
+	if err != nil {
+		return err
+	}
+	if err := aggregateStore.Load(ctx, contentAggregate); err != nil {
+		return err
+	}
+	if contentAggregate.Purged {
+		return content.ErrContentPurged
+	}
+	if contentAggregate.Deleted {
+		return content.ErrContentDeleted
+	}
+	return nil
+}
+
+// ensureContentUnchangedSince rejects a version of the content whose values were changed since. The events leaving
+// the values as they are, such as workflow transitions and comments, do not count.
+func ensureContentUnchangedSince(ctx context.Context, aggregateStore eventsourcing.AggregateStore, contentAggregate *content.ContentAggregate, version uint64) error {
+	currentVersion := contentAggregate.GetVersion()
+	if version == currentVersion {
+		return nil
+	}
+	if version > currentVersion {
+		return errors.Wrapf(content.ErrVersionNotCurrent, "version: %d, current version: %d", version, currentVersion)
+	}
+
+	versionAggregate, err := content.NewContentAggregate(contentAggregate.GetID(), contentAggregate.GetTenantId())
+	if err != nil {
+		return err
+	}
+	if err := aggregateStore.LoadAtVersion(ctx, versionAggregate, version); err != nil {
+		return err
+	}
+	if !jsonvalue.Equal(versionAggregate.Content, contentAggregate.Content) {
+		return errors.Wrapf(content.ErrVersionNotCurrent, "content changed since version: %d, current version: %d", version, currentVersion)
 	}
+	return nil
 }
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"contentgit/domain/content/events"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type CommitContent interface {
+	Handle(ctx context.Context, cmd CommitContentCommand) error
+}
+
+// CommitContentCommand updates several fields of a content on Branch at once.
+// ParentVersion is the version the changes were made on, the commit is rejected if the content has moved since.
+type CommitContentCommand struct {
+	AggregateID   string                  `json:"id"`
+	TenantId      string                  `json:"tenantId"`
+	Branch        string                  `json:"branch"`
+	CommitId      string                  `json:"commitId"`
+	Message       string                  `json:"message"`
+	ParentVersion uint64                  `json:"parentVersion"`
+	Fields        []events.CommittedField `json:"fields"`
+	CreatedById   string                  `json:"createdById"`
+	CreatedByName string                  `json:"createdByName"`
+}
+
+type commitContentCmdHandler struct {
+	aggregateStore              eventsourcing.AggregateStore
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository
+	assetAggregateStore         eventsourcing.AggregateStore
+}
+
+func (c *commitContentCmdHandler) Handle(ctx context.Context, cmd CommitContentCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		if err := ensureContentNotDeleted(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.Branch); err != nil {
+			return err
+		}
+
+		contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.Branch)
+		if err != nil {
+			return err
+		}
+		expectedVersion := contentAggregate.GetVersion()
+
+		if err := contentAggregate.Commit(ctx, cmd.CommitId, cmd.Message, cmd.ParentVersion, cmd.Fields, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+		if err := ValidateContentFields(ctx, c.contentTypeSchemaRepository, c.aggregateStore, c.assetAggregateStore, contentAggregate, committedFieldNames(cmd.Fields)...); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
+}
+
+func NewCommitContentCmdHandler(aggregateStore eventsourcing.AggregateStore,
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository, assetAggregateStore eventsourcing.AggregateStore) *commitContentCmdHandler {
+	return &commitContentCmdHandler{aggregateStore: aggregateStore, contentTypeSchemaRepository: contentTypeSchemaRepository,
+		assetAggregateStore: assetAggregateStore}
+}
+
+func committedFieldNames(fields []events.CommittedField) []string {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.FieldName)
+	}
+	return names
+}
@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type CreateContentBranch interface {
+	Handle(ctx context.Context, cmd CreateContentBranchCommand) error
+}
+
+type CreateContentBranchCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	Branch        string `json:"branch"`
+	SourceBranch  string `json:"sourceBranch"`
+	SourceVersion uint64 `json:"sourceVersion"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type createContentBranchCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+}
+
+func (c *createContentBranchCmdHandler) Handle(ctx context.Context, cmd CreateContentBranchCommand) error {
+	if err := ensureContentNotDeleted(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.SourceBranch); err != nil {
+		return err
+	}
+
+	branchAggregate, err := content.NewContentAggregateOnBranch(cmd.AggregateID, cmd.TenantId, cmd.Branch)
+	if err != nil {
+		return err
+	}
+
+	exists, err := c.aggregateStore.Exists(ctx, cmd.AggregateID, branchAggregate.GetBranch())
+	if err != nil {
+		return err
+	}
+	if exists {
+		return content.ErrBranchAlreadyExists
+	}
+
+	sourceAggregate, err := content.NewContentAggregateOnBranch(cmd.AggregateID, cmd.TenantId, cmd.SourceBranch)
+	if err != nil {
+		return err
+	}
+
+	if cmd.SourceVersion > 0 {
+		err = c.aggregateStore.LoadAtVersion(ctx, sourceAggregate, cmd.SourceVersion)
+	} else {
+		err = c.aggregateStore.Load(ctx, sourceAggregate)
+	}
+	if err != nil {
+		return err
+	}
+	if sourceAggregate.GetVersion() == 0 || sourceAggregate.GetTenantId() != cmd.TenantId {
+		return eventsourcing.ErrAggregateNotFound
+	}
+
+	if err := branchAggregate.CreateBranch(ctx, sourceAggregate, cmd.CreatedById, cmd.CreatedByName); err != nil {
+		return err
+	}
+
+	return c.aggregateStore.Save(ctx, branchAggregate, 0)
+}
+
+func NewCreateContentBranchCmdHandler(aggregateStore eventsourcing.AggregateStore) *createContentBranchCmdHandler {
+	return &createContentBranchCmdHandler{aggregateStore: aggregateStore}
+}
@@ -18,17 +18,22 @@ type CreateContentCommand struct {
 }
 
 type createContentCmdHandler struct {
-	aggregateStore eventsourcing.AggregateStore
+	aggregateStore              eventsourcing.AggregateStore
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository
+	assetAggregateStore         eventsourcing.AggregateStore
 }
 
 func (c *createContentCmdHandler) Handle(ctx context.Context, cmd CreateContentCommand) error {
-	exists, err := c.aggregateStore.Exists(ctx, cmd.AggregateID)
+	exists, err := c.aggregateStore.Exists(ctx, cmd.AggregateID, eventsourcing.DefaultBranch)
 	if err != nil {
 		return err
 	}
 	if exists {
 		return content.ErrContentAlreadyExists
 	}
+	if err := validateContent(ctx, c.contentTypeSchemaRepository, c.aggregateStore, c.assetAggregateStore, cmd.TenantID, cmd.ContentType, cmd.Content); err != nil {
+		return err
+	}
 
 	contentAggregate, err := content.NewContentAggregateWithType(cmd.AggregateID, cmd.TenantID, cmd.ContentType)
 	if err != nil {
@@ -40,9 +45,11 @@ func (c *createContentCmdHandler) Handle(ctx context.Context, cmd CreateContentC
 		return err
 	}
 
-	return c.aggregateStore.Save(ctx, contentAggregate)
+	return c.aggregateStore.Save(ctx, contentAggregate, 0)
 }
 
-func NewCreateUserSessionCmdHandler(aggregateStore eventsourcing.AggregateStore) *createContentCmdHandler {
-	return &createContentCmdHandler{aggregateStore: aggregateStore}
+func NewCreateUserSessionCmdHandler(aggregateStore eventsourcing.AggregateStore,
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository, assetAggregateStore eventsourcing.AggregateStore) *createContentCmdHandler {
+	return &createContentCmdHandler{aggregateStore: aggregateStore, contentTypeSchemaRepository: contentTypeSchemaRepository,
+		assetAggregateStore: assetAggregateStore}
 }
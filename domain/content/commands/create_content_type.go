@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type CreateContentType interface {
+	Handle(ctx context.Context, cmd CreateContentTypeCommand) error
+}
+
+type CreateContentTypeCommand struct {
+	TenantId      string                    `json:"tenantId"`
+	ContentType   string                    `json:"contentType"`
+	Description   string                    `json:"description"`
+	Fields        []content.FieldDefinition `json:"fields"`
+	CreatedById   string                    `json:"createdById"`
+	CreatedByName string                    `json:"createdByName"`
+}
+
+type createContentTypeCmdHandler struct {
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository
+}
+
+// Handle creates the first version of the schema, or the version following the deleted one of a deleted content type.
+func (c *createContentTypeCmdHandler) Handle(ctx context.Context, cmd CreateContentTypeCommand) error {
+	previous, err := c.contentTypeSchemaRepository.FindLatest(ctx, cmd.TenantId, cmd.ContentType)
+	if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+		return err
+	}
+	if previous != nil && !previous.Deleted {
+		return errors.Wrapf(content.ErrContentTypeExists, "content type: %s", cmd.ContentType)
+	}
+
+	schema, err := content.NewContentTypeSchema(cmd.TenantId, cmd.ContentType, previous, cmd.Description, cmd.Fields, nil,
+		cmd.CreatedById, cmd.CreatedByName)
+	if err != nil {
+		return err
+	}
+
+	return createContentTypeSchema(ctx, c.contentTypeSchemaRepository, schema)
+}
+
+func NewCreateContentTypeCmdHandler(contentTypeSchemaRepository content.ContentTypeSchemaRepository) *createContentTypeCmdHandler {
+	return &createContentTypeCmdHandler{contentTypeSchemaRepository: contentTypeSchemaRepository}
+}
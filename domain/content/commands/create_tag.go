@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type CreateContentTag interface {
+	Handle(ctx context.Context, cmd CreateContentTagCommand) error
+}
+
+// CreateContentTagCommand names Version of Branch with Tag, the latest version is tagged when Version is zero.
+type CreateContentTagCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	Tag           string `json:"tag"`
+	Branch        string `json:"branch"`
+	Version       uint64 `json:"version"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type createContentTagCmdHandler struct {
+	aggregateStore       eventsourcing.AggregateStore
+	contentTagRepository content.ContentTagRepository
+}
+
+func (c *createContentTagCmdHandler) Handle(ctx context.Context, cmd CreateContentTagCommand) error {
+	contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.Branch)
+	if err != nil {
+		return err
+	}
+	if contentAggregate.Purged {
+		return content.ErrContentPurged
+	}
+	if contentAggregate.Deleted {
+		return content.ErrContentDeleted
+	}
+	if err := ensureContentNotDeleted(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.Branch); err != nil {
+		return err
+	}
+
+	version := cmd.Version
+	if version == 0 {
+		version = contentAggregate.GetVersion()
+	}
+	if version > contentAggregate.GetVersion() {
+		return errors.Wrapf(eventsourcing.ErrVersionNotFound, "version: %d", version)
+	}
+
+	tag, err := content.NewContentTag(cmd.TenantId, cmd.AggregateID, cmd.Tag, contentAggregate.GetBranch(), version, cmd.CreatedById, cmd.CreatedByName)
+	if err != nil {
+		return err
+	}
+
+	if err := c.contentTagRepository.Create(ctx, *tag); err != nil {
+		if errors.Is(err, persistence.ErrDuplicateRecord) {
+			return errors.Wrapf(content.ErrTagAlreadyExists, "tag: %s", cmd.Tag)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func NewCreateContentTagCmdHandler(aggregateStore eventsourcing.AggregateStore, contentTagRepository content.ContentTagRepository) *createContentTagCmdHandler {
+	return &createContentTagCmdHandler{aggregateStore: aggregateStore, contentTagRepository: contentTagRepository}
+}
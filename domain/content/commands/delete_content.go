@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type DeleteContent interface {
+	Handle(ctx context.Context, cmd DeleteContentCommand) error
+}
+
+// DeleteContentCommand moves a content to the trash, the content and every branch of it are read-only until restored.
+// A content other contents reference cannot be deleted, the references have to be removed first.
+// The references are read from the reverse reference projection, which catches up with the content events
+// asynchronously: a reference saved just before the delete, within the lag of the projection, is not seen and the
+// content is deleted anyway. Such a reference points at a content in the trash and is listed among the references to
+// it once projected, it is resolved by restoring the content or by removing the reference.
+type DeleteContentCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type deleteContentCmdHandler struct {
+	aggregateStore                       eventsourcing.AggregateStore
+	contentReferenceProjectionRepository content.ContentReferenceProjectionRepository
+}
+
+func (c *deleteContentCmdHandler) Handle(ctx context.Context, cmd DeleteContentCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, "")
+		if err != nil {
+			return err
+		}
+		expectedVersion := contentAggregate.GetVersion()
+
+		if err := contentAggregate.Delete(ctx, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+		referenced, err := c.contentReferenceProjectionRepository.ExistsByTargetId(ctx, cmd.TenantId, cmd.AggregateID)
+		if err != nil {
+			return err
+		}
+		if referenced {
+			return errors.Wrapf(content.ErrContentReferenced, "content: %s", cmd.AggregateID)
+		}
+
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
+}
+
+func NewDeleteContentCmdHandler(aggregateStore eventsourcing.AggregateStore,
+	contentReferenceProjectionRepository content.ContentReferenceProjectionRepository) *deleteContentCmdHandler {
+	return &deleteContentCmdHandler{aggregateStore: aggregateStore, contentReferenceProjectionRepository: contentReferenceProjectionRepository}
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"context"
+)
+
+type DeleteContentType interface {
+	Handle(ctx context.Context, cmd DeleteContentTypeCommand) error
+}
+
+type DeleteContentTypeCommand struct {
+	TenantId      string `json:"tenantId"`
+	ContentType   string `json:"contentType"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type deleteContentTypeCmdHandler struct {
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository
+}
+
+// Handle adds a deleted version of the schema, the contents of the content type are kept but no longer validated.
+func (c *deleteContentTypeCmdHandler) Handle(ctx context.Context, cmd DeleteContentTypeCommand) error {
+	previous, err := findContentTypeSchema(ctx, c.contentTypeSchemaRepository, cmd.TenantId, cmd.ContentType)
+	if err != nil {
+		return err
+	}
+
+	schema := content.NewDeletedContentTypeSchema(previous, cmd.CreatedById, cmd.CreatedByName)
+	return createContentTypeSchema(ctx, c.contentTypeSchemaRepository, schema)
+}
+
+func NewDeleteContentTypeCmdHandler(contentTypeSchemaRepository content.ContentTypeSchemaRepository) *deleteContentTypeCmdHandler {
+	return &deleteContentTypeCmdHandler{contentTypeSchemaRepository: contentTypeSchemaRepository}
+}
@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type DeleteContentFieldComment interface {
+	Handle(ctx context.Context, cmd DeleteContentFieldCommentCommand) error
+}
+
+type DeleteContentFieldCommentCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	CommentId     string `json:"commentId"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type deleteContentFieldCommentCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+}
+
+func (c *deleteContentFieldCommentCmdHandler) Handle(ctx context.Context, cmd DeleteContentFieldCommentCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, "")
+		if err != nil {
+			return err
+		}
+		expectedVersion := contentAggregate.GetVersion()
+
+		if err := contentAggregate.DeleteFieldComment(ctx, cmd.CommentId, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
+}
+
+func NewDeleteContentFieldCommentCmdHandler(aggregateStore eventsourcing.AggregateStore) *deleteContentFieldCommentCmdHandler {
+	return &deleteContentFieldCommentCmdHandler{aggregateStore: aggregateStore}
+}
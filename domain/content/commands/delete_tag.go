@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"context"
+)
+
+type DeleteContentTag interface {
+	Handle(ctx context.Context, cmd DeleteContentTagCommand) error
+}
+
+type DeleteContentTagCommand struct {
+	AggregateID string `json:"id"`
+	TenantId    string `json:"tenantId"`
+	Tag         string `json:"tag"`
+}
+
+type deleteContentTagCmdHandler struct {
+	contentTagRepository content.ContentTagRepository
+}
+
+func (c *deleteContentTagCmdHandler) Handle(ctx context.Context, cmd DeleteContentTagCommand) error {
+	return c.contentTagRepository.Delete(ctx, cmd.TenantId, cmd.AggregateID, cmd.Tag)
+}
+
+func NewDeleteContentTagCmdHandler(contentTagRepository content.ContentTagRepository) *deleteContentTagCmdHandler {
+	return &deleteContentTagCmdHandler{contentTagRepository: contentTagRepository}
+}
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type EditContentFieldComment interface {
+	Handle(ctx context.Context, cmd EditContentFieldCommentCommand) error
+}
+
+type EditContentFieldCommentCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	CommentId     string `json:"commentId"`
+	Comment       string `json:"comment"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type editContentFieldCommentCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+}
+
+func (c *editContentFieldCommentCmdHandler) Handle(ctx context.Context, cmd EditContentFieldCommentCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, "")
+		if err != nil {
+			return err
+		}
+		expectedVersion := contentAggregate.GetVersion()
+
+		if err := contentAggregate.EditFieldComment(ctx, cmd.CommentId, cmd.Comment, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
+}
+
+func NewEditContentFieldCommentCmdHandler(aggregateStore eventsourcing.AggregateStore) *editContentFieldCommentCmdHandler {
+	return &editContentFieldCommentCmdHandler{aggregateStore: aggregateStore}
+}
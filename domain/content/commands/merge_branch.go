@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"contentgit/domain/content/events"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type MergeContentBranch interface {
+	Handle(ctx context.Context, cmd MergeContentBranchCommand) error
+}
+
+// MergeContentBranchCommand merges SourceBranch into TargetBranch.
+// SourceVersion and TargetVersion are optional, when given the merge is rejected with eventsourcing.ErrConcurrencyConflict
+// if a branch has moved since the conflicts were reviewed.
+type MergeContentBranchCommand struct {
+	AggregateID   string         `json:"id"`
+	TenantId      string         `json:"tenantId"`
+	SourceBranch  string         `json:"sourceBranch"`
+	TargetBranch  string         `json:"targetBranch"`
+	SourceVersion uint64         `json:"sourceVersion"`
+	TargetVersion uint64         `json:"targetVersion"`
+	Resolutions   map[string]any `json:"resolutions"`
+	Removals      []string       `json:"removals"`
+	CreatedById   string         `json:"createdById"`
+	CreatedByName string         `json:"createdByName"`
+}
+
+type mergeContentBranchCmdHandler struct {
+	aggregateStore              eventsourcing.AggregateStore
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository
+	assetAggregateStore         eventsourcing.AggregateStore
+}
+
+func (c *mergeContentBranchCmdHandler) Handle(ctx context.Context, cmd MergeContentBranchCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		if err := ensureContentNotDeleted(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.TargetBranch); err != nil {
+			return err
+		}
+
+		participants, err := content.LoadMergeParticipants(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.SourceBranch, cmd.TargetBranch)
+		if err != nil {
+			return err
+		}
+
+		if cmd.SourceVersion > 0 && cmd.SourceVersion != participants.Source.GetVersion() {
+			return eventsourcing.ErrConcurrencyConflict
+		}
+		if cmd.TargetVersion > 0 && cmd.TargetVersion != participants.Target.GetVersion() {
+			return eventsourcing.ErrConcurrencyConflict
+		}
+
+		expectedVersion := participants.Target.GetVersion()
+		if err := participants.Target.Merge(ctx, participants.Source, participants.Base, cmd.Resolutions, cmd.Removals, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+		if err := ValidateContentFields(ctx, c.contentTypeSchemaRepository, c.aggregateStore, c.assetAggregateStore, participants.Target,
+			mergedFieldNames(participants.Target)...); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, participants.Target, expectedVersion)
+	})
+}
+
+// mergedFieldNames returns the names of the fields a merge changed or removed, read from the merge event it applied.
+func mergedFieldNames(contentAggregate *content.ContentAggregate) []string {
+	names := make([]string, 0)
+	for _, change := range contentAggregate.GetChanges() {
+		merged, ok := change.(*events.ContentMergedEventV1)
+		if !ok {
+			continue
+		}
+		for _, field := range merged.Fields {
+			names = append(names, field.FieldName)
+		}
+	}
+	return names
+}
+
+func NewMergeContentBranchCmdHandler(aggregateStore eventsourcing.AggregateStore, contentTypeSchemaRepository content.ContentTypeSchemaRepository,
+	assetAggregateStore eventsourcing.AggregateStore) *mergeContentBranchCmdHandler {
+	return &mergeContentBranchCmdHandler{aggregateStore: aggregateStore, contentTypeSchemaRepository: contentTypeSchemaRepository,
+		assetAggregateStore: assetAggregateStore}
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"contentgit/domain/content/events"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+// MigrateContent returns the fields the migration changed, unlike the other commands, so that a schema migration
+// can report them.
+type MigrateContent interface {
+	Handle(ctx context.Context, cmd MigrateContentCommand) ([]events.MigratedField, error)
+}
+
+// MigrateContentCommand migrates a content with the migration steps of Schemas, the consecutive versions of the schema
+// of its content type up to the version it is migrated to. A dry run leaves the content as it is.
+type MigrateContentCommand struct {
+	AggregateID   string                      `json:"id"`
+	TenantId      string                      `json:"tenantId"`
+	MigrationId   string                      `json:"migrationId"`
+	Schemas       []content.ContentTypeSchema `json:"schemas"`
+	DryRun        bool                        `json:"dryRun"`
+	CreatedById   string                      `json:"createdById"`
+	CreatedByName string                      `json:"createdByName"`
+}
+
+type migrateContentCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+}
+
+func (c *migrateContentCmdHandler) Handle(ctx context.Context, cmd MigrateContentCommand) ([]events.MigratedField, error) {
+	var fields []events.MigratedField
+	err := eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, "")
+		if err != nil {
+			return err
+		}
+		expectedVersion := contentAggregate.GetVersion()
+
+		if err := contentAggregate.Migrate(ctx, cmd.MigrationId, cmd.Schemas, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+
+		changes := contentAggregate.GetChanges()
+		fields = changes[len(changes)-1].(*events.ContentMigratedEventV1).Fields
+		if cmd.DryRun {
+			return nil
+		}
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
+
+	return fields, err
+}
+
+func NewMigrateContentCmdHandler(aggregateStore eventsourcing.AggregateStore) *migrateContentCmdHandler {
+	return &migrateContentCmdHandler{aggregateStore: aggregateStore}
+}
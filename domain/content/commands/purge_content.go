@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type PurgeContent interface {
+	Handle(ctx context.Context, cmd PurgeContentCommand) error
+}
+
+// PurgeContentCommand erases the personal data of a content for good by destroying the key its personal data fields
+// were encrypted with. The snapshots, which hold the decrypted values, are deleted and the content is read-only afterwards.
+type PurgeContentCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type purgeContentCmdHandler struct {
+	aggregateStore       eventsourcing.AggregateStore
+	contentKeyRepository content.ContentKeyRepository
+	personalDataFields   content.PersonalDataFields
+}
+
+func (c *purgeContentCmdHandler) Handle(ctx context.Context, cmd PurgeContentCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, "")
+		if err != nil {
+			return err
+		}
+		expectedVersion := contentAggregate.GetVersion()
+
+		fields := c.personalDataFields.Of(contentAggregate.ContentType)
+		if err := contentAggregate.Purge(ctx, fields, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+
+		if err := c.aggregateStore.Save(ctx, contentAggregate, expectedVersion); err != nil {
+			return err
+		}
+
+		if err := c.contentKeyRepository.Destroy(ctx, contentAggregate.GetTenantId(), cmd.AggregateID); err != nil {
+			return err
+		}
+		return c.aggregateStore.DeleteSnapshots(ctx, cmd.AggregateID)
+	})
+}
+
+func NewPurgeContentCmdHandler(aggregateStore eventsourcing.AggregateStore, contentKeyRepository content.ContentKeyRepository,
+	personalDataFields content.PersonalDataFields) *purgeContentCmdHandler {
+	return &purgeContentCmdHandler{aggregateStore: aggregateStore, contentKeyRepository: contentKeyRepository, personalDataFields: personalDataFields}
+}
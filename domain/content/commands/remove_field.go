@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type RemoveContentField interface {
+	Handle(ctx context.Context, cmd RemoveContentFieldCommand) error
+}
+
+type RemoveContentFieldCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	Branch        string `json:"branch"`
+	FieldName     string `json:"fieldName"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type removeContentFieldCmdHandler struct {
+	aggregateStore              eventsourcing.AggregateStore
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository
+	assetAggregateStore         eventsourcing.AggregateStore
+}
+
+func (c *removeContentFieldCmdHandler) Handle(ctx context.Context, cmd RemoveContentFieldCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		if err := ensureContentNotDeleted(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.Branch); err != nil {
+			return err
+		}
+
+		contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.Branch)
+		if err != nil {
+			return err
+		}
+		expectedVersion := contentAggregate.GetVersion()
+
+		if err := contentAggregate.RemoveField(ctx, cmd.FieldName, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+		if err := ValidateContentFields(ctx, c.contentTypeSchemaRepository, c.aggregateStore, c.assetAggregateStore, contentAggregate, cmd.FieldName); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
+}
+
+func NewRemoveContentFieldCmdHandler(aggregateStore eventsourcing.AggregateStore,
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository, assetAggregateStore eventsourcing.AggregateStore) *removeContentFieldCmdHandler {
+	return &removeContentFieldCmdHandler{aggregateStore: aggregateStore, contentTypeSchemaRepository: contentTypeSchemaRepository,
+		assetAggregateStore: assetAggregateStore}
+}
@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type ResolveContentFieldComment interface {
+	Handle(ctx context.Context, cmd ResolveContentFieldCommentCommand) error
+}
+
+// ResolveContentFieldCommentCommand resolves the thread started by CommentId, or reopens it when Resolved is false.
+type ResolveContentFieldCommentCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	CommentId     string `json:"commentId"`
+	Resolved      bool   `json:"resolved"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type resolveContentFieldCommentCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+}
+
+func (c *resolveContentFieldCommentCmdHandler) Handle(ctx context.Context, cmd ResolveContentFieldCommentCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, "")
+		if err != nil {
+			return err
+		}
+		expectedVersion := contentAggregate.GetVersion()
+
+		if cmd.Resolved {
+			err = contentAggregate.ResolveFieldComment(ctx, cmd.CommentId, cmd.CreatedById, cmd.CreatedByName)
+		} else {
+			err = contentAggregate.ReopenFieldComment(ctx, cmd.CommentId, cmd.CreatedById, cmd.CreatedByName)
+		}
+		if err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
+}
+
+func NewResolveContentFieldCommentCmdHandler(aggregateStore eventsourcing.AggregateStore) *resolveContentFieldCommentCmdHandler {
+	return &resolveContentFieldCommentCmdHandler{aggregateStore: aggregateStore}
+}
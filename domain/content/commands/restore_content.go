@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type RestoreContent interface {
+	Handle(ctx context.Context, cmd RestoreContentCommand) error
+}
+
+// RestoreContentCommand brings a deleted content back from the trash.
+type RestoreContentCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type restoreContentCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+}
+
+func (c *restoreContentCmdHandler) Handle(ctx context.Context, cmd RestoreContentCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, "")
+		if err != nil {
+			return err
+		}
+		expectedVersion := contentAggregate.GetVersion()
+
+		if err := contentAggregate.Restore(ctx, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
+}
+
+func NewRestoreContentCmdHandler(aggregateStore eventsourcing.AggregateStore) *restoreContentCmdHandler {
+	return &restoreContentCmdHandler{aggregateStore: aggregateStore}
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+)
+
+type RevertContent interface {
+	Handle(ctx context.Context, cmd RevertContentCommand) error
+}
+
+// RevertContentCommand reverts the fields of a content on Branch to the values they had at TargetVersion.
+type RevertContentCommand struct {
+	AggregateID   string `json:"id"`
+	TenantId      string `json:"tenantId"`
+	Branch        string `json:"branch"`
+	TargetVersion uint64 `json:"targetVersion"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type revertContentCmdHandler struct {
+	aggregateStore eventsourcing.AggregateStore
+}
+
+func (c *revertContentCmdHandler) Handle(ctx context.Context, cmd RevertContentCommand) error {
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		if err := ensureContentNotDeleted(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.Branch); err != nil {
+			return err
+		}
+
+		contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.Branch)
+		if err != nil {
+			return err
+		}
+		expectedVersion := contentAggregate.GetVersion()
+
+		if cmd.TargetVersion == 0 || cmd.TargetVersion >= expectedVersion {
+			return eventsourcing.ErrVersionNotFound
+		}
+
+		targetAggregate, err := content.NewContentAggregateOnBranch(cmd.AggregateID, cmd.TenantId, cmd.Branch)
+		if err != nil {
+			return err
+		}
+		if err := c.aggregateStore.LoadAtVersion(ctx, targetAggregate, cmd.TargetVersion); err != nil {
+			return err
+		}
+
+		if err := contentAggregate.Revert(ctx, targetAggregate, cmd.CreatedById, cmd.CreatedByName); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
+}
+
+func NewRevertContentCmdHandler(aggregateStore eventsourcing.AggregateStore) *revertContentCmdHandler {
+	return &revertContentCmdHandler{aggregateStore: aggregateStore}
+}
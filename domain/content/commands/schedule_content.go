@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type ScheduleContent interface {
+	Handle(ctx context.Context, cmd ScheduleContentCommand) error
+}
+
+// ScheduleContentCommand schedules a publish or an unpublish of a content at RunAt. A publish promotes the content as
+// it was at Version, the current version when Version is zero, and fails when the values of the content were changed
+// since. The workflow of the content type has to be able to reach the transition from the current status, a publish
+// can be scheduled before the content is approved.
+type ScheduleContentCommand struct {
+	ScheduleId    string             `json:"scheduleId"`
+	AggregateID   string             `json:"id"`
+	TenantId      string             `json:"tenantId"`
+	Transition    content.Transition `json:"transition"`
+	Version       uint64             `json:"version"`
+	RunAt         time.Time          `json:"runAt"`
+	CreatedById   string             `json:"createdById"`
+	CreatedByName string             `json:"createdByName"`
+}
+
+type scheduleContentCmdHandler struct {
+	aggregateStore            eventsourcing.AggregateStore
+	contentScheduleRepository content.ContentScheduleRepository
+	workflows                 content.Workflows
+	clock                     content.Clock
+}
+
+func (c *scheduleContentCmdHandler) Handle(ctx context.Context, cmd ScheduleContentCommand) error {
+	contentAggregate, err := loadContent(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, "")
+	if err != nil {
+		return err
+	}
+	if contentAggregate.Purged {
+		return content.ErrContentPurged
+	}
+	if contentAggregate.Deleted {
+		return content.ErrContentDeleted
+	}
+
+	version := cmd.Version
+	if cmd.Transition == content.TransitionPublish {
+		if version == 0 {
+			version = contentAggregate.GetVersion()
+		}
+		if err := ensureContentUnchangedSince(ctx, c.aggregateStore, contentAggregate, version); err != nil {
+			return err
+		}
+	}
+
+	schedule, err := content.NewContentSchedule(cmd.ScheduleId, cmd.TenantId, cmd.AggregateID, cmd.Transition, version, cmd.RunAt,
+		c.clock.Now(), cmd.CreatedById, cmd.CreatedByName)
+	if err != nil {
+		return err
+	}
+	status := contentAggregate.CurrentStatus()
+	if !c.workflows.Of(contentAggregate.ContentType).Reaches(cmd.Transition, status) {
+		return errors.Wrapf(content.ErrTransitionNotAllowed, "transition: %s, status: %s", cmd.Transition, status)
+	}
+
+	return c.contentScheduleRepository.Create(ctx, *schedule)
+}
+
+func NewScheduleContentCmdHandler(aggregateStore eventsourcing.AggregateStore, contentScheduleRepository content.ContentScheduleRepository,
+	workflows content.Workflows, clock content.Clock) *scheduleContentCmdHandler {
+	return &scheduleContentCmdHandler{aggregateStore: aggregateStore, contentScheduleRepository: contentScheduleRepository, workflows: workflows,
+		clock: clock}
+}
@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"contentgit/domain/asset"
+	"contentgit/domain/content"
+	"contentgit/domain/content/jsonpointer"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// findContentTypeSchema returns the latest schema of a content type that is not deleted.
+func findContentTypeSchema(ctx context.Context, contentTypeSchemaRepository content.ContentTypeSchemaRepository,
+	tenantId string, contentType string) (*content.ContentTypeSchema, error) {
+	schema, err := contentTypeSchemaRepository.FindLatest(ctx, tenantId, contentType)
+	if errors.Is(err, persistence.ErrRecordNotFound) || (err == nil && schema.Deleted) {
+		return nil, errors.Wrapf(content.ErrContentTypeNotFound, "content type: %s", contentType)
+	}
+	return schema, err
+}
+
+func createContentTypeSchema(ctx context.Context, contentTypeSchemaRepository content.ContentTypeSchemaRepository,
+	schema *content.ContentTypeSchema) error {
+	if err := contentTypeSchemaRepository.Create(ctx, *schema); err != nil {
+		if errors.Is(err, persistence.ErrDuplicateRecord) {
+			return errors.Wrapf(content.ErrSchemaChanged, "content type: %s, version: %d", schema.ContentType, schema.Version)
+		}
+		return err
+	}
+	return nil
+}
+
+// validateContent checks a new content against the schema of its content type and the contents and assets its reference
+// and asset fields reference, a content type without a schema accepts any content.
+func validateContent(ctx context.Context, contentTypeSchemaRepository content.ContentTypeSchemaRepository,
+	aggregateStore eventsourcing.AggregateStore, assetAggregateStore eventsourcing.AggregateStore, tenantId string, contentType string,
+	document map[string]any) error {
+	schema, err := findContentTypeSchema(ctx, contentTypeSchemaRepository, tenantId, contentType)
+	if errors.Is(err, content.ErrContentTypeNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := schema.Validate(document); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(document))
+	for name := range document {
+		names = append(names, name)
+	}
+	if err := schema.ValidateReferences(document, referenceExists(ctx, aggregateStore, tenantId), names...); err != nil {
+		return err
+	}
+	return schema.ValidateAssetReferences(document, assetExists(ctx, assetAggregateStore, tenantId), names...)
+}
+
+// ValidateContentFields checks the top-level fields of the changed fields of a content against the schema of its content type
+// and the contents and assets they reference. Change requests merged into a content are checked with it as well.
+func ValidateContentFields(ctx context.Context, contentTypeSchemaRepository content.ContentTypeSchemaRepository,
+	aggregateStore eventsourcing.AggregateStore, assetAggregateStore eventsourcing.AggregateStore,
+	contentAggregate *content.ContentAggregate, fieldNames ...string) error {
+	schema, err := findContentTypeSchema(ctx, contentTypeSchemaRepository, contentAggregate.GetTenantId(), contentAggregate.ContentType)
+	if errors.Is(err, content.ErrContentTypeNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(fieldNames))
+	for _, fieldName := range fieldNames {
+		path, err := jsonpointer.Parse(fieldName)
+		if err != nil {
+			return errors.Wrap(content.ErrInvalidFieldName, err.Error())
+		}
+		names = append(names, path.Root())
+	}
+	if err := schema.ValidateFields(contentAggregate.Content, names...); err != nil {
+		return err
+	}
+	if err := schema.ValidateReferences(contentAggregate.Content, referenceExists(ctx, aggregateStore, contentAggregate.GetTenantId()), names...); err != nil {
+		return err
+	}
+	return schema.ValidateAssetReferences(contentAggregate.Content, assetExists(ctx, assetAggregateStore, contentAggregate.GetTenantId()), names...)
+}
+
+// referenceExists tells whether the content a reference points at exists in the tenant with the content type of the
+// reference and is not deleted. The aggregate is read rather than its projection, which may not have caught up with
+// a content just created.
+func referenceExists(ctx context.Context, aggregateStore eventsourcing.AggregateStore, tenantId string) func(content.ContentReference) (bool, error) {
+	return func(reference content.ContentReference) (bool, error) {
+		target, err := content.NewContentAggregate(reference.Id, tenantId)
+		if err != nil {
+			return false, err
+		}
+		if err := aggregateStore.Load(ctx, target); err != nil {
+			return false, err
+		}
+		return target.GetVersion() > 0 && target.GetTenantId() == tenantId && !target.Deleted && !target.Purged &&
+			target.ContentType == reference.ContentType, nil
+	}
+}
+
+// assetExists tells whether an asset of the tenant was uploaded and is not deleted, read from its aggregate like the
+// contents a reference points at.
+func assetExists(ctx context.Context, assetAggregateStore eventsourcing.AggregateStore, tenantId string) func(string) (bool, error) {
+	return func(assetId string) (bool, error) {
+		assetAggregate, err := asset.NewAssetAggregate(assetId, tenantId)
+		if err != nil {
+			return false, err
+		}
+		if err := assetAggregateStore.Load(ctx, assetAggregate); err != nil {
+			return false, err
+		}
+		return assetAggregate.GetVersion() > 0 && assetAggregate.GetTenantId() == tenantId && !assetAggregate.Deleted, nil
+	}
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type StartSchemaMigration interface {
+	Handle(ctx context.Context, cmd StartSchemaMigrationCommand) error
+}
+
+// StartSchemaMigrationCommand starts a background migration of the contents of a content type from FromVersion
+// to ToVersion of its schema. ToVersion is the latest version when it is zero and FromVersion the version before
+// ToVersion when it is zero. Only one migration of a content type that is not a dry run runs at a time.
+type StartSchemaMigrationCommand struct {
+	MigrationId   string `json:"migrationId"`
+	TenantId      string `json:"tenantId"`
+	ContentType   string `json:"contentType"`
+	FromVersion   uint64 `json:"fromVersion"`
+	ToVersion     uint64 `json:"toVersion"`
+	DryRun        bool   `json:"dryRun"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
+}
+
+type startSchemaMigrationCmdHandler struct {
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository
+	schemaMigrationRepository   content.SchemaMigrationRepository
+}
+
+func (c *startSchemaMigrationCmdHandler) Handle(ctx context.Context, cmd StartSchemaMigrationCommand) error {
+	latest, err := findContentTypeSchema(ctx, c.contentTypeSchemaRepository, cmd.TenantId, cmd.ContentType)
+	if err != nil {
+		return err
+	}
+
+	toVersion := cmd.ToVersion
+	if toVersion == 0 {
+		toVersion = latest.Version
+	}
+	if toVersion > latest.Version {
+		return errors.Wrapf(content.ErrInvalidMigration, "toVersion: %d, latest version: %d", toVersion, latest.Version)
+	}
+	fromVersion := cmd.FromVersion
+	if fromVersion == 0 {
+		fromVersion = toVersion - 1
+	}
+
+	if !cmd.DryRun {
+		active, err := c.schemaMigrationRepository.ExistsActive(ctx, cmd.TenantId, cmd.ContentType)
+		if err != nil {
+			return err
+		}
+		if active {
+			return errors.Wrapf(content.ErrMigrationInProgress, "content type: %s", cmd.ContentType)
+		}
+	}
+
+	migration, err := content.NewSchemaMigration(cmd.MigrationId, cmd.TenantId, cmd.ContentType, fromVersion, toVersion, cmd.DryRun,
+		cmd.CreatedById, cmd.CreatedByName)
+	if err != nil {
+		return err
+	}
+
+	return c.schemaMigrationRepository.Create(ctx, *migration)
+}
+
+func NewStartSchemaMigrationCmdHandler(contentTypeSchemaRepository content.ContentTypeSchemaRepository,
+	schemaMigrationRepository content.SchemaMigrationRepository) *startSchemaMigrationCmdHandler {
+	return &startSchemaMigrationCmdHandler{contentTypeSchemaRepository: contentTypeSchemaRepository, schemaMigrationRepository: schemaMigrationRepository}
+}
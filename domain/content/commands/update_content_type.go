@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"contentgit/domain/content"
+	"context"
+)
+
+type UpdateContentType interface {
+	Handle(ctx context.Context, cmd UpdateContentTypeCommand) error
+}
+
+// UpdateContentTypeCommand replaces the schema of a content type with a new version, contents created under
+// a previous version are validated against the new one from their next change on. Migrations declare how
+// a schema migration brings the existing contents to the new version.
+type UpdateContentTypeCommand struct {
+	TenantId      string                    `json:"tenantId"`
+	ContentType   string                    `json:"contentType"`
+	Description   string                    `json:"description"`
+	Fields        []content.FieldDefinition `json:"fields"`
+	Migrations    []content.MigrationStep   `json:"migrations"`
+	CreatedById   string                    `json:"createdById"`
+	CreatedByName string                    `json:"createdByName"`
+}
+
+type updateContentTypeCmdHandler struct {
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository
+}
+
+func (c *updateContentTypeCmdHandler) Handle(ctx context.Context, cmd UpdateContentTypeCommand) error {
+	previous, err := findContentTypeSchema(ctx, c.contentTypeSchemaRepository, cmd.TenantId, cmd.ContentType)
+	if err != nil {
+		return err
+	}
+
+	schema, err := content.NewContentTypeSchema(cmd.TenantId, cmd.ContentType, previous, cmd.Description, cmd.Fields,
+		cmd.Migrations, cmd.CreatedById, cmd.CreatedByName)
+	if err != nil {
+		return err
+	}
+
+	return createContentTypeSchema(ctx, c.contentTypeSchemaRepository, schema)
+}
+
+func NewUpdateContentTypeCmdHandler(contentTypeSchemaRepository content.ContentTypeSchemaRepository) *updateContentTypeCmdHandler {
+	return &updateContentTypeCmdHandler{contentTypeSchemaRepository: contentTypeSchemaRepository}
+}
@@ -10,10 +10,13 @@ type UpdateContentField interface {
 	Handle(ctx context.Context, cmd UpdateContentFieldCommand) error
 }
 
+// UpdateContentFieldCommand updates the value of FieldName, or of the localized field FieldName in Locale when it is set.
 type UpdateContentFieldCommand struct {
 	AggregateID   string `json:"id"`
 	TenantId      string `json:"tenantId"`
+	Branch        string `json:"branch"`
 	FieldName     string `json:"fieldName"`
+	Locale        string `json:"locale"`
 	BeforeValue   any    `json:"beforeValue"`
 	AfterValue    any    `json:"afterValue"`
 	CreatedById   string `json:"createdById"`
@@ -21,27 +24,49 @@ type UpdateContentFieldCommand struct {
 }
 
 type updateContentFieldCmdHandler struct {
-	aggregateStore eventsourcing.AggregateStore
+	aggregateStore              eventsourcing.AggregateStore
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository
+	assetAggregateStore         eventsourcing.AggregateStore
 }
 
 func (c *updateContentFieldCmdHandler) Handle(ctx context.Context, cmd UpdateContentFieldCommand) error {
-	contentAggregate, err := content.NewContentAggregate(cmd.AggregateID, cmd.TenantId)
-	if err != nil {
-		return err
-	}
+	return eventsourcing.RetryOnConcurrencyConflict(ctx, maxConcurrencyRetries, func(ctx context.Context) error {
+		if err := ensureContentNotDeleted(ctx, c.aggregateStore, cmd.AggregateID, cmd.TenantId, cmd.Branch); err != nil {
+			return err
+		}
 
-	err = c.aggregateStore.Load(ctx, contentAggregate)
-	if err != nil {
-		return err
-	}
+		contentAggregate, err := content.NewContentAggregateOnBranch(cmd.AggregateID, cmd.TenantId, cmd.Branch)
+		if err != nil {
+			return err
+		}
 
-	if err := contentAggregate.UpdateField(ctx, cmd.FieldName, cmd.BeforeValue, cmd.AfterValue, cmd.CreatedById, cmd.CreatedByName); err != nil {
-		return err
-	}
+		err = c.aggregateStore.Load(ctx, contentAggregate)
+		if err != nil {
+			return err
+		}
+		if contentAggregate.GetTenantId() != cmd.TenantId {
+			return eventsourcing.ErrAggregateNotFound
+		}
+		expectedVersion := contentAggregate.GetVersion()
 
-	return c.aggregateStore.Save(ctx, contentAggregate)
+		if cmd.Locale != "" {
+			err = contentAggregate.UpdateLocalizedField(ctx, cmd.FieldName, cmd.Locale, cmd.BeforeValue, cmd.AfterValue, cmd.CreatedById, cmd.CreatedByName)
+		} else {
+			err = contentAggregate.UpdateField(ctx, cmd.FieldName, cmd.BeforeValue, cmd.AfterValue, cmd.CreatedById, cmd.CreatedByName)
+		}
+		if err != nil {
+			return err
+		}
+		if err := ValidateContentFields(ctx, c.contentTypeSchemaRepository, c.aggregateStore, c.assetAggregateStore, contentAggregate, cmd.FieldName); err != nil {
+			return err
+		}
+
+		return c.aggregateStore.Save(ctx, contentAggregate, expectedVersion)
+	})
 }
 
-func NewUpdateContentFieldCmdHandler(aggregateStore eventsourcing.AggregateStore) *updateContentFieldCmdHandler {
-	return &updateContentFieldCmdHandler{aggregateStore: aggregateStore}
+func NewUpdateContentFieldCmdHandler(aggregateStore eventsourcing.AggregateStore,
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository, assetAggregateStore eventsourcing.AggregateStore) *updateContentFieldCmdHandler {
+	return &updateContentFieldCmdHandler{aggregateStore: aggregateStore, contentTypeSchemaRepository: contentTypeSchemaRepository,
+		assetAggregateStore: assetAggregateStore}
 }
@@ -0,0 +1,217 @@
+package content
+
+import (
+	"contentgit/domain/content/events"
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// FieldCommentId is the id of a comment, the version of its event for a comment recorded before comment ids existed.
+func FieldCommentId(commentId string, version uint64) string {
+	if commentId != "" {
+		return commentId
+	}
+	return strconv.FormatUint(version, 10)
+}
+
+// mentionPattern matches @userId at the start of a comment or after a space, so that e-mail addresses are no mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|\s)@([\w-]+(?:\.[\w-]+)*)`)
+
+// ParseMentions returns the ids of the users mentioned with @userId in a comment, once each in order of appearance.
+func ParseMentions(comment string) []string {
+	userIds := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(comment, -1) {
+		if userId := match[1]; !seen[userId] {
+			seen[userId] = true
+			userIds = append(userIds, userId)
+		}
+	}
+	return userIds
+}
+
+// ReplyToFieldComment adds the comment commentId to the thread of parentId, on the field of parentId.
+func (a *ContentAggregate) ReplyToFieldComment(ctx context.Context, commentId string, parentId string, comment string, createdById string, createdByName string) error {
+	fieldName, parent, ok := a.findComment(parentId)
+	if !ok || parent.Deleted {
+		return errors.Wrapf(ErrCommentNotFound, "commentId: %s", parentId)
+	}
+	if err := a.validateNewComment(commentId, comment); err != nil {
+		return err
+	}
+
+	event := &events.FieldCommentAddedEventV1{
+		FieldName:     fieldName,
+		CommentId:     commentId,
+		ParentId:      parentId,
+		Comment:       comment,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// EditFieldComment replaces the text of a comment, only its author can edit it.
+func (a *ContentAggregate) EditFieldComment(ctx context.Context, commentId string, comment string, createdById string, createdByName string) error {
+	fieldName, fieldComment, err := a.findOwnComment(commentId, createdById)
+	if err != nil {
+		return err
+	}
+	if comment == "" {
+		return errors.Wrap(ErrInvalidComment, "comment is required")
+	}
+	if comment == fieldComment.Comment {
+		return nil
+	}
+
+	event := &events.FieldCommentEditedEventV1{
+		FieldName:     fieldName,
+		CommentId:     commentId,
+		Comment:       comment,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// DeleteFieldComment erases a comment, only its author can delete it. Its replies are kept.
+func (a *ContentAggregate) DeleteFieldComment(ctx context.Context, commentId string, createdById string, createdByName string) error {
+	fieldName, _, err := a.findOwnComment(commentId, createdById)
+	if err != nil {
+		return err
+	}
+
+	event := &events.FieldCommentDeletedEventV1{
+		FieldName:     fieldName,
+		CommentId:     commentId,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+// ResolveFieldComment resolves the thread started by commentId, replies have no state of their own.
+func (a *ContentAggregate) ResolveFieldComment(ctx context.Context, commentId string, createdById string, createdByName string) error {
+	fieldName, fieldComment, err := a.findThread(commentId)
+	if err != nil {
+		return err
+	}
+	if fieldComment.Resolved {
+		return ErrThreadResolved
+	}
+
+	event := &events.FieldCommentResolvedEventV1{
+		FieldName:     fieldName,
+		CommentId:     commentId,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+func (a *ContentAggregate) ReopenFieldComment(ctx context.Context, commentId string, createdById string, createdByName string) error {
+	fieldName, fieldComment, err := a.findThread(commentId)
+	if err != nil {
+		return err
+	}
+	if !fieldComment.Resolved {
+		return ErrThreadNotResolved
+	}
+
+	event := &events.FieldCommentReopenedEventV1{
+		FieldName:     fieldName,
+		CommentId:     commentId,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
+
+func (a *ContentAggregate) validateNewComment(commentId string, comment string) error {
+	if commentId == "" || comment == "" {
+		return errors.Wrap(ErrInvalidComment, "commentId and comment are required")
+	}
+	if _, _, ok := a.findComment(commentId); ok {
+		return errors.Wrapf(ErrInvalidComment, "commentId: %s already exists", commentId)
+	}
+	return nil
+}
+
+// findComment returns the field of the comment with the comment itself, which can be changed through the pointer.
+func (a *ContentAggregate) findComment(commentId string) (string, *Comment, bool) {
+	for i := range a.FieldComments {
+		for j := range a.FieldComments[i].Comments {
+			if a.FieldComments[i].Comments[j].Id == commentId {
+				return a.FieldComments[i].FieldName, &a.FieldComments[i].Comments[j], true
+			}
+		}
+	}
+	return "", nil, false
+}
+
+func (a *ContentAggregate) findOwnComment(commentId string, createdById string) (string, *Comment, error) {
+	fieldName, fieldComment, ok := a.findComment(commentId)
+	if !ok || fieldComment.Deleted {
+		return "", nil, errors.Wrapf(ErrCommentNotFound, "commentId: %s", commentId)
+	}
+	if fieldComment.CreatedById != createdById {
+		return "", nil, errors.Wrapf(ErrNotCommentAuthor, "commentId: %s", commentId)
+	}
+	return fieldName, fieldComment, nil
+}
+
+func (a *ContentAggregate) findThread(commentId string) (string, *Comment, error) {
+	fieldName, fieldComment, ok := a.findComment(commentId)
+	if !ok {
+		return "", nil, errors.Wrapf(ErrCommentNotFound, "commentId: %s", commentId)
+	}
+	if fieldComment.ParentId != "" {
+		return "", nil, errors.Wrapf(ErrInvalidComment, "commentId: %s is a reply", commentId)
+	}
+	return fieldName, fieldComment, nil
+}
+
+func (a *ContentAggregate) handleFieldCommentEditedEvent(evt *events.FieldCommentEditedEventV1) error {
+	_, fieldComment, ok := a.findComment(evt.CommentId)
+	if !ok {
+		return errors.Wrapf(ErrCommentNotFound, "commentId: %s", evt.CommentId)
+	}
+	fieldComment.Comment = evt.Comment
+	return nil
+}
+
+func (a *ContentAggregate) handleFieldCommentDeletedEvent(evt *events.FieldCommentDeletedEventV1) error {
+	_, fieldComment, ok := a.findComment(evt.CommentId)
+	if !ok {
+		return errors.Wrapf(ErrCommentNotFound, "commentId: %s", evt.CommentId)
+	}
+	fieldComment.Comment = ""
+	fieldComment.Deleted = true
+	return nil
+}
+
+func (a *ContentAggregate) handleFieldCommentResolvedEvent(evt *events.FieldCommentResolvedEventV1) error {
+	_, fieldComment, ok := a.findComment(evt.CommentId)
+	if !ok {
+		return errors.Wrapf(ErrCommentNotFound, "commentId: %s", evt.CommentId)
+	}
+	fieldComment.Resolved = true
+	return nil
+}
+
+func (a *ContentAggregate) handleFieldCommentReopenedEvent(evt *events.FieldCommentReopenedEventV1) error {
+	_, fieldComment, ok := a.findComment(evt.CommentId)
+	if !ok {
+		return errors.Wrapf(ErrCommentNotFound, "commentId: %s", evt.CommentId)
+	}
+	fieldComment.Resolved = false
+	return nil
+}
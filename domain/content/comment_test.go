@@ -0,0 +1,205 @@
+package content
+
+import (
+	"contentgit/domain/content/events"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCommentedContent(t *testing.T) *ContentAggregate {
+	sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+	_ = sut.CreateContent(context.Background(), map[string]any{"price": "3000"})
+	err := sut.AddFieldComment(context.Background(), "c1", "price", "금액 결정되었나요?", "1", "사이트 관리자")
+	assert.NoError(t, err)
+	return sut
+}
+
+func TestFieldCommentId(t *testing.T) {
+	t.Run("댓글 id가 없던 이벤트는 이벤트 버전을 id로 쓴다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"price": "3000"})
+
+		// when
+		err := sut.RaiseEvent(&events.FieldCommentAddedEventV1{FieldName: "price", Comment: "금액 결정되었나요?", CreatedById: "1"})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "2", sut.FieldComments[0].Comments[0].Id)
+	})
+}
+
+func TestContentAggregate_ReplyToFieldComment(t *testing.T) {
+	t.Run("댓글에 답글을 달면 같은 필드의 스레드에 추가된다", func(t *testing.T) {
+		// given
+		sut := newCommentedContent(t)
+
+		// when
+		err := sut.ReplyToFieldComment(context.Background(), "c2", "c1", "네 2500으로 결정되었어요.", "2", "김영희")
+
+		// then
+		assert.NoError(t, err)
+		event := sut.GetChanges()[2].(*events.FieldCommentAddedEventV1)
+		assert.Equal(t, "price", event.FieldName)
+		assert.Equal(t, "c1", event.ParentId)
+		assert.Equal(t, "c1", sut.FieldComments[0].Comments[1].ParentId)
+	})
+
+	t.Run("없는 댓글에 답글을 달면 ErrCommentNotFound를 반환한다", func(t *testing.T) {
+		// given
+		sut := newCommentedContent(t)
+
+		// when
+		err := sut.ReplyToFieldComment(context.Background(), "c2", "unknown", "답글", "2", "김영희")
+
+		// then
+		assert.ErrorIs(t, err, ErrCommentNotFound)
+	})
+
+	t.Run("이미 있는 댓글 id면 ErrInvalidComment를 반환한다", func(t *testing.T) {
+		// given
+		sut := newCommentedContent(t)
+
+		// when
+		err := sut.ReplyToFieldComment(context.Background(), "c1", "c1", "답글", "2", "김영희")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidComment)
+	})
+}
+
+func TestContentAggregate_EditFieldComment(t *testing.T) {
+	t.Run("작성자는 댓글을 수정할 수 있다", func(t *testing.T) {
+		// given
+		sut := newCommentedContent(t)
+
+		// when
+		err := sut.EditFieldComment(context.Background(), "c1", "금액 확정되었나요?", "1", "사이트 관리자")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "금액 확정되었나요?", sut.FieldComments[0].Comments[0].Comment)
+	})
+
+	t.Run("작성자가 아니면 ErrNotCommentAuthor를 반환한다", func(t *testing.T) {
+		// given
+		sut := newCommentedContent(t)
+
+		// when
+		err := sut.EditFieldComment(context.Background(), "c1", "수정", "2", "김영희")
+
+		// then
+		assert.ErrorIs(t, err, ErrNotCommentAuthor)
+	})
+}
+
+func TestContentAggregate_DeleteFieldComment(t *testing.T) {
+	t.Run("삭제한 댓글은 답글을 남기고 내용만 지운다", func(t *testing.T) {
+		// given
+		sut := newCommentedContent(t)
+		_ = sut.ReplyToFieldComment(context.Background(), "c2", "c1", "네 2500으로 결정되었어요.", "2", "김영희")
+
+		// when
+		err := sut.DeleteFieldComment(context.Background(), "c1", "1", "사이트 관리자")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(sut.FieldComments[0].Comments))
+		assert.True(t, sut.FieldComments[0].Comments[0].Deleted)
+		assert.Empty(t, sut.FieldComments[0].Comments[0].Comment)
+	})
+
+	t.Run("삭제한 댓글은 다시 수정하거나 답글을 달 수 없다", func(t *testing.T) {
+		// given
+		sut := newCommentedContent(t)
+		_ = sut.DeleteFieldComment(context.Background(), "c1", "1", "사이트 관리자")
+
+		// when
+		editErr := sut.EditFieldComment(context.Background(), "c1", "수정", "1", "사이트 관리자")
+		replyErr := sut.ReplyToFieldComment(context.Background(), "c2", "c1", "답글", "2", "김영희")
+
+		// then
+		assert.ErrorIs(t, editErr, ErrCommentNotFound)
+		assert.ErrorIs(t, replyErr, ErrCommentNotFound)
+	})
+}
+
+func TestContentAggregate_ResolveFieldComment(t *testing.T) {
+	t.Run("스레드를 해결하고 다시 열 수 있다", func(t *testing.T) {
+		// given
+		sut := newCommentedContent(t)
+
+		// when
+		resolveErr := sut.ResolveFieldComment(context.Background(), "c1", "2", "김영희")
+		resolved := sut.FieldComments[0].Comments[0].Resolved
+		reopenErr := sut.ReopenFieldComment(context.Background(), "c1", "2", "김영희")
+
+		// then
+		assert.NoError(t, resolveErr)
+		assert.True(t, resolved)
+		assert.NoError(t, reopenErr)
+		assert.False(t, sut.FieldComments[0].Comments[0].Resolved)
+	})
+
+	t.Run("해결된 스레드를 다시 해결하면 ErrThreadResolved를 반환한다", func(t *testing.T) {
+		// given
+		sut := newCommentedContent(t)
+		_ = sut.ResolveFieldComment(context.Background(), "c1", "2", "김영희")
+
+		// when
+		err := sut.ResolveFieldComment(context.Background(), "c1", "2", "김영희")
+
+		// then
+		assert.ErrorIs(t, err, ErrThreadResolved)
+	})
+
+	t.Run("답글은 해결할 수 없다", func(t *testing.T) {
+		// given
+		sut := newCommentedContent(t)
+		_ = sut.ReplyToFieldComment(context.Background(), "c2", "c1", "네 2500으로 결정되었어요.", "2", "김영희")
+
+		// when
+		err := sut.ResolveFieldComment(context.Background(), "c2", "2", "김영희")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidComment)
+	})
+}
+
+func TestParseMentions(t *testing.T) {
+	t.Run("@userId로 언급된 사용자를 한 번씩 순서대로 반환한다", func(t *testing.T) {
+		// when
+		actual := ParseMentions("@2 금액 확인 부탁드려요. @kim.younghee 님도 @2 확인해 주세요.")
+
+		// then
+		assert.Equal(t, []string{"2", "kim.younghee"}, actual)
+	})
+
+	t.Run("이메일 주소는 언급이 아니다", func(t *testing.T) {
+		// when
+		actual := ParseMentions("admin@bettercode.kr 로 문의해 주세요")
+
+		// then
+		assert.Empty(t, actual)
+	})
+}
+
+func TestContentAggregate_AddFieldComment_Mention(t *testing.T) {
+	t.Run("언급이 있는 댓글도 댓글 이벤트 하나만 발생시켜 버전을 하나만 올린다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "bettercode")
+		_ = sut.CreateContent(context.Background(), map[string]any{"price": "3000"})
+
+		// when
+		err := sut.AddFieldComment(context.Background(), "c1", "price", "@2 @3 금액 결정되었나요?", "1", "사이트 관리자")
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, sut.GetChanges(), 2)
+		assert.Equal(t, uint64(2), sut.GetVersion())
+		assert.Equal(t, "@2 @3 금액 결정되었나요?", sut.GetChanges()[1].(*events.FieldCommentAddedEventV1).Comment)
+	})
+}
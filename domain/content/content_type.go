@@ -0,0 +1,375 @@
+package content
+
+import (
+	"contentgit/domain/content/jsonvalue"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// FieldType is the JSON type the value of a field must have.
+type FieldType string
+
+const (
+	FieldTypeString  FieldType = "string"
+	FieldTypeNumber  FieldType = "number"
+	FieldTypeInteger FieldType = "integer"
+	FieldTypeBoolean FieldType = "boolean"
+	FieldTypeObject  FieldType = "object"
+	FieldTypeArray   FieldType = "array"
+	// FieldTypeReference holds a reference to another content, see ContentReference.
+	FieldTypeReference FieldType = "reference"
+	// FieldTypeAsset holds a reference to an asset, see ParseAssetReference.
+	FieldTypeAsset FieldType = "asset"
+)
+
+func (t FieldType) valid() bool {
+	switch t {
+	case FieldTypeString, FieldTypeNumber, FieldTypeInteger, FieldTypeBoolean, FieldTypeObject, FieldTypeArray, FieldTypeReference,
+		FieldTypeAsset:
+		return true
+	}
+	return false
+}
+
+// FieldDefinition declares a top-level field of the contents of a content type. Enum restricts the value to one of
+// its values, Pattern is a regular expression a string value has to match. ReferenceType restricts a reference field
+// to the contents of a content type, a reference field without it may reference a content of any type.
+// A localized field holds a value by locale, {"ko-KR": "...", "en-US": "..."}, each of them of Type.
+type FieldDefinition struct {
+	Name          string    `json:"name"`
+	Type          FieldType `json:"type"`
+	Required      bool      `json:"required,omitempty"`
+	Enum          []any     `json:"enum,omitempty"`
+	Pattern       string    `json:"pattern,omitempty"`
+	ReferenceType string    `json:"referenceType,omitempty"`
+	Localized     bool      `json:"localized,omitempty"`
+}
+
+// FieldDefinitions are the fields of a schema, stored as jsonb.
+type FieldDefinitions []FieldDefinition
+
+func (jsonField FieldDefinitions) Value() (driver.Value, error) {
+	return json.Marshal(jsonField)
+}
+
+// Scan Unmarshal
+func (jsonField *FieldDefinitions) Scan(value any) error {
+	data, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(data, &jsonField)
+}
+
+// ContentTypeSchema is a version of the schema of a content type of a tenant. Every change of a schema adds a version,
+// contents are validated against the latest one and deleting a content type adds a deleted version.
+// Migrations declare how the contents of the previous version are brought to this one.
+// A content type without a schema accepts any content.
+type ContentTypeSchema struct {
+	TenantId      string           `gorm:"type:varchar(100);primaryKey"`
+	ContentType   string           `gorm:"type:varchar(100);primaryKey"`
+	Version       uint64           `gorm:"primaryKey"`
+	Description   string           `gorm:"type:text"`
+	Fields        FieldDefinitions `gorm:"type:jsonb;not null"`
+	Migrations    MigrationSteps   `gorm:"type:jsonb"`
+	Deleted       bool             `gorm:"not null;default:false"`
+	CreatedById   string
+	CreatedByName string
+	CreatedAt     time.Time
+}
+
+// NewContentTypeSchema is the schema following previous, which is nil for a content type that never had one.
+// The first version of a schema has no contents to migrate and so no migration steps.
+func NewContentTypeSchema(tenantId string, contentType string, previous *ContentTypeSchema, description string,
+	fields []FieldDefinition, migrations []MigrationStep, createdById string, createdByName string) (*ContentTypeSchema, error) {
+	if !refNamePattern.MatchString(contentType) {
+		return nil, errors.Wrapf(ErrInvalidSchema, "content type: %s", contentType)
+	}
+	if err := validateFieldDefinitions(fields); err != nil {
+		return nil, err
+	}
+	if previous == nil && len(migrations) > 0 {
+		return nil, errors.Wrap(ErrInvalidSchema, "the first version of a schema cannot have migrations")
+	}
+	if err := validateMigrationSteps(migrations); err != nil {
+		return nil, err
+	}
+
+	return &ContentTypeSchema{
+		TenantId:      tenantId,
+		ContentType:   contentType,
+		Version:       nextSchemaVersion(previous),
+		Description:   description,
+		Fields:        fields,
+		Migrations:    migrations,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}, nil
+}
+
+// NewDeletedContentTypeSchema is the version deleting the content type of previous, it keeps the fields of previous.
+func NewDeletedContentTypeSchema(previous *ContentTypeSchema, createdById string, createdByName string) *ContentTypeSchema {
+	return &ContentTypeSchema{
+		TenantId:      previous.TenantId,
+		ContentType:   previous.ContentType,
+		Version:       nextSchemaVersion(previous),
+		Description:   previous.Description,
+		Fields:        previous.Fields,
+		Deleted:       true,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+}
+
+func nextSchemaVersion(previous *ContentTypeSchema) uint64 {
+	if previous == nil {
+		return 1
+	}
+	return previous.Version + 1
+}
+
+func (*ContentTypeSchema) TableName() string {
+	return "content_type_schemas"
+}
+
+func (s *ContentTypeSchema) field(name string) (FieldDefinition, bool) {
+	for _, field := range s.Fields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return FieldDefinition{}, false
+}
+
+// Validate checks a whole content, its fields have to be declared and the required ones present.
+func (s *ContentTypeSchema) Validate(content map[string]any) error {
+	violations := make([]FieldViolation, 0)
+	for _, field := range s.Fields {
+		value, ok := content[field.Name]
+		violations = append(violations, field.validate(value, ok)...)
+	}
+	for name := range content {
+		if _, ok := s.field(name); !ok {
+			violations = append(violations, FieldViolation{Field: name, Message: "is not declared by the content type"})
+		}
+	}
+	return newSchemaViolationError(violations)
+}
+
+// ValidateFields checks only the top-level fields names of a content, so that a change is not rejected because
+// of another field that was valid under a previous version of the schema.
+func (s *ContentTypeSchema) ValidateFields(content map[string]any, names ...string) error {
+	violations := make([]FieldViolation, 0)
+	for _, name := range names {
+		field, ok := s.field(name)
+		if !ok {
+			if _, present := content[name]; present {
+				violations = append(violations, FieldViolation{Field: name, Message: "is not declared by the content type"})
+			}
+			continue
+		}
+		value, present := content[name]
+		violations = append(violations, field.validate(value, present)...)
+	}
+	return newSchemaViolationError(violations)
+}
+
+func (f FieldDefinition) validate(value any, present bool) []FieldViolation {
+	if !present || value == nil {
+		if f.Required {
+			return []FieldViolation{{Field: f.Name, Message: "is required"}}
+		}
+		return nil
+	}
+	if f.Localized {
+		return f.validateLocalized(value)
+	}
+	if !f.Type.matches(value) {
+		return []FieldViolation{{Field: f.Name, Message: fmt.Sprintf("must be of type %s", f.Type)}}
+	}
+
+	violations := make([]FieldViolation, 0)
+	if len(f.Enum) > 0 && !containsValue(f.Enum, value) {
+		violations = append(violations, FieldViolation{Field: f.Name, Message: fmt.Sprintf("must be one of %v", f.Enum)})
+	}
+	if f.Pattern != "" {
+		if pattern, err := regexp.Compile(f.Pattern); err == nil && !pattern.MatchString(value.(string)) {
+			violations = append(violations, FieldViolation{Field: f.Name, Message: fmt.Sprintf("must match the pattern %s", f.Pattern)})
+		}
+	}
+	if f.ReferenceType != "" {
+		if reference, _ := ParseReference(value); reference.ContentType != f.ReferenceType {
+			violations = append(violations, FieldViolation{Field: f.Name, Message: fmt.Sprintf("must reference a content of type %s", f.ReferenceType)})
+		}
+	}
+	return violations
+}
+
+// validateLocalized checks the value of every locale of a localized field, a required field has to be translated
+// to a locale at least.
+func (f FieldDefinition) validateLocalized(value any) []FieldViolation {
+	values, ok := localizedValues(value)
+	if !ok {
+		return []FieldViolation{{Field: f.Name, Message: "must be an object of values by locale"}}
+	}
+
+	locales := make([]string, 0, len(values))
+	for locale, localeValue := range values {
+		if localeValue != nil {
+			locales = append(locales, locale)
+		}
+	}
+	if len(locales) == 0 && f.Required {
+		return []FieldViolation{{Field: f.Name, Message: "is required"}}
+	}
+	sort.Strings(locales)
+
+	localeField := f
+	localeField.Localized = false
+	violations := make([]FieldViolation, 0)
+	for _, locale := range locales {
+		for _, violation := range localeField.validate(values[locale], true) {
+			violations = append(violations, FieldViolation{Field: f.Name, Message: "in " + locale + " " + violation.Message})
+		}
+	}
+	return violations
+}
+
+func (t FieldType) matches(value any) bool {
+	switch t {
+	case FieldTypeString:
+		_, ok := value.(string)
+		return ok
+	case FieldTypeNumber:
+		_, ok := numberOf(value)
+		return ok
+	case FieldTypeInteger:
+		number, ok := numberOf(value)
+		return ok && number == math.Trunc(number)
+	case FieldTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case FieldTypeObject:
+		_, ok := value.(map[string]any)
+		return ok
+	case FieldTypeArray:
+		_, ok := value.([]any)
+		return ok
+	case FieldTypeReference:
+		_, ok := ParseReference(value)
+		return ok
+	case FieldTypeAsset:
+		_, ok := ParseAssetReference(value)
+		return ok
+	}
+	return false
+}
+
+func numberOf(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		number, err := v.Float64()
+		return number, err == nil
+	}
+	return 0, false
+}
+
+func containsValue(values []any, value any) bool {
+	for _, candidate := range values {
+		if jsonvalue.Equal(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateFieldDefinitions(fields []FieldDefinition) error {
+	names := make(map[string]bool)
+	for _, field := range fields {
+		if field.Name == "" || strings.Contains(field.Name, "/") {
+			return errors.Wrapf(ErrInvalidSchema, "field name: %q", field.Name)
+		}
+		if names[field.Name] {
+			return errors.Wrapf(ErrInvalidSchema, "field %s is declared twice", field.Name)
+		}
+		names[field.Name] = true
+
+		if !field.Type.valid() {
+			return errors.Wrapf(ErrInvalidSchema, "field %s has an unknown type %q", field.Name, field.Type)
+		}
+		for _, value := range field.Enum {
+			if !field.Type.matches(value) {
+				return errors.Wrapf(ErrInvalidSchema, "enum value %v of field %s is not of type %s", value, field.Name, field.Type)
+			}
+		}
+		if field.Pattern != "" {
+			if field.Type != FieldTypeString {
+				return errors.Wrapf(ErrInvalidSchema, "field %s of type %s cannot have a pattern", field.Name, field.Type)
+			}
+			if _, err := regexp.Compile(field.Pattern); err != nil {
+				return errors.Wrapf(ErrInvalidSchema, "pattern of field %s: %s", field.Name, err.Error())
+			}
+		}
+		if field.Localized && (field.Type == FieldTypeReference || field.Type == FieldTypeAsset) {
+			return errors.Wrapf(ErrInvalidSchema, "%s field %s cannot be localized", field.Type, field.Name)
+		}
+		if field.ReferenceType != "" {
+			if field.Type != FieldTypeReference {
+				return errors.Wrapf(ErrInvalidSchema, "field %s of type %s cannot have a reference type", field.Name, field.Type)
+			}
+			if !refNamePattern.MatchString(field.ReferenceType) {
+				return errors.Wrapf(ErrInvalidSchema, "reference type of field %s: %s", field.Name, field.ReferenceType)
+			}
+		}
+	}
+	return nil
+}
+
+// FieldViolation is a reason a field of a content does not satisfy the schema of its content type.
+type FieldViolation struct {
+	Field   string
+	Message string
+}
+
+// SchemaViolationError lists every violation of a content, it is ErrSchemaViolation for errors.Is.
+type SchemaViolationError struct {
+	Violations []FieldViolation
+}
+
+func newSchemaViolationError(violations []FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Field < violations[j].Field
+	})
+	return &SchemaViolationError{Violations: violations}
+}
+
+func (e *SchemaViolationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.Field+" "+violation.Message)
+	}
+	return ErrSchemaViolation.Error() + ": " + strings.Join(messages, ", ")
+}
+
+func (e *SchemaViolationError) Is(target error) bool {
+	return target == ErrSchemaViolation
+}
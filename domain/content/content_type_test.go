@@ -0,0 +1,101 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newNoticeSchema(t *testing.T) *ContentTypeSchema {
+	schema, err := NewContentTypeSchema("bettercode", "notices", nil, "공지사항", []FieldDefinition{
+		{Name: "title", Type: FieldTypeString, Required: true},
+		{Name: "category", Type: FieldTypeString, Enum: []any{"event", "maintenance"}},
+		{Name: "priority", Type: FieldTypeInteger},
+		{Name: "code", Type: FieldTypeString, Pattern: `^N-[0-9]+$`},
+	}, nil, "1", "사이트 관리자")
+	require.NoError(t, err)
+	return schema
+}
+
+func TestNewContentTypeSchema(t *testing.T) {
+	t.Run("첫 스키마는 버전 1이고 이후 변경마다 버전이 올라간다", func(t *testing.T) {
+		// given
+		first := newNoticeSchema(t)
+
+		// when
+		second, err := NewContentTypeSchema("bettercode", "notices", first, "공지사항", first.Fields, nil, "1", "사이트 관리자")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), first.Version)
+		assert.Equal(t, uint64(2), second.Version)
+		assert.Equal(t, uint64(3), NewDeletedContentTypeSchema(second, "1", "사이트 관리자").Version)
+	})
+
+	t.Run("필드 정의가 잘못되면 ErrInvalidSchema를 반환한다", func(t *testing.T) {
+		invalidFields := [][]FieldDefinition{
+			{{Name: "", Type: FieldTypeString}},
+			{{Name: "title", Type: FieldTypeString}, {Name: "title", Type: FieldTypeNumber}},
+			{{Name: "title", Type: "date"}},
+			{{Name: "priority", Type: FieldTypeInteger, Enum: []any{"high"}}},
+			{{Name: "priority", Type: FieldTypeInteger, Pattern: "^[0-9]$"}},
+			{{Name: "code", Type: FieldTypeString, Pattern: "("}},
+			{{Name: "brand", Type: FieldTypeObject, ReferenceType: "brands"}},
+			{{Name: "brand", Type: FieldTypeReference, ReferenceType: "브랜드"}},
+		}
+		for _, fields := range invalidFields {
+			// when
+			_, err := NewContentTypeSchema("bettercode", "notices", nil, "", fields, nil, "1", "사이트 관리자")
+
+			// then
+			assert.ErrorIs(t, err, ErrInvalidSchema, fields)
+		}
+	})
+}
+
+func TestContentTypeSchema_Validate(t *testing.T) {
+	t.Run("스키마를 만족하는 컨텐츠는 통과한다", func(t *testing.T) {
+		// given
+		sut := newNoticeSchema(t)
+
+		// when
+		err := sut.Validate(map[string]any{"title": "점검 안내", "category": "maintenance", "priority": float64(1), "code": "N-12"})
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("위반한 필드마다 사유를 반환한다", func(t *testing.T) {
+		// given
+		sut := newNoticeSchema(t)
+
+		// when
+		err := sut.Validate(map[string]any{"category": "sale", "priority": 1.5, "code": "12", "author": "김영희"})
+
+		// then
+		assert.ErrorIs(t, err, ErrSchemaViolation)
+		var schemaViolation *SchemaViolationError
+		assert.ErrorAs(t, err, &schemaViolation)
+		assert.Equal(t, []FieldViolation{
+			{Field: "author", Message: "is not declared by the content type"},
+			{Field: "category", Message: "must be one of [event maintenance]"},
+			{Field: "code", Message: "must match the pattern ^N-[0-9]+$"},
+			{Field: "priority", Message: "must be of type integer"},
+			{Field: "title", Message: "is required"},
+		}, schemaViolation.Violations)
+	})
+
+	t.Run("ValidateFields는 주어진 필드만 검사한다", func(t *testing.T) {
+		// given
+		sut := newNoticeSchema(t)
+		content := map[string]any{"category": "sale", "priority": float64(2)}
+
+		// when
+		err := sut.ValidateFields(content, "priority")
+
+		// then
+		assert.NoError(t, err)
+		assert.ErrorIs(t, sut.ValidateFields(content, "title"), ErrSchemaViolation)
+	})
+}
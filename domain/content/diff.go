@@ -0,0 +1,84 @@
+package content
+
+import (
+	"contentgit/domain/content/jsonpointer"
+	"contentgit/domain/content/jsonvalue"
+)
+
+type AddedField struct {
+	FieldName string
+	Value     any
+}
+
+type RemovedField struct {
+	FieldName string
+	Value     any
+}
+
+type ChangedField struct {
+	FieldName   string
+	BeforeValue any
+	AfterValue  any
+}
+
+// ContentDiff is the per-field difference between two states of a content, fields are ordered by name.
+type ContentDiff struct {
+	Added   []AddedField
+	Removed []RemovedField
+	Changed []ChangedField
+}
+
+// JSONPatchOperation is a RFC 6902 JSON Patch operation.
+type JSONPatchOperation struct {
+	Op    string
+	Path  string
+	Value any
+}
+
+func Diff(from map[string]any, to map[string]any) ContentDiff {
+	diff := ContentDiff{
+		Added:   make([]AddedField, 0),
+		Removed: make([]RemovedField, 0),
+		Changed: make([]ChangedField, 0),
+	}
+
+	for _, fieldName := range sortedKeys(from) {
+		toValue, ok := to[fieldName]
+		if !ok {
+			diff.Removed = append(diff.Removed, RemovedField{FieldName: fieldName, Value: from[fieldName]})
+			continue
+		}
+
+		if !jsonvalue.Equal(from[fieldName], toValue) {
+			diff.Changed = append(diff.Changed, ChangedField{FieldName: fieldName, BeforeValue: from[fieldName], AfterValue: toValue})
+		}
+	}
+
+	for _, fieldName := range sortedKeys(to) {
+		if _, ok := from[fieldName]; !ok {
+			diff.Added = append(diff.Added, AddedField{FieldName: fieldName, Value: to[fieldName]})
+		}
+	}
+
+	return diff
+}
+
+// JSONPatch returns the diff as RFC 6902 operations that turn the from state into the to state.
+func (d ContentDiff) JSONPatch() []JSONPatchOperation {
+	operations := make([]JSONPatchOperation, 0, len(d.Added)+len(d.Removed)+len(d.Changed))
+	for _, field := range d.Removed {
+		operations = append(operations, JSONPatchOperation{Op: "remove", Path: jsonPointer(field.FieldName)})
+	}
+	for _, field := range d.Added {
+		operations = append(operations, JSONPatchOperation{Op: "add", Path: jsonPointer(field.FieldName), Value: field.Value})
+	}
+	for _, field := range d.Changed {
+		operations = append(operations, JSONPatchOperation{Op: "replace", Path: jsonPointer(field.FieldName), Value: field.AfterValue})
+	}
+	return operations
+}
+
+// jsonPointer escapes a field name as a RFC 6901 JSON Pointer.
+func jsonPointer(fieldName string) string {
+	return jsonpointer.Pointer{fieldName}.JSONPointer()
+}
@@ -0,0 +1,54 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	t.Run("추가, 삭제, 변경된 필드를 이름 순으로 반환한다", func(t *testing.T) {
+		// given
+		from := map[string]any{"name": "홍길동", "price": "1000", "taxRate": "10"}
+		to := map[string]any{"name": "고길동", "price": "1000", "stock": "5"}
+
+		// when
+		diff := Diff(from, to)
+
+		// then
+		assert.Equal(t, []AddedField{{FieldName: "stock", Value: "5"}}, diff.Added)
+		assert.Equal(t, []RemovedField{{FieldName: "taxRate", Value: "10"}}, diff.Removed)
+		assert.Equal(t, []ChangedField{{FieldName: "name", BeforeValue: "홍길동", AfterValue: "고길동"}}, diff.Changed)
+	})
+
+	t.Run("같은 컨텐츠는 차이가 없다", func(t *testing.T) {
+		// given
+		from := map[string]any{"tags": []any{"a", "b"}}
+		to := map[string]any{"tags": []any{"a", "b"}}
+
+		// when
+		diff := Diff(from, to)
+
+		// then
+		assert.Empty(t, diff.Added)
+		assert.Empty(t, diff.Removed)
+		assert.Empty(t, diff.Changed)
+		assert.Empty(t, diff.JSONPatch())
+	})
+}
+
+func TestContentDiff_JSONPatch(t *testing.T) {
+	// given
+	from := map[string]any{"name": "홍길동", "a/b": "1"}
+	to := map[string]any{"name": "고길동", "c~d": nil}
+
+	// when
+	operations := Diff(from, to).JSONPatch()
+
+	// then
+	assert.Equal(t, []JSONPatchOperation{
+		{Op: "remove", Path: "/a~1b"},
+		{Op: "add", Path: "/c~0d", Value: nil},
+		{Op: "replace", Path: "/name", Value: "고길동"},
+	}, operations)
+}
@@ -4,7 +4,47 @@ import "github.com/pkg/errors"
 
 var (
 	ErrFieldNotFound        = errors.New("not found field")
+	ErrFieldAlreadyExists   = errors.New("field with given name already exists")
+	ErrInvalidFieldName     = errors.New("invalid field name")
 	ErrFieldUpdateConflict  = errors.New("field update conflict")
 	ErrContentAlreadyExists = errors.New("content with given id already exists")
 	ErrUnknownEventType     = errors.New("unknown event type")
+	ErrInvalidBranchName    = errors.New("invalid branch name")
+	ErrBranchAlreadyExists  = errors.New("branch with given name already exists")
+	ErrNoMergeBase          = errors.New("branches have no common ancestor")
+	ErrMergeConflict        = errors.New("merge conflict")
+	ErrNothingToMerge       = errors.New("nothing to merge")
+	ErrNothingToRevert      = errors.New("nothing to revert")
+	ErrInvalidCommit        = errors.New("commit must update at least one field and each field only once")
+	ErrStaleParentVersion   = errors.New("parent version is not the current version")
+	ErrInvalidTagName       = errors.New("invalid tag name")
+	ErrTagAlreadyExists     = errors.New("tag with given name already exists")
+	ErrContentDeleted       = errors.New("content is deleted")
+	ErrContentNotDeleted    = errors.New("content is not deleted")
+	ErrContentPurged        = errors.New("content is purged")
+	ErrInvalidStatus        = errors.New("invalid status")
+	ErrTransitionNotAllowed = errors.New("transition is not allowed from the current status")
+	ErrReasonRequired       = errors.New("reason is required")
+	ErrContentNotPublished  = errors.New("content is not published")
+	ErrVersionNotCurrent    = errors.New("version is not the current version")
+	ErrInvalidSchedule      = errors.New("invalid schedule")
+	ErrScheduleNotPending   = errors.New("schedule is not pending")
+	ErrInvalidComment       = errors.New("invalid comment")
+	ErrCommentNotFound      = errors.New("not found comment")
+	ErrNotCommentAuthor     = errors.New("only the author can change the comment")
+	ErrThreadResolved       = errors.New("comment thread is already resolved")
+	ErrThreadNotResolved    = errors.New("comment thread is not resolved")
+	ErrInvalidSchema        = errors.New("invalid content type schema")
+	ErrSchemaViolation      = errors.New("content does not match the schema of its content type")
+	ErrContentTypeNotFound  = errors.New("not found content type")
+	ErrContentTypeExists    = errors.New("content type with given name already exists")
+	ErrSchemaChanged        = errors.New("schema of the content type was changed concurrently")
+	ErrInvalidMigration     = errors.New("invalid schema migration")
+	ErrMigrationFailed      = errors.New("content cannot be migrated")
+	ErrNothingToMigrate     = errors.New("nothing to migrate")
+	ErrMigrationNotFound    = errors.New("not found schema migration")
+	ErrMigrationInProgress  = errors.New("schema migration of the content type is in progress")
+	ErrContentReferenced    = errors.New("content is referenced by other contents")
+	ErrInvalidLocale        = errors.New("invalid locale")
+	ErrFieldNotLocalized    = errors.New("field does not hold localized values")
 )
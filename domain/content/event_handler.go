@@ -4,6 +4,7 @@ import (
 	"contentgit/domain/content/events"
 	"contentgit/domain/content/projections"
 	"contentgit/dtos"
+	persistence "contentgit/ports/out/persistance"
 	"contentgit/ports/out/persistance/eventsourcing"
 	"context"
 	"fmt"
@@ -12,16 +13,25 @@ import (
 )
 
 type ContentEventHandler struct {
-	serializer               eventsourcing.Serializer
-	contentProjectRepository ContentProjectionRepository
+	serializer                     eventsourcing.Serializer
+	contentProjectRepository       ContentProjectionRepository
+	contentBranchProjectRepository ContentBranchProjectionRepository
+	contentCommitProjectRepository ContentCommitProjectionRepository
+	publishedContentRepository     PublishedContentProjectionRepository
 }
 
-func NewContentEventHandler(serializer eventsourcing.Serializer, contentProjectRepository ContentProjectionRepository) *ContentEventHandler {
-	return &ContentEventHandler{serializer: serializer, contentProjectRepository: contentProjectRepository}
+func NewContentEventHandler(serializer eventsourcing.Serializer, contentProjectRepository ContentProjectionRepository,
+	contentBranchProjectRepository ContentBranchProjectionRepository,
+	contentCommitProjectRepository ContentCommitProjectionRepository,
+	publishedContentRepository PublishedContentProjectionRepository) *ContentEventHandler {
+	return &ContentEventHandler{serializer: serializer, contentProjectRepository: contentProjectRepository,
+		contentBranchProjectRepository: contentBranchProjectRepository,
+		contentCommitProjectRepository: contentCommitProjectRepository,
+		publishedContentRepository:     publishedContentRepository}
 }
 
 func (c *ContentEventHandler) Handle(ctx context.Context, esEvent eventsourcing.Event) error {
-	deserializedEvent, err := c.serializer.DeserializeEvent(esEvent)
+	deserializedEvent, err := c.serializer.DeserializeEvent(ctx, esEvent)
 	if err != nil {
 		return errors.Wrapf(err, "serializer.DeserializeEvent aggregateID: %s, type: %s", esEvent.GetAggregateID(), esEvent.GetEventType())
 	}
@@ -31,10 +41,65 @@ func (c *ContentEventHandler) Handle(ctx context.Context, esEvent eventsourcing.
 		return c.onContentCreated(ctx, esEvent, event)
 
 	case *events.FieldUpdatedEventV1:
+		if esEvent.GetBranch() != eventsourcing.DefaultBranch {
+			return c.onBranchFieldUpdated(ctx, esEvent, event)
+		}
 		return c.onFieldUpdated(ctx, esEvent, event)
 
 	case *events.FieldCommentAddedEventV1:
 		return c.onFieldCommentAdded(ctx, esEvent, event)
+	case *events.FieldCommentEditedEventV1:
+		return c.onFieldCommentEdited(ctx, esEvent, event)
+	case *events.FieldCommentDeletedEventV1:
+		return c.onFieldCommentDeleted(ctx, esEvent, event)
+	case *events.FieldCommentResolvedEventV1:
+		return c.onFieldCommentResolved(ctx, esEvent, event)
+	case *events.FieldCommentReopenedEventV1:
+		return c.onFieldCommentReopened(ctx, esEvent, event)
+
+	case *events.FieldAddedEventV1:
+		if esEvent.GetBranch() != eventsourcing.DefaultBranch {
+			return c.onBranchFieldAdded(ctx, esEvent, event)
+		}
+		return c.onFieldAdded(ctx, esEvent, event)
+
+	case *events.FieldRemovedEventV1:
+		if esEvent.GetBranch() != eventsourcing.DefaultBranch {
+			return c.onBranchFieldRemoved(ctx, esEvent, event)
+		}
+		return c.onFieldRemoved(ctx, esEvent, event)
+
+	case *events.ContentBranchCreatedEventV1:
+		return c.onContentBranchCreated(ctx, esEvent, event)
+
+	case *events.ContentMergedEventV1:
+		if esEvent.GetBranch() != eventsourcing.DefaultBranch {
+			return c.onBranchContentMerged(ctx, esEvent, event)
+		}
+		return c.onContentMerged(ctx, esEvent, event)
+
+	case *events.ContentRevertedEventV1:
+		if esEvent.GetBranch() != eventsourcing.DefaultBranch {
+			return c.onBranchContentReverted(ctx, esEvent, event)
+		}
+		return c.onContentReverted(ctx, esEvent, event)
+
+	case *events.ContentCommittedEventV1:
+		return c.onContentCommitted(ctx, esEvent, event)
+	case *events.ContentDeletedEventV1:
+		return c.onContentDeleted(ctx, esEvent, event)
+	case *events.ContentRestoredEventV1:
+		return c.onContentRestored(ctx, esEvent, event)
+	case *events.ContentPurgedEventV1:
+		return c.onContentPurged(ctx, esEvent, event)
+	case *events.ContentStatusChangedEventV1:
+		return c.onContentStatusChanged(ctx, esEvent, event)
+
+	case *events.ContentMigratedEventV1:
+		if esEvent.GetBranch() != eventsourcing.DefaultBranch {
+			return c.onBranchContentMigrated(ctx, esEvent, event)
+		}
+		return c.onContentMigrated(ctx, esEvent, event)
 	default:
 		return errors.New(fmt.Sprintf("unknown event type: %s", esEvent.GetEventType()))
 	}
@@ -76,7 +141,9 @@ func (c *ContentEventHandler) onFieldUpdated(ctx context.Context, esEvent events
 		CreatedByName: event.CreatedByName,
 	}
 
-	contentProjection.UpdateField(event.FieldName, updateField)
+	if err := contentProjection.UpdateField(event.FieldName, updateField); err != nil {
+		return errors.Wrapf(err, "failed to update field %s", event.FieldName)
+	}
 	contentProjection.Version = uint(esEvent.Version)
 
 	return c.contentProjectRepository.Save(ctx, contentProjection)
@@ -87,8 +154,470 @@ func (c *ContentEventHandler) onFieldCommentAdded(ctx context.Context, esEvent e
 	if err != nil {
 		return errors.Wrap(err, "failed to find content projection")
 	}
-	contentProjection.AddFieldComment(event.FieldName, event.Comment, event.CreatedById, event.CreatedByName)
+	contentProjection.AddFieldComment(FieldCommentId(event.CommentId, esEvent.Version), event.ParentId, event.FieldName, event.Comment,
+		event.CreatedById, event.CreatedByName)
+	contentProjection.Version = uint(esEvent.Version)
+
+	return c.contentProjectRepository.Save(ctx, contentProjection)
+}
+
+func (c *ContentEventHandler) onFieldCommentEdited(ctx context.Context, esEvent eventsourcing.Event, event *events.FieldCommentEditedEventV1) error {
+	return c.changeFieldComment(ctx, esEvent, event.CommentId, func(fieldComment *projections.ContentFieldComment) {
+		fieldComment.Edit(event.Comment, esEvent.GetCreatedAt())
+	})
+}
+
+func (c *ContentEventHandler) onFieldCommentDeleted(ctx context.Context, esEvent eventsourcing.Event, event *events.FieldCommentDeletedEventV1) error {
+	return c.changeFieldComment(ctx, esEvent, event.CommentId, func(fieldComment *projections.ContentFieldComment) {
+		fieldComment.Delete()
+	})
+}
+
+func (c *ContentEventHandler) onFieldCommentResolved(ctx context.Context, esEvent eventsourcing.Event, event *events.FieldCommentResolvedEventV1) error {
+	return c.changeFieldComment(ctx, esEvent, event.CommentId, func(fieldComment *projections.ContentFieldComment) {
+		fieldComment.Resolve(event.CreatedById, event.CreatedByName, esEvent.GetCreatedAt())
+	})
+}
+
+func (c *ContentEventHandler) onFieldCommentReopened(ctx context.Context, esEvent eventsourcing.Event, event *events.FieldCommentReopenedEventV1) error {
+	return c.changeFieldComment(ctx, esEvent, event.CommentId, func(fieldComment *projections.ContentFieldComment) {
+		fieldComment.Reopen()
+	})
+}
+
+func (c *ContentEventHandler) changeFieldComment(ctx context.Context, esEvent eventsourcing.Event, commentId string, change func(fieldComment *projections.ContentFieldComment)) error {
+	contentProjection, err := c.contentProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find content projection")
+	}
+
+	fieldComment, ok := contentProjection.FindFieldComment(commentId)
+	if !ok {
+		return errors.Wrapf(ErrCommentNotFound, "commentId: %s", commentId)
+	}
+	change(fieldComment)
+	contentProjection.Version = uint(esEvent.Version)
+
+	return c.contentProjectRepository.Save(ctx, contentProjection)
+}
+
+func (c *ContentEventHandler) onFieldAdded(ctx context.Context, esEvent eventsourcing.Event, event *events.FieldAddedEventV1) error {
+	contentProjection, err := c.contentProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find content projection")
+	}
+
+	if err := contentProjection.AddField(event.FieldName, dtos.ContentUpdateField{
+		AfterValue:    event.Value,
+		CreatedById:   event.CreatedById,
+		CreatedByName: event.CreatedByName,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to add field %s", event.FieldName)
+	}
+	contentProjection.Version = uint(esEvent.Version)
+
+	return c.contentProjectRepository.Save(ctx, contentProjection)
+}
+
+func (c *ContentEventHandler) onFieldRemoved(ctx context.Context, esEvent eventsourcing.Event, event *events.FieldRemovedEventV1) error {
+	contentProjection, err := c.contentProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find content projection")
+	}
+
+	if err := contentProjection.RemoveField(event.FieldName, dtos.ContentUpdateField{
+		BeforeValue:   event.Value,
+		CreatedById:   event.CreatedById,
+		CreatedByName: event.CreatedByName,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to remove field %s", event.FieldName)
+	}
+	contentProjection.Version = uint(esEvent.Version)
+
+	return c.contentProjectRepository.Save(ctx, contentProjection)
+}
+
+func (c *ContentEventHandler) onContentDeleted(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentDeletedEventV1) error {
+	contentProjection, err := c.contentProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find content projection")
+	}
+
+	contentProjection.Delete(esEvent.GetCreatedAt())
+	contentProjection.Version = uint(esEvent.Version)
+	if err := c.contentProjectRepository.Save(ctx, contentProjection); err != nil {
+		return err
+	}
+
+	return c.publishedContentRepository.Delete(ctx, esEvent.TenantId, esEvent.AggregateID)
+}
+
+func (c *ContentEventHandler) onContentRestored(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentRestoredEventV1) error {
+	contentProjection, err := c.contentProjectRepository.FindByIDIncludingDeleted(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find content projection")
+	}
+
+	contentProjection.Restore()
+	contentProjection.Version = uint(esEvent.Version)
+
+	return c.contentProjectRepository.Save(ctx, contentProjection)
+}
+
+func (c *ContentEventHandler) onContentStatusChanged(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentStatusChangedEventV1) error {
+	contentProjection, err := c.contentProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find content projection")
+	}
+
+	contentProjection.ChangeStatus(event.ToStatus, event.Reason)
+	contentProjection.Version = uint(esEvent.Version)
+
+	switch Transition(event.Transition) {
+	case TransitionPublish:
+		// the draft has not changed since the promoted version, status changes leave the content as it is
+		contentProjection.Publish(uint(event.PublishedVersion))
+		publishedContent := projections.NewPublishedContentProjection(
+			esEvent.AggregateID,
+			esEvent.TenantId,
+			contentProjection.ContentType,
+			contentProjection.Content,
+			uint(event.PublishedVersion),
+			event.CreatedById,
+			event.CreatedByName,
+			esEvent.GetCreatedAt(),
+		)
+		if err := c.publishedContentRepository.Save(ctx, &publishedContent); err != nil {
+			return errors.Wrap(err, "failed to save published content projection")
+		}
+	case TransitionUnpublish:
+		contentProjection.Unpublish()
+		if err := c.publishedContentRepository.Delete(ctx, esEvent.TenantId, esEvent.AggregateID); err != nil {
+			return errors.Wrap(err, "failed to delete published content projection")
+		}
+	}
+
+	return c.contentProjectRepository.Save(ctx, contentProjection)
+}
+
+// onContentPurged scrubs the purged fields from the content, its published version, its branches and their commit logs.
+func (c *ContentEventHandler) onContentPurged(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentPurgedEventV1) error {
+	contentProjection, err := c.contentProjectRepository.FindByIDIncludingDeleted(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find content projection")
+	}
+
+	contentProjection.Purge(event.Fields, esEvent.GetCreatedAt())
+	contentProjection.Version = uint(esEvent.Version)
+	if err := c.contentProjectRepository.Save(ctx, contentProjection); err != nil {
+		return err
+	}
+
+	publishedContent, err := c.publishedContentRepository.FindByID(ctx, esEvent.TenantId, contentProjection.ContentType, esEvent.AggregateID)
+	if err != nil && !errors.Is(err, persistence.ErrRecordNotFound) {
+		return errors.Wrap(err, "failed to find published content projection")
+	}
+	if publishedContent != nil {
+		publishedContent.Purge(event.Fields)
+		if err := c.publishedContentRepository.Save(ctx, publishedContent); err != nil {
+			return err
+		}
+	}
+
+	branchProjections, err := c.contentBranchProjectRepository.FindAllByContentId(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find content branch projections")
+	}
+
+	branches := []string{eventsourcing.DefaultBranch}
+	for i := range branchProjections {
+		branchProjections[i].Purge(event.Fields)
+		if err := c.contentBranchProjectRepository.Save(ctx, &branchProjections[i]); err != nil {
+			return err
+		}
+		branches = append(branches, branchProjections[i].Branch)
+	}
+
+	for _, branch := range branches {
+		commitProjections, err := c.contentCommitProjectRepository.FindAllByContentId(ctx, esEvent.TenantId, esEvent.AggregateID, branch)
+		if err != nil {
+			return errors.Wrap(err, "failed to find content commit projections")
+		}
+		for i := range commitProjections {
+			commitProjections[i].Purge(event.Fields)
+			if err := c.contentCommitProjectRepository.Save(ctx, &commitProjections[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *ContentEventHandler) onContentBranchCreated(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentBranchCreatedEventV1) error {
+	if esEvent.GetVersion() != 1 {
+		return errors.Wrapf(eventsourcing.ErrInvalidEventVersion, "type: %s, version: %d", esEvent.GetEventType(), esEvent.GetVersion())
+	}
+
+	contentBranchProjection := projections.NewContentBranchProjection(
+		esEvent.AggregateID,
+		esEvent.GetBranch(),
+		esEvent.TenantId,
+		event.Content,
+		event.ContentType,
+		event.SourceBranch,
+		uint(event.SourceVersion),
+		event.CreatedById,
+		event.CreatedByName,
+		uint(esEvent.Version),
+	)
+
+	if err := c.contentBranchProjectRepository.Create(ctx, contentBranchProjection); err != nil {
+		return errors.Wrap(err, "failed to create content branch projection")
+	}
+	return nil
+}
+
+func (c *ContentEventHandler) onBranchFieldUpdated(ctx context.Context, esEvent eventsourcing.Event, event *events.FieldUpdatedEventV1) error {
+	contentBranchProjection, err := c.contentBranchProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID, esEvent.GetBranch())
+	if err != nil {
+		return errors.Wrap(err, "failed to find content branch projection")
+	}
+
+	if err := contentBranchProjection.UpdateField(event.FieldName, event.AfterValue); err != nil {
+		return errors.Wrapf(err, "failed to update field %s", event.FieldName)
+	}
+	contentBranchProjection.Version = uint(esEvent.Version)
+
+	return c.contentBranchProjectRepository.Save(ctx, contentBranchProjection)
+}
+
+func (c *ContentEventHandler) onBranchFieldAdded(ctx context.Context, esEvent eventsourcing.Event, event *events.FieldAddedEventV1) error {
+	contentBranchProjection, err := c.contentBranchProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID, esEvent.GetBranch())
+	if err != nil {
+		return errors.Wrap(err, "failed to find content branch projection")
+	}
+
+	if err := contentBranchProjection.AddField(event.FieldName, event.Value); err != nil {
+		return errors.Wrapf(err, "failed to add field %s", event.FieldName)
+	}
+	contentBranchProjection.Version = uint(esEvent.Version)
+
+	return c.contentBranchProjectRepository.Save(ctx, contentBranchProjection)
+}
+
+func (c *ContentEventHandler) onBranchFieldRemoved(ctx context.Context, esEvent eventsourcing.Event, event *events.FieldRemovedEventV1) error {
+	contentBranchProjection, err := c.contentBranchProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID, esEvent.GetBranch())
+	if err != nil {
+		return errors.Wrap(err, "failed to find content branch projection")
+	}
+
+	if err := contentBranchProjection.RemoveField(event.FieldName); err != nil {
+		return errors.Wrapf(err, "failed to remove field %s", event.FieldName)
+	}
+	contentBranchProjection.Version = uint(esEvent.Version)
+
+	return c.contentBranchProjectRepository.Save(ctx, contentBranchProjection)
+}
+
+func (c *ContentEventHandler) onContentMerged(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentMergedEventV1) error {
+	contentProjection, err := c.contentProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find content projection")
+	}
+
+	for _, field := range event.Fields {
+		if field.Removed {
+			if err := contentProjection.RemoveField(field.FieldName, dtos.ContentUpdateField{
+				BeforeValue:   field.BeforeValue,
+				CreatedById:   event.CreatedById,
+				CreatedByName: event.CreatedByName,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to remove field %s", field.FieldName)
+			}
+			continue
+		}
+
+		if err := contentProjection.UpdateField(field.FieldName, dtos.ContentUpdateField{
+			BeforeValue:   field.BeforeValue,
+			AfterValue:    field.AfterValue,
+			CreatedById:   event.CreatedById,
+			CreatedByName: event.CreatedByName,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to update field %s", field.FieldName)
+		}
+	}
+	contentProjection.Version = uint(esEvent.Version)
+
+	return c.contentProjectRepository.Save(ctx, contentProjection)
+}
+
+func (c *ContentEventHandler) onBranchContentMerged(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentMergedEventV1) error {
+	contentBranchProjection, err := c.contentBranchProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID, esEvent.GetBranch())
+	if err != nil {
+		return errors.Wrap(err, "failed to find content branch projection")
+	}
+
+	for _, field := range event.Fields {
+		if field.Removed {
+			if err := contentBranchProjection.RemoveField(field.FieldName); err != nil {
+				return errors.Wrapf(err, "failed to remove field %s", field.FieldName)
+			}
+			continue
+		}
+
+		if err := contentBranchProjection.UpdateField(field.FieldName, field.AfterValue); err != nil {
+			return errors.Wrapf(err, "failed to update field %s", field.FieldName)
+		}
+	}
+	contentBranchProjection.Version = uint(esEvent.Version)
+
+	return c.contentBranchProjectRepository.Save(ctx, contentBranchProjection)
+}
+
+func (c *ContentEventHandler) onContentReverted(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentRevertedEventV1) error {
+	contentProjection, err := c.contentProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find content projection")
+	}
+
+	for _, field := range event.Fields {
+		// a field the reverted content does not have is removed by the revert, not set to null
+		if _, ok := event.Content[field.FieldName]; !ok {
+			if err := contentProjection.RemoveField(field.FieldName, dtos.ContentUpdateField{
+				BeforeValue:   field.BeforeValue,
+				CreatedById:   event.CreatedById,
+				CreatedByName: event.CreatedByName,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to remove field %s", field.FieldName)
+			}
+			continue
+		}
+
+		if err := contentProjection.UpdateField(field.FieldName, dtos.ContentUpdateField{
+			BeforeValue:   field.BeforeValue,
+			AfterValue:    field.AfterValue,
+			CreatedById:   event.CreatedById,
+			CreatedByName: event.CreatedByName,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to update field %s", field.FieldName)
+		}
+	}
+	contentProjection.Content = event.Content
+	contentProjection.MarkEdited()
 	contentProjection.Version = uint(esEvent.Version)
 
 	return c.contentProjectRepository.Save(ctx, contentProjection)
 }
+
+func (c *ContentEventHandler) onBranchContentReverted(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentRevertedEventV1) error {
+	contentBranchProjection, err := c.contentBranchProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID, esEvent.GetBranch())
+	if err != nil {
+		return errors.Wrap(err, "failed to find content branch projection")
+	}
+
+	contentBranchProjection.Content = event.Content
+	contentBranchProjection.Version = uint(esEvent.Version)
+
+	return c.contentBranchProjectRepository.Save(ctx, contentBranchProjection)
+}
+
+func (c *ContentEventHandler) onContentMigrated(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentMigratedEventV1) error {
+	contentProjection, err := c.contentProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find content projection")
+	}
+
+	for _, field := range event.Fields {
+		if err := contentProjection.UpdateField(field.FieldName, dtos.ContentUpdateField{
+			BeforeValue:   field.BeforeValue,
+			AfterValue:    field.AfterValue,
+			CreatedById:   event.CreatedById,
+			CreatedByName: event.CreatedByName,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to update field %s", field.FieldName)
+		}
+	}
+	contentProjection.Content = event.Content
+	contentProjection.MarkEdited()
+	contentProjection.Version = uint(esEvent.Version)
+
+	return c.contentProjectRepository.Save(ctx, contentProjection)
+}
+
+func (c *ContentEventHandler) onBranchContentMigrated(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentMigratedEventV1) error {
+	contentBranchProjection, err := c.contentBranchProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID, esEvent.GetBranch())
+	if err != nil {
+		return errors.Wrap(err, "failed to find content branch projection")
+	}
+
+	contentBranchProjection.Content = event.Content
+	contentBranchProjection.Version = uint(esEvent.Version)
+
+	return c.contentBranchProjectRepository.Save(ctx, contentBranchProjection)
+}
+
+func (c *ContentEventHandler) onContentCommitted(ctx context.Context, esEvent eventsourcing.Event, event *events.ContentCommittedEventV1) error {
+	if esEvent.GetBranch() != eventsourcing.DefaultBranch {
+		contentBranchProjection, err := c.contentBranchProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID, esEvent.GetBranch())
+		if err != nil {
+			return errors.Wrap(err, "failed to find content branch projection")
+		}
+
+		for _, field := range event.Fields {
+			if err := contentBranchProjection.UpdateField(field.FieldName, field.AfterValue); err != nil {
+				return errors.Wrapf(err, "failed to update field %s", field.FieldName)
+			}
+		}
+		contentBranchProjection.Version = uint(esEvent.Version)
+
+		if err := c.contentBranchProjectRepository.Save(ctx, contentBranchProjection); err != nil {
+			return err
+		}
+	} else {
+		contentProjection, err := c.contentProjectRepository.FindByID(ctx, esEvent.TenantId, esEvent.AggregateID)
+		if err != nil {
+			return errors.Wrap(err, "failed to find content projection")
+		}
+
+		for _, field := range event.Fields {
+			if err := contentProjection.UpdateField(field.FieldName, dtos.ContentUpdateField{
+				BeforeValue:   field.BeforeValue,
+				AfterValue:    field.AfterValue,
+				CreatedById:   event.CreatedById,
+				CreatedByName: event.CreatedByName,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to update field %s", field.FieldName)
+			}
+		}
+		contentProjection.Version = uint(esEvent.Version)
+
+		if err := c.contentProjectRepository.Save(ctx, contentProjection); err != nil {
+			return err
+		}
+	}
+
+	fields := make(projections.CommitFieldChangeVO, 0, len(event.Fields))
+	for _, field := range event.Fields {
+		fields = append(fields, projections.CommitFieldChange{Name: field.FieldName, BeforeValue: field.BeforeValue, AfterValue: field.AfterValue})
+	}
+
+	contentCommitProjection := projections.NewContentCommitProjection(
+		event.CommitId,
+		esEvent.AggregateID,
+		esEvent.GetBranch(),
+		esEvent.TenantId,
+		uint(esEvent.Version),
+		uint(event.ParentVersion),
+		event.Message,
+		fields,
+		event.CreatedById,
+		event.CreatedByName,
+		esEvent.CreatedAt,
+	)
+
+	if err := c.contentCommitProjectRepository.Create(ctx, contentCommitProjection); err != nil {
+		return errors.Wrap(err, "failed to create content commit projection")
+	}
+	return nil
+}
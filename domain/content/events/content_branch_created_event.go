@@ -0,0 +1,19 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ContentBranchCreatedEventType eventsourcing.EventType = "CONTENT_BRANCH_CREATED_V1"
+)
+
+// ContentBranchCreatedEventV1 is the first event of a branch stream. It carries the content of the source branch
+// at the version the branch was forked from.
+type ContentBranchCreatedEventV1 struct {
+	Content       map[string]any `json:"content"`
+	ContentType   string         `json:"contentType"`
+	SourceBranch  string         `json:"sourceBranch"`
+	SourceVersion uint64         `json:"sourceVersion"`
+	CreatedById   string         `json:"createdById"`
+	CreatedByName string         `json:"createdByName"`
+	Metadata      *string        `json:"-"`
+}
@@ -0,0 +1,24 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ContentCommittedEventType eventsourcing.EventType = "CONTENT_COMMITTED_V1"
+)
+
+// ContentCommittedEventV1 records several field updates applied together on top of ParentVersion.
+type ContentCommittedEventV1 struct {
+	CommitId      string           `json:"commitId"`
+	Message       string           `json:"message"`
+	ParentVersion uint64           `json:"parentVersion"`
+	Fields        []CommittedField `json:"fields"`
+	CreatedById   string           `json:"createdById"`
+	CreatedByName string           `json:"createdByName"`
+	Metadata      *string          `json:"-"`
+}
+
+type CommittedField struct {
+	FieldName   string `json:"fieldName"`
+	BeforeValue any    `json:"beforeValue"`
+	AfterValue  any    `json:"afterValue"`
+}
@@ -0,0 +1,14 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ContentDeletedEventType eventsourcing.EventType = "CONTENT_DELETED_V1"
+)
+
+// ContentDeletedEventV1 moves the content to the trash, it is kept with its history and can be restored.
+type ContentDeletedEventV1 struct {
+	CreatedById   string  `json:"createdById"`
+	CreatedByName string  `json:"createdByName"`
+	Metadata      *string `json:"-"`
+}
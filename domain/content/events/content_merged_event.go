@@ -0,0 +1,27 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ContentMergedEventType eventsourcing.EventType = "CONTENT_MERGED_V1"
+)
+
+// ContentMergedEventV1 records a merge of SourceBranch at SourceVersion into the branch of the event stream,
+// whose version before the merge was TargetVersion.
+type ContentMergedEventV1 struct {
+	SourceBranch  string        `json:"sourceBranch"`
+	SourceVersion uint64        `json:"sourceVersion"`
+	TargetVersion uint64        `json:"targetVersion"`
+	Fields        []MergedField `json:"fields"`
+	CreatedById   string        `json:"createdById"`
+	CreatedByName string        `json:"createdByName"`
+	Metadata      *string       `json:"-"`
+}
+
+// MergedField is a field the merge set to AfterValue, or removed when Removed is set.
+type MergedField struct {
+	FieldName   string `json:"fieldName"`
+	BeforeValue any    `json:"beforeValue"`
+	AfterValue  any    `json:"afterValue"`
+	Removed     bool   `json:"removed,omitempty"`
+}
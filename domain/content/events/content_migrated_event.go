@@ -0,0 +1,26 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ContentMigratedEventType eventsourcing.EventType = "CONTENT_MIGRATED_V1"
+)
+
+// ContentMigratedEventV1 records the migration of the content from FromSchemaVersion to ToSchemaVersion of the schema
+// of its content type. Content holds the field values after the migration and Fields the fields that were changed by it.
+type ContentMigratedEventV1 struct {
+	MigrationId       string          `json:"migrationId"`
+	FromSchemaVersion uint64          `json:"fromSchemaVersion"`
+	ToSchemaVersion   uint64          `json:"toSchemaVersion"`
+	Content           map[string]any  `json:"content"`
+	Fields            []MigratedField `json:"fields"`
+	CreatedById       string          `json:"createdById"`
+	CreatedByName     string          `json:"createdByName"`
+	Metadata          *string         `json:"-"`
+}
+
+type MigratedField struct {
+	FieldName   string `json:"fieldName"`
+	BeforeValue any    `json:"beforeValue"`
+	AfterValue  any    `json:"afterValue"`
+}
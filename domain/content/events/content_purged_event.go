@@ -0,0 +1,16 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ContentPurgedEventType eventsourcing.EventType = "CONTENT_PURGED_V1"
+)
+
+// ContentPurgedEventV1 erases the personal data of the content. The key its personal data fields were encrypted with
+// is destroyed, Fields are the fields whose values are unreadable from then on.
+type ContentPurgedEventV1 struct {
+	Fields        []string `json:"fields"`
+	CreatedById   string   `json:"createdById"`
+	CreatedByName string   `json:"createdByName"`
+	Metadata      *string  `json:"-"`
+}
@@ -0,0 +1,14 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ContentRestoredEventType eventsourcing.EventType = "CONTENT_RESTORED_V1"
+)
+
+// ContentRestoredEventV1 brings a deleted content back from the trash.
+type ContentRestoredEventV1 struct {
+	CreatedById   string  `json:"createdById"`
+	CreatedByName string  `json:"createdByName"`
+	Metadata      *string `json:"-"`
+}
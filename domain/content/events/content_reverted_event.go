@@ -0,0 +1,24 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ContentRevertedEventType eventsourcing.EventType = "CONTENT_REVERTED_V1"
+)
+
+// ContentRevertedEventV1 records a revert of the content to the field values it had at TargetVersion.
+// Content holds the field values after the revert and Fields the fields that were changed by it.
+type ContentRevertedEventV1 struct {
+	TargetVersion uint64          `json:"targetVersion"`
+	Content       map[string]any  `json:"content"`
+	Fields        []RevertedField `json:"fields"`
+	CreatedById   string          `json:"createdById"`
+	CreatedByName string          `json:"createdByName"`
+	Metadata      *string         `json:"-"`
+}
+
+type RevertedField struct {
+	FieldName   string `json:"fieldName"`
+	BeforeValue any    `json:"beforeValue"`
+	AfterValue  any    `json:"afterValue"`
+}
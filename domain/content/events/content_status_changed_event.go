@@ -0,0 +1,21 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	ContentStatusChangedEventType eventsourcing.EventType = "CONTENT_STATUS_CHANGED_V1"
+)
+
+// ContentStatusChangedEventV1 records an editorial transition of the content from FromStatus to ToStatus.
+// Reason is why the content was rejected and PublishedVersion the version a publish promoted to the published content,
+// they are empty for the other transitions.
+type ContentStatusChangedEventV1 struct {
+	Transition       string  `json:"transition"`
+	FromStatus       string  `json:"fromStatus"`
+	ToStatus         string  `json:"toStatus"`
+	Reason           string  `json:"reason,omitempty"`
+	PublishedVersion uint64  `json:"publishedVersion,omitempty"`
+	CreatedById      string  `json:"createdById"`
+	CreatedByName    string  `json:"createdByName"`
+	Metadata         *string `json:"-"`
+}
@@ -0,0 +1,17 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	FieldAddedEventType eventsourcing.EventType = "CONTENT_FIELD_ADDED_V1"
+)
+
+// FieldAddedEventV1 adds a field, a localized field is added with the value of its first locale Locale.
+type FieldAddedEventV1 struct {
+	FieldName     string  `json:"fieldName"`
+	Locale        string  `json:"locale,omitempty"`
+	Value         any     `json:"value"`
+	CreatedById   string  `json:"createdById"`
+	CreatedByName string  `json:"createdByName"`
+	Metadata      *string `json:"-"`
+}
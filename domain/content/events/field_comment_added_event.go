@@ -8,9 +8,15 @@ const (
 	FieldCommentAddedEventType eventsourcing.EventType = "CONTENT_FIELD_COMMENT_ADDED_V1"
 )
 
+// FieldCommentAddedEventV1 starts a comment thread on a field, or replies to the comment ParentId.
+// Comments recorded before comment ids existed have no CommentId, the version of their event is used instead.
+// A comment on a localized field in Locale is on the value of the locale, FieldName points at it.
 type FieldCommentAddedEventV1 struct {
 	TenantId      string  `json:"tenantId"`
 	FieldName     string  `json:"fieldName"`
+	Locale        string  `json:"locale,omitempty"`
+	CommentId     string  `json:"commentId,omitempty"`
+	ParentId      string  `json:"parentId,omitempty"`
 	Comment       string  `json:"comment"`
 	CreatedById   string  `json:"createdById"`
 	CreatedByName string  `json:"createdByName"`
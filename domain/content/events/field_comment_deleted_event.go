@@ -0,0 +1,16 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	FieldCommentDeletedEventType eventsourcing.EventType = "CONTENT_FIELD_COMMENT_DELETED_V1"
+)
+
+// FieldCommentDeletedEventV1 erases the text of a comment, the comment stays in its thread so that its replies keep their place.
+type FieldCommentDeletedEventV1 struct {
+	FieldName     string  `json:"fieldName"`
+	CommentId     string  `json:"commentId"`
+	CreatedById   string  `json:"createdById"`
+	CreatedByName string  `json:"createdByName"`
+	Metadata      *string `json:"-"`
+}
@@ -0,0 +1,17 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	FieldCommentEditedEventType eventsourcing.EventType = "CONTENT_FIELD_COMMENT_EDITED_V1"
+)
+
+// FieldCommentEditedEventV1 replaces the text of a comment by its author.
+type FieldCommentEditedEventV1 struct {
+	FieldName     string  `json:"fieldName"`
+	CommentId     string  `json:"commentId"`
+	Comment       string  `json:"comment"`
+	CreatedById   string  `json:"createdById"`
+	CreatedByName string  `json:"createdByName"`
+	Metadata      *string `json:"-"`
+}
@@ -0,0 +1,16 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	FieldCommentReopenedEventType eventsourcing.EventType = "CONTENT_FIELD_COMMENT_REOPENED_V1"
+)
+
+// FieldCommentReopenedEventV1 marks a resolved thread as unresolved again.
+type FieldCommentReopenedEventV1 struct {
+	FieldName     string  `json:"fieldName"`
+	CommentId     string  `json:"commentId"`
+	CreatedById   string  `json:"createdById"`
+	CreatedByName string  `json:"createdByName"`
+	Metadata      *string `json:"-"`
+}
@@ -0,0 +1,16 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	FieldCommentResolvedEventType eventsourcing.EventType = "CONTENT_FIELD_COMMENT_RESOLVED_V1"
+)
+
+// FieldCommentResolvedEventV1 marks the thread started by the comment CommentId as resolved.
+type FieldCommentResolvedEventV1 struct {
+	FieldName     string  `json:"fieldName"`
+	CommentId     string  `json:"commentId"`
+	CreatedById   string  `json:"createdById"`
+	CreatedByName string  `json:"createdByName"`
+	Metadata      *string `json:"-"`
+}
@@ -0,0 +1,16 @@
+package events
+
+import "contentgit/ports/out/persistance/eventsourcing"
+
+const (
+	FieldRemovedEventType eventsourcing.EventType = "CONTENT_FIELD_REMOVED_V1"
+)
+
+// FieldRemovedEventV1 records the removal of a field, Value is the value the field had when it was removed.
+type FieldRemovedEventV1 struct {
+	FieldName     string  `json:"fieldName"`
+	Value         any     `json:"value"`
+	CreatedById   string  `json:"createdById"`
+	CreatedByName string  `json:"createdByName"`
+	Metadata      *string `json:"-"`
+}
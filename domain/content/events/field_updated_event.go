@@ -9,8 +9,11 @@ const (
 	FieldUpdatedEventType eventsourcing.EventType = "CONTENT_FIELD_UPDATED_V1"
 )
 
+// FieldUpdatedEventV1 replaces the value of a field. An update of a localized field in Locale replaces the values of
+// all its locales, so that the event applies as any other update.
 type FieldUpdatedEventV1 struct {
 	FieldName     string    `json:"fieldName"`
+	Locale        string    `json:"locale,omitempty"`
 	BeforeValue   any       `json:"beforeValue"`
 	AfterValue    any       `json:"afterValue"`
 	CreatedById   string    `json:"createdById"`
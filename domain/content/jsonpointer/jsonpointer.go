@@ -0,0 +1,261 @@
+// Package jsonpointer addresses values nested in a content with RFC 6901 JSON Pointers such as /variants/2/price.
+// A field name that does not start with a slash is a single top-level key, so plain field names keep working.
+package jsonpointer
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidPointer = errors.New("invalid json pointer")
+	ErrNotFound       = errors.New("no value at json pointer")
+	ErrAlreadyExists  = errors.New("value already exists at json pointer")
+)
+
+// Pointer is the list of reference tokens of a JSON Pointer, unescaped.
+type Pointer []string
+
+// Parse parses a JSON Pointer, or a plain top-level field name when path does not start with a slash.
+func Parse(path string) (Pointer, error) {
+	if path == "" {
+		return nil, errors.Wrap(ErrInvalidPointer, "empty path")
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		return Pointer{path}, nil
+	}
+
+	tokens := strings.Split(path[1:], "/")
+	for i, token := range tokens {
+		unescaped, err := unescape(token)
+		if err != nil {
+			return nil, errors.Wrapf(err, "path: %s", path)
+		}
+		tokens[i] = unescaped
+	}
+	return tokens, nil
+}
+
+// String returns the canonical form of the pointer: the plain key for a top-level field, the escaped JSON Pointer otherwise.
+func (p Pointer) String() string {
+	if len(p) == 1 && !strings.HasPrefix(p[0], "/") && p[0] != "" {
+		return p[0]
+	}
+	return p.JSONPointer()
+}
+
+// JSONPointer returns the escaped RFC 6901 form of the pointer, even for a top-level field.
+func (p Pointer) JSONPointer() string {
+	var builder strings.Builder
+	for _, token := range p {
+		builder.WriteString("/")
+		builder.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+	return builder.String()
+}
+
+// Root is the top-level field the pointer belongs to.
+func (p Pointer) Root() string {
+	return p[0]
+}
+
+// Get returns the value the pointer refers to in document.
+func (p Pointer) Get(document map[string]any) (any, bool) {
+	var node any = document
+	for _, token := range p {
+		child, err := childOf(node, token)
+		if err != nil {
+			return nil, false
+		}
+		node = child
+	}
+	return node, true
+}
+
+// Set replaces the value the pointer refers to, the last key of an object is created when missing
+// but array elements must exist.
+func (p Pointer) Set(document map[string]any, value any) error {
+	return p.mutate(document, func(container any, token string) (any, error) {
+		switch c := container.(type) {
+		case map[string]any:
+			c[token] = value
+			return c, nil
+		case []any:
+			index, err := arrayIndex(token, len(c)-1)
+			if err != nil {
+				return nil, err
+			}
+			c[index] = value
+			return c, nil
+		default:
+			return nil, ErrNotFound
+		}
+	})
+}
+
+// Add adds a value: a key that must not exist yet in an object, or an element inserted at an index of an array,
+// "-" appends to the array.
+func (p Pointer) Add(document map[string]any, value any) error {
+	return p.mutate(document, func(container any, token string) (any, error) {
+		switch c := container.(type) {
+		case map[string]any:
+			if _, ok := c[token]; ok {
+				return nil, ErrAlreadyExists
+			}
+			c[token] = value
+			return c, nil
+		case []any:
+			if token == "-" {
+				return append(c, value), nil
+			}
+			index, err := arrayIndex(token, len(c))
+			if err != nil {
+				return nil, err
+			}
+			inserted := make([]any, 0, len(c)+1)
+			inserted = append(inserted, c[:index]...)
+			inserted = append(inserted, value)
+			return append(inserted, c[index:]...), nil
+		default:
+			return nil, ErrNotFound
+		}
+	})
+}
+
+// Remove removes the value the pointer refers to and returns it.
+func (p Pointer) Remove(document map[string]any) (any, error) {
+	var removed any
+	err := p.mutate(document, func(container any, token string) (any, error) {
+		switch c := container.(type) {
+		case map[string]any:
+			value, ok := c[token]
+			if !ok {
+				return nil, ErrNotFound
+			}
+			removed = value
+			delete(c, token)
+			return c, nil
+		case []any:
+			index, err := arrayIndex(token, len(c)-1)
+			if err != nil {
+				return nil, err
+			}
+			removed = c[index]
+			remaining := make([]any, 0, len(c)-1)
+			remaining = append(remaining, c[:index]...)
+			return append(remaining, c[index+1:]...), nil
+		default:
+			return nil, ErrNotFound
+		}
+	})
+	return removed, err
+}
+
+// mutate walks down to the container of the last token and replaces the containers on the way with the results,
+// so that operations growing or shrinking an array are written back to its parent.
+func (p Pointer) mutate(document map[string]any, fn func(container any, token string) (any, error)) error {
+	if len(p) == 0 {
+		return errors.Wrap(ErrInvalidPointer, "empty pointer")
+	}
+
+	_, err := mutate(document, p, fn)
+	if err != nil {
+		return errors.Wrapf(err, "path: %s", p.String())
+	}
+	return nil
+}
+
+func mutate(node any, tokens []string, fn func(container any, token string) (any, error)) (any, error) {
+	if len(tokens) == 1 {
+		return fn(node, tokens[0])
+	}
+
+	child, err := childOf(node, tokens[0])
+	if err != nil {
+		return nil, err
+	}
+
+	mutated, err := mutate(child, tokens[1:], fn)
+	if err != nil {
+		return nil, err
+	}
+
+	switch c := node.(type) {
+	case map[string]any:
+		c[tokens[0]] = mutated
+		return c, nil
+	case []any:
+		index, _ := strconv.Atoi(tokens[0])
+		c[index] = mutated
+		return c, nil
+	default:
+		return nil, ErrNotFound
+	}
+}
+
+func childOf(node any, token string) (any, error) {
+	switch c := node.(type) {
+	case map[string]any:
+		child, ok := c[token]
+		if !ok {
+			return nil, ErrNotFound
+		}
+		return child, nil
+	case []any:
+		index, err := arrayIndex(token, len(c)-1)
+		if err != nil {
+			return nil, err
+		}
+		return c[index], nil
+	default:
+		return nil, ErrNotFound
+	}
+}
+
+// arrayIndex parses an array index token that must not be greater than max.
+func arrayIndex(token string, max int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, errors.Wrapf(ErrInvalidPointer, "array index: %s", token)
+	}
+	for _, r := range token {
+		if r < '0' || r > '9' {
+			return 0, errors.Wrapf(ErrInvalidPointer, "array index: %s", token)
+		}
+	}
+
+	index, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, errors.Wrapf(ErrInvalidPointer, "array index: %s", token)
+	}
+	if index > max {
+		return 0, errors.Wrapf(ErrNotFound, "array index: %d", index)
+	}
+	return index, nil
+}
+
+func unescape(token string) (string, error) {
+	if !strings.Contains(token, "~") {
+		return token, nil
+	}
+
+	var builder strings.Builder
+	for i := 0; i < len(token); i++ {
+		if token[i] != '~' {
+			builder.WriteByte(token[i])
+			continue
+		}
+		if i+1 >= len(token) || (token[i+1] != '0' && token[i+1] != '1') {
+			return "", errors.Wrapf(ErrInvalidPointer, "invalid escape in token: %s", token)
+		}
+		if token[i+1] == '0' {
+			builder.WriteByte('~')
+		} else {
+			builder.WriteByte('/')
+		}
+		i++
+	}
+	return builder.String(), nil
+}
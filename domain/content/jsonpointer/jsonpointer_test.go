@@ -0,0 +1,196 @@
+package jsonpointer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		expected  Pointer
+		canonical string
+		err       error
+	}{
+		{name: "필드명은 최상위 필드 하나를 가리킨다", path: "name", expected: Pointer{"name"}, canonical: "name"},
+		{name: "최상위 필드를 가리키는 JSON Pointer는 필드명이 된다", path: "/name", expected: Pointer{"name"}, canonical: "name"},
+		{name: "중첩된 경로를 파싱한다", path: "/variants/2/price", expected: Pointer{"variants", "2", "price"}, canonical: "/variants/2/price"},
+		{name: "~0과 ~1을 해제한다", path: "/a~1b/c~0d", expected: Pointer{"a/b", "c~d"}, canonical: "/a~1b/c~0d"},
+		{name: "빈 토큰을 허용한다", path: "/", expected: Pointer{""}, canonical: "/"},
+		{name: "빈 경로는 error를 반환한다", path: "", err: ErrInvalidPointer},
+		{name: "잘못된 escape는 error를 반환한다", path: "/a~2", err: ErrInvalidPointer},
+		{name: "끝나지 않은 escape는 error를 반환한다", path: "/a~", err: ErrInvalidPointer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			pointer, err := Parse(tt.path)
+
+			// then
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, pointer)
+			assert.Equal(t, tt.canonical, pointer.String())
+		})
+	}
+}
+
+func TestPointer_Get(t *testing.T) {
+	document := map[string]any{
+		"name":     "홍길동",
+		"variants": []any{map[string]any{"price": 1000.0}, map[string]any{"price": 2000.0}},
+		"a/b":      "slash",
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected any
+		ok       bool
+	}{
+		{name: "최상위 필드", path: "name", expected: "홍길동", ok: true},
+		{name: "배열 요소의 필드", path: "/variants/1/price", expected: 2000.0, ok: true},
+		{name: "배열 요소", path: "/variants/0", expected: map[string]any{"price": 1000.0}, ok: true},
+		{name: "escape된 키", path: "/a~1b", expected: "slash", ok: true},
+		{name: "없는 필드", path: "/unknown", ok: false},
+		{name: "범위를 벗어난 인덱스", path: "/variants/2/price", ok: false},
+		{name: "0으로 시작하는 인덱스", path: "/variants/01", ok: false},
+		{name: "문자열 아래의 경로", path: "/name/first", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			pointer, _ := Parse(tt.path)
+
+			// when
+			value, ok := pointer.Get(document)
+
+			// then
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestPointer_Set(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		value    any
+		expected map[string]any
+		err      error
+	}{
+		{
+			name:     "배열 요소의 필드를 바꾼다",
+			path:     "/variants/0/price",
+			value:    1500.0,
+			expected: map[string]any{"variants": []any{map[string]any{"price": 1500.0}}},
+		},
+		{
+			name:     "객체에 없는 키는 만든다",
+			path:     "/variants/0/stock",
+			value:    3.0,
+			expected: map[string]any{"variants": []any{map[string]any{"price": 1000.0, "stock": 3.0}}},
+		},
+		{name: "없는 배열 요소는 error를 반환한다", path: "/variants/1", value: 1.0, err: ErrNotFound},
+		{name: "없는 중간 경로는 error를 반환한다", path: "/options/color", value: "red", err: ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			document := map[string]any{"variants": []any{map[string]any{"price": 1000.0}}}
+			pointer, _ := Parse(tt.path)
+
+			// when
+			err := pointer.Set(document, tt.value)
+
+			// then
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, document)
+		})
+	}
+}
+
+func TestPointer_Add(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		value    any
+		expected map[string]any
+		err      error
+	}{
+		{name: "배열 중간에 삽입한다", path: "/tags/1", value: "b", expected: map[string]any{"tags": []any{"a", "b", "c"}, "size": map[string]any{}}},
+		{name: "배열 끝 인덱스에 삽입한다", path: "/tags/2", value: "d", expected: map[string]any{"tags": []any{"a", "c", "d"}, "size": map[string]any{}}},
+		{name: "-는 배열 끝에 추가한다", path: "/tags/-", value: "d", expected: map[string]any{"tags": []any{"a", "c", "d"}, "size": map[string]any{}}},
+		{name: "객체에 키를 추가한다", path: "/size/width", value: 10.0, expected: map[string]any{"tags": []any{"a", "c"}, "size": map[string]any{"width": 10.0}}},
+		{name: "이미 있는 키는 error를 반환한다", path: "tags", value: []any{}, err: ErrAlreadyExists},
+		{name: "배열 길이를 넘는 인덱스는 error를 반환한다", path: "/tags/3", value: "d", err: ErrNotFound},
+		{name: "숫자가 아닌 인덱스는 error를 반환한다", path: "/tags/x", value: "d", err: ErrInvalidPointer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			document := map[string]any{"tags": []any{"a", "c"}, "size": map[string]any{}}
+			pointer, _ := Parse(tt.path)
+
+			// when
+			err := pointer.Add(document, tt.value)
+
+			// then
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, document)
+		})
+	}
+}
+
+func TestPointer_Remove(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		removed  any
+		expected map[string]any
+		err      error
+	}{
+		{name: "최상위 필드를 삭제한다", path: "name", removed: "홍길동", expected: map[string]any{"tags": []any{"a", "b", "c"}}},
+		{name: "배열 요소를 삭제한다", path: "/tags/1", removed: "b", expected: map[string]any{"name": "홍길동", "tags": []any{"a", "c"}}},
+		{name: "없는 필드는 error를 반환한다", path: "/price", err: ErrNotFound},
+		{name: "-는 삭제할 수 없다", path: "/tags/-", err: ErrInvalidPointer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			document := map[string]any{"name": "홍길동", "tags": []any{"a", "b", "c"}}
+			pointer, _ := Parse(tt.path)
+
+			// when
+			removed, err := pointer.Remove(document)
+
+			// then
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.removed, removed)
+			assert.Equal(t, tt.expected, document)
+		})
+	}
+}
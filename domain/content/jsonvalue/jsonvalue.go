@@ -0,0 +1,107 @@
+// Package jsonvalue compares values decoded from JSON by their JSON meaning rather than their Go representation:
+// numbers are compared by value whatever their type, arrays are ordered and objects are unordered.
+package jsonvalue
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+)
+
+// Equal reports whether a and b are the same JSON value.
+// A nil map, slice or pointer is the same value as null, as it is what encoding/json produces for them.
+func Equal(a any, b any) bool {
+	return equal(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+func equal(a reflect.Value, b reflect.Value) bool {
+	a, b = indirect(a), indirect(b)
+
+	if !a.IsValid() || !b.IsValid() {
+		return !a.IsValid() && !b.IsValid()
+	}
+
+	if aNumber, ok := number(a); ok {
+		bNumber, ok := number(b)
+		return ok && aNumber.Cmp(bNumber) == 0
+	}
+
+	switch a.Kind() {
+	case reflect.String:
+		return b.Kind() == reflect.String && a.String() == b.String()
+	case reflect.Bool:
+		return b.Kind() == reflect.Bool && a.Bool() == b.Bool()
+	case reflect.Slice, reflect.Array:
+		if b.Kind() != reflect.Slice && b.Kind() != reflect.Array {
+			return false
+		}
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !equal(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if b.Kind() != reflect.Map || a.Type().Key().Kind() != reflect.String || b.Type().Key().Kind() != reflect.String {
+			return reflect.DeepEqual(a.Interface(), b.Interface())
+		}
+		if a.Len() != b.Len() {
+			return false
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			bValue := b.MapIndex(reflect.ValueOf(iter.Key().String()).Convert(b.Type().Key()))
+			if !bValue.IsValid() || !equal(iter.Value(), bValue) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+}
+
+// indirect unwraps interfaces and pointers, and returns the zero Value for null.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() {
+		switch v.Kind() {
+		case reflect.Interface, reflect.Pointer:
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		case reflect.Map, reflect.Slice:
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			return v
+		default:
+			return v
+		}
+	}
+	return v
+}
+
+var numberType = reflect.TypeOf(json.Number(""))
+
+// number returns the exact value of a Go number or a json.Number, NaN and infinities are not JSON numbers.
+func number(v reflect.Value) (*big.Rat, bool) {
+	if v.Type() == numberType {
+		return new(big.Rat).SetString(v.String())
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(v.Uint())), true
+	case reflect.Float32, reflect.Float64:
+		r := new(big.Rat).SetFloat64(v.Float())
+		return r, r != nil
+	default:
+		return nil, false
+	}
+}
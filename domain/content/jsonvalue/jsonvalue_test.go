@@ -0,0 +1,137 @@
+package jsonvalue
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type namedMap map[string]any
+
+type namedString string
+
+func TestEqual(t *testing.T) {
+	one := 1
+	name := "홍길동"
+	var nilMap map[string]any
+	var nilSlice []any
+	var nilPointer *int
+
+	tests := []struct {
+		name     string
+		a        any
+		b        any
+		expected bool
+	}{
+		// null
+		{name: "null과 null은 같다", a: nil, b: nil, expected: true},
+		{name: "null과 빈 문자열은 다르다", a: nil, b: "", expected: false},
+		{name: "null과 0은 다르다", a: nil, b: 0, expected: false},
+		{name: "null과 false는 다르다", a: nil, b: false, expected: false},
+		{name: "null과 빈 배열은 다르다", a: nil, b: []any{}, expected: false},
+		{name: "null과 빈 객체는 다르다", a: nil, b: map[string]any{}, expected: false},
+		{name: "nil map은 null이다", a: nilMap, b: nil, expected: true},
+		{name: "nil slice는 null이다", a: nilSlice, b: nil, expected: true},
+		{name: "nil pointer는 null이다", a: nilPointer, b: nil, expected: true},
+
+		// numbers
+		{name: "같은 float64는 같다", a: 1.5, b: 1.5, expected: true},
+		{name: "다른 float64는 다르다", a: 1.5, b: 2.5, expected: false},
+		{name: "float64와 int는 값이 같으면 같다", a: 1.0, b: 1, expected: true},
+		{name: "float64와 int는 값이 다르면 다르다", a: 1.5, b: 1, expected: false},
+		{name: "int64와 uint8은 값이 같으면 같다", a: int64(200), b: uint8(200), expected: true},
+		{name: "음수 int와 uint는 다르다", a: -1, b: uint(math.MaxUint), expected: false},
+		{name: "큰 uint64를 정확히 비교한다", a: uint64(math.MaxUint64), b: uint64(math.MaxUint64 - 1), expected: false},
+		{name: "json.Number와 float64는 값이 같으면 같다", a: json.Number("2.50"), b: 2.5, expected: true},
+		{name: "지수 표기 json.Number와 int는 값이 같으면 같다", a: json.Number("1e3"), b: 1000, expected: true},
+		{name: "json.Number끼리 값이 다르면 다르다", a: json.Number("1"), b: json.Number("2"), expected: false},
+		{name: "0과 -0은 같다", a: 0.0, b: math.Copysign(0, -1), expected: true},
+		{name: "NaN은 자기 자신과도 다르다", a: math.NaN(), b: math.NaN(), expected: false},
+		{name: "숫자와 숫자 문자열은 다르다", a: 1, b: "1", expected: false},
+		{name: "숫자 pointer는 숫자와 같다", a: &one, b: 1.0, expected: true},
+
+		// strings and booleans
+		{name: "같은 문자열은 같다", a: "a", b: "a", expected: true},
+		{name: "다른 문자열은 다르다", a: "a", b: "b", expected: false},
+		{name: "이름 붙은 string 타입도 값으로 비교한다", a: namedString("a"), b: "a", expected: true},
+		{name: "문자열 pointer는 문자열과 같다", a: &name, b: "홍길동", expected: true},
+		{name: "true와 true는 같다", a: true, b: true, expected: true},
+		{name: "true와 false는 다르다", a: true, b: false, expected: false},
+		{name: "true와 문자열 true는 다르다", a: true, b: "true", expected: false},
+
+		// arrays
+		{name: "빈 배열은 같다", a: []any{}, b: []any{}, expected: true},
+		{name: "같은 순서의 배열은 같다", a: []any{"a", 1.0}, b: []any{"a", 1}, expected: true},
+		{name: "순서가 다른 배열은 다르다", a: []any{"a", "b"}, b: []any{"b", "a"}, expected: false},
+		{name: "길이가 다른 배열은 다르다", a: []any{"a"}, b: []any{"a", "a"}, expected: false},
+		{name: "타입이 다른 slice도 요소가 같으면 같다", a: []string{"a", "b"}, b: []any{"a", "b"}, expected: true},
+		{name: "Go 배열과 slice는 요소가 같으면 같다", a: [2]int{1, 2}, b: []any{1.0, 2.0}, expected: true},
+		{name: "배열과 객체는 다르다", a: []any{}, b: map[string]any{}, expected: false},
+		{name: "중첩된 배열을 비교한다", a: []any{[]any{1.0}, []any{2.0}}, b: []any{[]any{1}, []any{2}}, expected: true},
+
+		// objects
+		{name: "빈 객체는 같다", a: map[string]any{}, b: map[string]any{}, expected: true},
+		{name: "키 순서와 무관하게 같다", a: map[string]any{"a": 1.0, "b": 2.0}, b: map[string]any{"b": 2, "a": 1}, expected: true},
+		{name: "값이 다른 객체는 다르다", a: map[string]any{"a": 1.0}, b: map[string]any{"a": 2.0}, expected: false},
+		{name: "키가 다른 객체는 다르다", a: map[string]any{"a": 1.0}, b: map[string]any{"b": 1.0}, expected: false},
+		{name: "키 개수가 다른 객체는 다르다", a: map[string]any{"a": 1.0}, b: map[string]any{"a": 1.0, "b": 1.0}, expected: false},
+		{name: "null 값을 가진 키와 키가 없는 객체는 다르다", a: map[string]any{"a": nil}, b: map[string]any{"b": nil}, expected: false},
+		{name: "이름 붙은 map 타입도 값으로 비교한다", a: namedMap{"a": "x"}, b: map[string]any{"a": "x"}, expected: true},
+		{name: "타입이 다른 map도 값이 같으면 같다", a: map[string]string{"a": "x"}, b: map[string]any{"a": "x"}, expected: true},
+		{
+			name:     "중첩된 객체와 배열을 비교한다",
+			a:        map[string]any{"variants": []any{map[string]any{"price": 1000.0, "tags": []any{"a"}}}},
+			b:        map[string]any{"variants": []any{map[string]any{"tags": []any{"a"}, "price": 1000}}},
+			expected: true,
+		},
+		{
+			name:     "중첩된 배열의 순서가 다르면 다르다",
+			a:        map[string]any{"variants": []any{map[string]any{"tags": []any{"a", "b"}}}},
+			b:        map[string]any{"variants": []any{map[string]any{"tags": []any{"b", "a"}}}},
+			expected: false,
+		},
+		{name: "객체와 문자열은 다르다", a: map[string]any{}, b: "{}", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			actual := Equal(tt.a, tt.b)
+			reversed := Equal(tt.b, tt.a)
+
+			// then
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expected, reversed)
+		})
+	}
+}
+
+func TestEqual_JSON으로_디코딩한_값(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{name: "공백과 키 순서는 무시한다", a: `{"a": [1, 2], "b": {"c": null}}`, b: `{"b":{"c":null},"a":[1,2]}`, expected: true},
+		{name: "숫자 표기는 무시한다", a: `[1, 1.0, 1e0]`, b: `[1.00, 10e-1, 1]`, expected: true},
+		{name: "배열 순서는 비교한다", a: `[1, 2]`, b: `[2, 1]`, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			var a, b any
+			assert.NoError(t, json.Unmarshal([]byte(tt.a), &a))
+			assert.NoError(t, json.Unmarshal([]byte(tt.b), &b))
+
+			// when
+			actual := Equal(a, b)
+
+			// then
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package content
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const contentKeySize = 32
+
+// ContentKey is the encryption key of the personal data fields of a content. Destroying the key makes
+// every encrypted value in the event stream unreadable, which is how a content is erased without rewriting its history.
+type ContentKey struct {
+	ContentId   string `gorm:"type:varchar(100);primaryKey"`
+	TenantId    string `gorm:"type:varchar(100);not null;index"`
+	Key         []byte
+	CreatedAt   time.Time
+	DestroyedAt *time.Time
+}
+
+func NewContentKey(tenantId string, contentId string) (*ContentKey, error) {
+	key := make([]byte, contentKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Wrap(err, "rand.Read")
+	}
+
+	return &ContentKey{
+		ContentId: contentId,
+		TenantId:  tenantId,
+		Key:       key,
+	}, nil
+}
+
+func (*ContentKey) TableName() string {
+	return "content_keys"
+}
+
+func (k *ContentKey) IsDestroyed() bool {
+	return k.DestroyedAt != nil
+}
+
+// Encrypt seals the plaintext with AES-256-GCM and returns the nonce followed by the ciphertext, base64 encoded.
+func (k *ContentKey) Encrypt(plaintext []byte) (string, error) {
+	if k.IsDestroyed() {
+		return "", errors.Wrapf(ErrContentPurged, "contentId: %s", k.ContentId)
+	}
+
+	gcm, err := k.gcm()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Wrap(err, "rand.Read")
+	}
+
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, plaintext, nil)), nil
+}
+
+func (k *ContentKey) Decrypt(ciphertext string) ([]byte, error) {
+	if k.IsDestroyed() {
+		return nil, errors.Wrapf(ErrContentPurged, "contentId: %s", k.ContentId)
+	}
+
+	gcm, err := k.gcm()
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, errors.Wrap(err, "base64.DecodeString")
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.Errorf("ciphertext too short, contentId: %s", k.ContentId)
+	}
+
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "gcm.Open contentId: %s", k.ContentId)
+	}
+	return plaintext, nil
+}
+
+func (k *ContentKey) gcm() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(k.Key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "aes.NewCipher contentId: %s", k.ContentId)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,232 @@
+package content
+
+import (
+	"contentgit/domain/content/events"
+	"contentgit/domain/content/jsonvalue"
+	"context"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// localePattern matches a BCP 47 language tag such as ko, ko-KR or zh-Hant-TW.
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// IsLocale tells whether locale is a language tag a localized field can hold a value for.
+func IsLocale(locale string) bool {
+	return localePattern.MatchString(locale)
+}
+
+// LocaleFallbacks are the locales of each tenant in the order a read falls back through them, the first one is the
+// default locale of the tenant. Tenants are case-insensitive.
+type LocaleFallbacks map[string][]string
+
+// NewLocaleFallbacks builds the fallback chains of tenants from their locales.
+func NewLocaleFallbacks(locales map[string][]string) (LocaleFallbacks, error) {
+	fallbacks := make(LocaleFallbacks, len(locales))
+	for tenantId, chain := range locales {
+		for _, locale := range chain {
+			if !IsLocale(locale) {
+				return nil, errors.Wrapf(ErrInvalidLocale, "tenant: %s, locale: %s", tenantId, locale)
+			}
+		}
+		fallbacks[strings.ToLower(tenantId)] = chain
+	}
+	return fallbacks, nil
+}
+
+// Locales returns the locales of the tenant, none when the tenant has no fallback chain.
+func (f LocaleFallbacks) Locales(tenantId string) []string {
+	return f[strings.ToLower(tenantId)]
+}
+
+// Chain returns the locales a read in locale goes through: the locale, its language when it has a region,
+// then the locales of the tenant.
+func (f LocaleFallbacks) Chain(tenantId string, locale string) []string {
+	chain := []string{locale}
+	if language, _, ok := strings.Cut(locale, "-"); ok {
+		chain = append(chain, language)
+	}
+	for _, fallback := range f.Locales(tenantId) {
+		if !slices.Contains(chain, fallback) {
+			chain = append(chain, fallback)
+		}
+	}
+	return chain
+}
+
+// localizedValues returns the values by locale a localized field holds.
+func localizedValues(value any) (map[string]any, bool) {
+	values, ok := value.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	for locale := range values {
+		if !IsLocale(locale) {
+			return nil, false
+		}
+	}
+	return values, true
+}
+
+// Localize returns a copy of a content with the value of each localized field in the first locale of chain it was
+// translated to, and the locale each value was taken from. A localized field translated to none of the locales is left out.
+func (s *ContentTypeSchema) Localize(content map[string]any, chain []string) (map[string]any, map[string]string) {
+	localized := copyContent(content)
+	fieldLocales := make(map[string]string)
+	for _, field := range s.Fields {
+		if !field.Localized {
+			continue
+		}
+		values, ok := localizedValues(content[field.Name])
+		if !ok {
+			continue
+		}
+
+		delete(localized, field.Name)
+		for _, locale := range chain {
+			if value := values[locale]; value != nil {
+				localized[field.Name] = copyValue(value)
+				fieldLocales[field.Name] = locale
+				break
+			}
+		}
+	}
+	return localized, fieldLocales
+}
+
+// LocaleCompleteness is how many of the localized fields of a content are translated to a locale, Missing are the others.
+type LocaleCompleteness struct {
+	Locale     string
+	Translated int
+	Total      int
+	Missing    []string
+}
+
+func (c LocaleCompleteness) IsComplete() bool {
+	return c.Translated == c.Total
+}
+
+// LocaleCompleteness reports the completeness of a content in the given locales followed by the other locales it
+// was translated to, none for a content type without localized fields.
+func (s *ContentTypeSchema) LocaleCompleteness(content map[string]any, locales []string) []LocaleCompleteness {
+	fieldNames := make([]string, 0)
+	others := make([]string, 0)
+	for _, field := range s.Fields {
+		if !field.Localized {
+			continue
+		}
+		fieldNames = append(fieldNames, field.Name)
+
+		values, _ := localizedValues(content[field.Name])
+		for locale, value := range values {
+			if value != nil && !slices.Contains(locales, locale) && !slices.Contains(others, locale) {
+				others = append(others, locale)
+			}
+		}
+	}
+	if len(fieldNames) == 0 {
+		return nil
+	}
+	sort.Strings(others)
+
+	completeness := make([]LocaleCompleteness, 0, len(locales)+len(others))
+	for _, locale := range append(slices.Clone(locales), others...) {
+		localeCompleteness := LocaleCompleteness{Locale: locale, Total: len(fieldNames), Missing: make([]string, 0)}
+		for _, name := range fieldNames {
+			values, _ := localizedValues(content[name])
+			if values[locale] != nil {
+				localeCompleteness.Translated++
+			} else {
+				localeCompleteness.Missing = append(localeCompleteness.Missing, name)
+			}
+		}
+		completeness = append(completeness, localeCompleteness)
+	}
+	return completeness
+}
+
+// UpdateLocalizedField updates the value of a localized field in locale after checking it still holds beforeValue,
+// nil when the field is not translated to the locale. A nil afterValue removes the translation, the first translation
+// of a field the content does not hold yet adds the field.
+func (a *ContentAggregate) UpdateLocalizedField(ctx context.Context, fieldName string, locale string, beforeValue any, afterValue any,
+	createdById string, createdByName string) error {
+	path, err := parseFieldPath(fieldName)
+	if err != nil {
+		return err
+	}
+	if !IsLocale(locale) {
+		return errors.Wrapf(ErrInvalidLocale, "locale: %s", locale)
+	}
+
+	current, ok := path.Get(a.Content)
+	if !ok {
+		if beforeValue != nil {
+			return ErrFieldUpdateConflict
+		}
+		if afterValue == nil {
+			return nil
+		}
+		return a.Apply(&events.FieldAddedEventV1{
+			FieldName:     path.String(),
+			Locale:        locale,
+			Value:         map[string]any{locale: afterValue},
+			CreatedById:   createdById,
+			CreatedByName: createdByName,
+		})
+	}
+
+	values, ok := localizedValues(current)
+	if !ok {
+		return errors.Wrapf(ErrFieldNotLocalized, "fieldName: %s", path.String())
+	}
+	if !jsonvalue.Equal(values[locale], beforeValue) {
+		return ErrFieldUpdateConflict
+	}
+
+	afterValues := copyContent(values)
+	if afterValue == nil {
+		delete(afterValues, locale)
+	} else {
+		afterValues[locale] = afterValue
+	}
+
+	return a.Apply(&events.FieldUpdatedEventV1{
+		FieldName:     path.String(),
+		Locale:        locale,
+		BeforeValue:   values,
+		AfterValue:    afterValues,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	})
+}
+
+// AddLocalizedFieldComment starts the comment thread commentId on the value of a localized field in locale, which
+// does not have to be translated yet.
+func (a *ContentAggregate) AddLocalizedFieldComment(ctx context.Context, commentId string, fieldName string, locale string,
+	comment string, createdById string, createdByName string) error {
+	path, err := parseFieldPath(fieldName)
+	if err != nil {
+		return err
+	}
+	if !IsLocale(locale) {
+		return errors.Wrapf(ErrInvalidLocale, "locale: %s", locale)
+	}
+	if err := a.validateNewComment(commentId, comment); err != nil {
+		return err
+	}
+
+	event := &events.FieldCommentAddedEventV1{
+		FieldName:     append(path, locale).JSONPointer(),
+		Locale:        locale,
+		CommentId:     commentId,
+		Comment:       comment,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}
+
+	return a.Apply(event)
+}
@@ -0,0 +1,261 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGuideSchema(t *testing.T) *ContentTypeSchema {
+	schema, err := NewContentTypeSchema("atlas", "guides", nil, "가이드", []FieldDefinition{
+		{Name: "slug", Type: FieldTypeString, Required: true},
+		{Name: "title", Type: FieldTypeString, Required: true, Localized: true},
+		{Name: "body", Type: FieldTypeString, Localized: true},
+	}, nil, "1", "사이트 관리자")
+	require.NoError(t, err)
+	return schema
+}
+
+func TestLocaleFallbacks_Chain(t *testing.T) {
+	t.Run("요청한 로케일, 그 언어, 테넌트의 로케일 순서로 대체한다", func(t *testing.T) {
+		// given
+		sut, err := NewLocaleFallbacks(map[string][]string{"atlas": {"ko-KR", "en-US"}})
+		require.NoError(t, err)
+
+		// when
+		chain := sut.Chain("Atlas", "ja-JP")
+
+		// then
+		assert.Equal(t, []string{"ja-JP", "ja", "ko-KR", "en-US"}, chain)
+		assert.Equal(t, []string{"en-US", "en", "ko-KR"}, sut.Chain("atlas", "en-US"))
+		assert.Equal(t, []string{"ko-KR", "ko"}, sut.Chain("gridge", "ko-KR"))
+	})
+
+	t.Run("로케일이 잘못되면 ErrInvalidLocale을 반환한다", func(t *testing.T) {
+		// when
+		_, err := NewLocaleFallbacks(map[string][]string{"atlas": {"korean"}})
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidLocale)
+	})
+}
+
+func TestContentTypeSchema_Validate_Localized(t *testing.T) {
+	t.Run("로케일마다 값을 검사한다", func(t *testing.T) {
+		// given
+		sut := newGuideSchema(t)
+
+		// when
+		err := sut.Validate(map[string]any{
+			"slug":  "getting-started",
+			"title": map[string]any{"ko-KR": "시작하기", "en-US": float64(1)},
+			"body":  "본문",
+		})
+
+		// then
+		assert.ErrorIs(t, err, ErrSchemaViolation)
+		assert.Equal(t, []FieldViolation{
+			{Field: "body", Message: "must be an object of values by locale"},
+			{Field: "title", Message: "in en-US must be of type string"},
+		}, err.(*SchemaViolationError).Violations)
+	})
+
+	t.Run("필수 필드는 한 로케일 이상 번역되어야 한다", func(t *testing.T) {
+		// given
+		sut := newGuideSchema(t)
+
+		// when
+		err := sut.Validate(map[string]any{"slug": "getting-started", "title": map[string]any{"ko-KR": nil}})
+
+		// then
+		assert.ErrorIs(t, err, ErrSchemaViolation)
+		assert.Equal(t, []FieldViolation{{Field: "title", Message: "is required"}}, err.(*SchemaViolationError).Violations)
+	})
+
+	t.Run("참조 필드는 로케일별 값을 가질 수 없다", func(t *testing.T) {
+		// when
+		_, err := NewContentTypeSchema("atlas", "guides", nil, "", []FieldDefinition{
+			{Name: "author", Type: FieldTypeReference, Localized: true},
+		}, nil, "1", "사이트 관리자")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidSchema)
+	})
+}
+
+func TestContentTypeSchema_Localize(t *testing.T) {
+	t.Run("로케일별 필드를 대체 순서에서 처음 번역된 로케일의 값으로 읽는다", func(t *testing.T) {
+		// given
+		sut := newGuideSchema(t)
+		content := map[string]any{
+			"slug":  "getting-started",
+			"title": map[string]any{"ko-KR": "시작하기", "en-US": "Getting started"},
+			"body":  map[string]any{"ko-KR": "본문"},
+		}
+
+		// when
+		localized, fieldLocales := sut.Localize(content, []string{"en-US", "en", "ko-KR"})
+
+		// then
+		assert.Equal(t, map[string]any{"slug": "getting-started", "title": "Getting started", "body": "본문"}, localized)
+		assert.Equal(t, map[string]string{"title": "en-US", "body": "ko-KR"}, fieldLocales)
+		assert.Equal(t, map[string]any{"ko-KR": "시작하기", "en-US": "Getting started"}, content["title"])
+	})
+
+	t.Run("어느 로케일로도 번역되지 않은 필드는 빠진다", func(t *testing.T) {
+		// given
+		sut := newGuideSchema(t)
+
+		// when
+		localized, fieldLocales := sut.Localize(map[string]any{
+			"slug":  "getting-started",
+			"title": map[string]any{"ko-KR": "시작하기"},
+		}, []string{"ja-JP", "ja"})
+
+		// then
+		assert.Equal(t, map[string]any{"slug": "getting-started"}, localized)
+		assert.Empty(t, fieldLocales)
+	})
+}
+
+func TestContentTypeSchema_LocaleCompleteness(t *testing.T) {
+	t.Run("테넌트의 로케일과 번역된 다른 로케일의 완성도를 반환한다", func(t *testing.T) {
+		// given
+		sut := newGuideSchema(t)
+
+		// when
+		completeness := sut.LocaleCompleteness(map[string]any{
+			"slug":  "getting-started",
+			"title": map[string]any{"ko-KR": "시작하기", "en-US": "Getting started", "ja-JP": "はじめに"},
+			"body":  map[string]any{"ko-KR": "본문"},
+		}, []string{"ko-KR", "en-US"})
+
+		// then
+		assert.Equal(t, []LocaleCompleteness{
+			{Locale: "ko-KR", Translated: 2, Total: 2, Missing: []string{}},
+			{Locale: "en-US", Translated: 1, Total: 2, Missing: []string{"body"}},
+			{Locale: "ja-JP", Translated: 1, Total: 2, Missing: []string{"body"}},
+		}, completeness)
+		assert.True(t, completeness[0].IsComplete())
+		assert.False(t, completeness[1].IsComplete())
+	})
+
+	t.Run("로케일별 필드가 없으면 완성도가 없다", func(t *testing.T) {
+		// given
+		sut := newProductSchema(t)
+
+		// when
+		completeness := sut.LocaleCompleteness(map[string]any{"name": "무드등"}, []string{"ko-KR"})
+
+		// then
+		assert.Nil(t, completeness)
+	})
+}
+
+func TestContentAggregate_UpdateLocalizedField(t *testing.T) {
+	t.Run("한 로케일의 값을 업데이트한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "atlas")
+		sut.Content = map[string]any{"title": map[string]any{"ko-KR": "시작하기"}}
+
+		// when
+		err := sut.UpdateLocalizedField(context.Background(), "title", "en-US", nil, "Getting started", "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"ko-KR": "시작하기", "en-US": "Getting started"}, sut.Content["title"])
+	})
+
+	t.Run("null로 업데이트하면 로케일의 번역을 지운다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "atlas")
+		sut.Content = map[string]any{"title": map[string]any{"ko-KR": "시작하기", "en-US": "Getting started"}}
+
+		// when
+		err := sut.UpdateLocalizedField(context.Background(), "title", "en-US", "Getting started", nil, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"ko-KR": "시작하기"}, sut.Content["title"])
+	})
+
+	t.Run("없는 필드는 로케일의 값으로 추가한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "atlas")
+		sut.Content = map[string]any{"slug": "getting-started"}
+
+		// when
+		err := sut.UpdateLocalizedField(context.Background(), "body", "ko-KR", nil, "본문", "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"ko-KR": "본문"}, sut.Content["body"])
+	})
+
+	t.Run("로케일의 값이 충돌하면 ErrFieldUpdateConflict를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "atlas")
+		sut.Content = map[string]any{"title": map[string]any{"ko-KR": "시작하기"}}
+
+		// when
+		err := sut.UpdateLocalizedField(context.Background(), "title", "ko-KR", "시작", "처음 시작하기", "testerId", "testerName")
+
+		// then
+		assert.Equal(t, ErrFieldUpdateConflict, err)
+	})
+
+	t.Run("로케일별 값이 아닌 필드면 ErrFieldNotLocalized를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "atlas")
+		sut.Content = map[string]any{"slug": "getting-started"}
+
+		// when
+		err := sut.UpdateLocalizedField(context.Background(), "slug", "en-US", nil, "start", "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrFieldNotLocalized)
+	})
+
+	t.Run("로케일이 잘못되면 ErrInvalidLocale을 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "atlas")
+		sut.Content = map[string]any{"title": map[string]any{"ko-KR": "시작하기"}}
+
+		// when
+		err := sut.UpdateLocalizedField(context.Background(), "title", "KO", nil, "시작하기", "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidLocale)
+	})
+}
+
+func TestContentAggregate_AddLocalizedFieldComment(t *testing.T) {
+	t.Run("아직 번역되지 않은 로케일의 값에도 댓글을 단다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "atlas")
+		sut.Content = map[string]any{"title": map[string]any{"ko-KR": "시작하기"}}
+
+		// when
+		err := sut.AddLocalizedFieldComment(context.Background(), uuid.New().String(), "title", "en-US", "번역해 주세요", "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(sut.FieldComments))
+		assert.Equal(t, "/title/en-US", sut.FieldComments[0].FieldName)
+	})
+
+	t.Run("필드가 없으면 ErrFieldNotFound를 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewContentAggregate(uuid.New().String(), "atlas")
+		sut.Content = map[string]any{"slug": "getting-started"}
+
+		// when
+		err := sut.AddLocalizedFieldComment(context.Background(), uuid.New().String(), "title", "en-US", "번역해 주세요", "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrFieldNotFound)
+	})
+}
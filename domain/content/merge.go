@@ -0,0 +1,193 @@
+package content
+
+import (
+	"contentgit/domain/content/events"
+	"contentgit/domain/content/jsonvalue"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"fmt"
+	"sort"
+)
+
+// MergeBase is the version of another branch that a branch last incorporated, either by being forked from it
+// or by merging it. AtVersion is the version of the branch itself when that happened.
+type MergeBase struct {
+	Version   uint64 `json:"version"`
+	AtVersion uint64 `json:"atVersion"`
+}
+
+// FieldConflict is a field changed on both sides to different values. SourceRemoved and TargetRemoved tell a
+// field removed on a side apart from a field set to null there.
+type FieldConflict struct {
+	FieldName     string `json:"fieldName"`
+	BaseValue     any    `json:"baseValue"`
+	SourceValue   any    `json:"sourceValue"`
+	TargetValue   any    `json:"targetValue"`
+	SourceRemoved bool   `json:"sourceRemoved,omitempty"`
+	TargetRemoved bool   `json:"targetRemoved,omitempty"`
+}
+
+type MergeResult struct {
+	Fields    []events.MergedField
+	Conflicts []FieldConflict
+}
+
+// MergeConflictError is returned when a merge has conflicting fields that were not resolved.
+type MergeConflictError struct {
+	Conflicts []FieldConflict
+}
+
+func (e *MergeConflictError) Error() string {
+	return fmt.Sprintf("%s: %d fields", ErrMergeConflict.Error(), len(e.Conflicts))
+}
+
+func (e *MergeConflictError) Is(target error) bool {
+	return target == ErrMergeConflict
+}
+
+// ThreeWayMerge merges the fields of source into target using base as their common ancestor.
+// A field changed only on source is taken from source, a field changed only on target is kept,
+// and a field changed on both sides to different values is reported as a conflict.
+// A field missing from a side is not the same as a field set to null, so a field removed on source is removed
+// from target unless target changed it, and a removal against an edit is a conflict.
+func ThreeWayMerge(base map[string]any, source map[string]any, target map[string]any) MergeResult {
+	result := MergeResult{
+		Fields:    make([]events.MergedField, 0),
+		Conflicts: make([]FieldConflict, 0),
+	}
+
+	for _, fieldName := range unionKeys(base, source, target) {
+		baseValue, inBase := base[fieldName]
+		sourceValue, inSource := source[fieldName]
+		targetValue, inTarget := target[fieldName]
+
+		if sameField(baseValue, inBase, sourceValue, inSource) || sameField(sourceValue, inSource, targetValue, inTarget) {
+			continue
+		}
+
+		if sameField(baseValue, inBase, targetValue, inTarget) {
+			result.Fields = append(result.Fields, events.MergedField{
+				FieldName:   fieldName,
+				BeforeValue: targetValue,
+				AfterValue:  sourceValue,
+				Removed:     !inSource,
+			})
+			continue
+		}
+
+		result.Conflicts = append(result.Conflicts, FieldConflict{
+			FieldName:     fieldName,
+			BaseValue:     baseValue,
+			SourceValue:   sourceValue,
+			TargetValue:   targetValue,
+			SourceRemoved: !inSource,
+			TargetRemoved: !inTarget,
+		})
+	}
+
+	return result
+}
+
+// sameField compares two sides of a field, a missing field is only the same as another missing field.
+func sameField(a any, aPresent bool, b any, bPresent bool) bool {
+	if !aPresent || !bPresent {
+		return aPresent == bPresent
+	}
+	return jsonvalue.Equal(a, b)
+}
+
+// MergeBaseWith finds the common ancestor of the aggregate and source as a branch and version.
+func (a *ContentAggregate) MergeBaseWith(source *ContentAggregate) (string, uint64, error) {
+	if a.GetBranch() == source.GetBranch() {
+		return "", 0, ErrNoMergeBase
+	}
+
+	targetBase, targetHasBase := a.MergeBases[source.GetBranch()]
+	sourceBase, sourceHasBase := source.MergeBases[a.GetBranch()]
+
+	switch {
+	case targetHasBase && sourceHasBase:
+		// source incorporated the target after the target last incorporated source
+		if sourceBase.AtVersion > targetBase.Version {
+			return a.GetBranch(), sourceBase.Version, nil
+		}
+		return source.GetBranch(), targetBase.Version, nil
+	case targetHasBase:
+		return source.GetBranch(), targetBase.Version, nil
+	case sourceHasBase:
+		return a.GetBranch(), sourceBase.Version, nil
+	default:
+		return "", 0, ErrNoMergeBase
+	}
+}
+
+// MergeParticipants are the aggregates taking part in a merge of Source into Target.
+type MergeParticipants struct {
+	Source *ContentAggregate
+	Target *ContentAggregate
+	Base   *ContentAggregate
+}
+
+// LoadMergeParticipants loads the heads of source and target branch and their common ancestor.
+func LoadMergeParticipants(ctx context.Context, aggregateStore eventsourcing.AggregateStore, id string, tenantId string,
+	sourceBranch string, targetBranch string) (*MergeParticipants, error) {
+	source, err := loadBranchHead(ctx, aggregateStore, id, tenantId, sourceBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := loadBranchHead(ctx, aggregateStore, id, tenantId, targetBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	baseBranch, baseVersion, err := target.MergeBaseWith(source)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := NewContentAggregateOnBranch(id, tenantId, baseBranch)
+	if err != nil {
+		return nil, err
+	}
+	if err := aggregateStore.LoadAtVersion(ctx, base, baseVersion); err != nil {
+		return nil, err
+	}
+
+	return &MergeParticipants{Source: source, Target: target, Base: base}, nil
+}
+
+func loadBranchHead(ctx context.Context, aggregateStore eventsourcing.AggregateStore, id string, tenantId string, branch string) (*ContentAggregate, error) {
+	aggregate, err := NewContentAggregateOnBranch(id, tenantId, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := aggregateStore.Load(ctx, aggregate); err != nil {
+		return nil, err
+	}
+	if aggregate.GetVersion() == 0 || aggregate.GetTenantId() != tenantId {
+		return nil, eventsourcing.ErrAggregateNotFound
+	}
+
+	return aggregate, nil
+}
+
+func unionKeys(maps ...map[string]any) []string {
+	union := make(map[string]struct{})
+	for _, m := range maps {
+		for key := range m {
+			union[key] = struct{}{}
+		}
+	}
+	return sortedKeys(union)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,270 @@
+package content
+
+import (
+	"contentgit/domain/content/events"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThreeWayMerge(t *testing.T) {
+	t.Run("한쪽에서만 변경된 필드는 자동으로 병합된다", func(t *testing.T) {
+		// given
+		base := map[string]any{"name": "홍길동", "price": "1000", "taxRate": "10"}
+		source := map[string]any{"name": "고길동", "price": "1000", "taxRate": "10"}
+		target := map[string]any{"name": "홍길동", "price": "2000", "taxRate": "10"}
+
+		// when
+		result := ThreeWayMerge(base, source, target)
+
+		// then
+		assert.Empty(t, result.Conflicts)
+		assert.Equal(t, 1, len(result.Fields))
+		assert.Equal(t, "name", result.Fields[0].FieldName)
+		assert.Equal(t, "홍길동", result.Fields[0].BeforeValue)
+		assert.Equal(t, "고길동", result.Fields[0].AfterValue)
+	})
+
+	t.Run("양쪽에서 같은 값으로 변경된 필드는 병합할 필요가 없다", func(t *testing.T) {
+		// given
+		base := map[string]any{"name": "홍길동"}
+		source := map[string]any{"name": "고길동"}
+		target := map[string]any{"name": "고길동"}
+
+		// when
+		result := ThreeWayMerge(base, source, target)
+
+		// then
+		assert.Empty(t, result.Conflicts)
+		assert.Empty(t, result.Fields)
+	})
+
+	t.Run("양쪽에서 다른 값으로 변경된 필드는 충돌로 보고된다", func(t *testing.T) {
+		// given
+		base := map[string]any{"name": "홍길동", "tags": []any{"a"}}
+		source := map[string]any{"name": "고길동", "tags": []any{"a", "b"}}
+		target := map[string]any{"name": "둘리", "tags": []any{"a"}}
+
+		// when
+		result := ThreeWayMerge(base, source, target)
+
+		// then
+		assert.Equal(t, []FieldConflict{{FieldName: "name", BaseValue: "홍길동", SourceValue: "고길동", TargetValue: "둘리"}}, result.Conflicts)
+		assert.Equal(t, 1, len(result.Fields))
+		assert.Equal(t, "tags", result.Fields[0].FieldName)
+	})
+
+	t.Run("source에서 삭제된 필드는 target에서도 삭제된다", func(t *testing.T) {
+		// given
+		base := map[string]any{"name": "홍길동", "memo": "메모"}
+		source := map[string]any{"name": "홍길동"}
+		target := map[string]any{"name": "둘리", "memo": "메모"}
+
+		// when
+		result := ThreeWayMerge(base, source, target)
+
+		// then
+		assert.Empty(t, result.Conflicts)
+		assert.Equal(t, []events.MergedField{{FieldName: "memo", BeforeValue: "메모", Removed: true}}, result.Fields)
+	})
+
+	t.Run("없는 필드와 null인 필드는 다르다", func(t *testing.T) {
+		// given
+		base := map[string]any{"memo": "메모"}
+		source := map[string]any{"memo": nil}
+		target := map[string]any{}
+
+		// when
+		result := ThreeWayMerge(base, source, target)
+
+		// then
+		assert.Equal(t, []FieldConflict{{FieldName: "memo", BaseValue: "메모", TargetRemoved: true}}, result.Conflicts)
+		assert.Empty(t, result.Fields)
+	})
+
+	t.Run("한쪽에서 삭제하고 다른 쪽에서 변경한 필드는 충돌로 보고된다", func(t *testing.T) {
+		// given
+		base := map[string]any{"memo": "메모"}
+		source := map[string]any{}
+		target := map[string]any{"memo": "새 메모"}
+
+		// when
+		result := ThreeWayMerge(base, source, target)
+
+		// then
+		assert.Equal(t, []FieldConflict{{FieldName: "memo", BaseValue: "메모", TargetValue: "새 메모", SourceRemoved: true}}, result.Conflicts)
+	})
+
+	t.Run("JSON 값이 같으면 표현이 달라도 변경으로 보지 않는다", func(t *testing.T) {
+		// given
+		base := map[string]any{"size": map[string]any{"width": 10.0, "height": 20.0}}
+		source := map[string]any{"size": map[string]any{"height": 20, "width": 10}}
+		target := map[string]any{"size": map[string]any{"width": 15.0, "height": 20.0}}
+
+		// when
+		result := ThreeWayMerge(base, source, target)
+
+		// then
+		assert.Empty(t, result.Conflicts)
+		assert.Empty(t, result.Fields)
+	})
+}
+
+func TestContentAggregate_MergeBaseWith(t *testing.T) {
+	t.Run("branch는 생성된 시점의 source 버전을 공통 조상으로 가진다", func(t *testing.T) {
+		// given
+		main, feature := newMainAndFeature(t)
+		_ = main.UpdateField(context.Background(), "name", "홍길동", "둘리", "testerId", "testerName")
+
+		// when
+		branch, version, err := main.MergeBaseWith(feature)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, eventsourcing.DefaultBranch, branch)
+		assert.Equal(t, uint64(1), version)
+	})
+
+	t.Run("병합 이후에는 마지막으로 병합된 버전을 공통 조상으로 가진다", func(t *testing.T) {
+		// given
+		main, feature := newMainAndFeature(t)
+		_ = feature.UpdateField(context.Background(), "name", "홍길동", "고길동", "testerId", "testerName")
+		_ = main.Merge(context.Background(), feature, mustBase(t, main, 1), nil, nil, "testerId", "testerName")
+
+		// when
+		branch, version, err := main.MergeBaseWith(feature)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "feature", branch)
+		assert.Equal(t, uint64(2), version)
+	})
+
+	t.Run("같은 branch끼리는 공통 조상이 없다", func(t *testing.T) {
+		// given
+		main, _ := newMainAndFeature(t)
+
+		// when
+		_, _, err := main.MergeBaseWith(main)
+
+		// then
+		assert.ErrorIs(t, err, ErrNoMergeBase)
+	})
+}
+
+func TestContentAggregate_Merge(t *testing.T) {
+	t.Run("충돌이 없으면 병합 이벤트를 기록한다", func(t *testing.T) {
+		// given
+		main, feature := newMainAndFeature(t)
+		_ = feature.UpdateField(context.Background(), "name", "홍길동", "고길동", "testerId", "testerName")
+
+		// when
+		err := main.Merge(context.Background(), feature, mustBase(t, main, 1), nil, nil, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "고길동", main.Content["name"])
+		assert.Equal(t, uint64(2), main.GetVersion())
+		assert.Equal(t, MergeBase{Version: 2, AtVersion: 2}, main.MergeBases["feature"])
+	})
+
+	t.Run("해결되지 않은 충돌이 있으면 MergeConflictError를 반환한다", func(t *testing.T) {
+		// given
+		main, feature := newMainAndFeature(t)
+		base := mustBase(t, main, 1)
+		_ = feature.UpdateField(context.Background(), "name", "홍길동", "고길동", "testerId", "testerName")
+		_ = main.UpdateField(context.Background(), "name", "홍길동", "둘리", "testerId", "testerName")
+
+		// when
+		err := main.Merge(context.Background(), feature, base, nil, nil, "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrMergeConflict)
+		var mergeConflictError *MergeConflictError
+		assert.ErrorAs(t, err, &mergeConflictError)
+		assert.Equal(t, "name", mergeConflictError.Conflicts[0].FieldName)
+		assert.Equal(t, "둘리", main.Content["name"])
+	})
+
+	t.Run("충돌은 선택한 값으로 해결된다", func(t *testing.T) {
+		// given
+		main, feature := newMainAndFeature(t)
+		base := mustBase(t, main, 1)
+		_ = feature.UpdateField(context.Background(), "name", "홍길동", "고길동", "testerId", "testerName")
+		_ = main.UpdateField(context.Background(), "name", "홍길동", "둘리", "testerId", "testerName")
+
+		// when
+		err := main.Merge(context.Background(), feature, base, map[string]any{"name": "마이콜"}, nil, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "마이콜", main.Content["name"])
+	})
+
+	t.Run("source에서 삭제된 필드를 삭제한다", func(t *testing.T) {
+		// given
+		main, feature := newMainAndFeature(t)
+		base := mustBase(t, main, 1)
+		_ = feature.RemoveField(context.Background(), "price", "testerId", "testerName")
+
+		// when
+		err := main.Merge(context.Background(), feature, base, nil, nil, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": "홍길동"}, main.Content)
+	})
+
+	t.Run("삭제와 변경의 충돌은 삭제로 해결할 수 있다", func(t *testing.T) {
+		// given
+		main, feature := newMainAndFeature(t)
+		base := mustBase(t, main, 1)
+		_ = feature.RemoveField(context.Background(), "price", "testerId", "testerName")
+		_ = main.UpdateField(context.Background(), "price", "1000", "2000", "testerId", "testerName")
+
+		// when
+		err := main.Merge(context.Background(), feature, base, nil, []string{"price"}, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": "홍길동"}, main.Content)
+	})
+
+	t.Run("source가 이미 병합되었으면 ErrNothingToMerge를 반환한다", func(t *testing.T) {
+		// given
+		main, feature := newMainAndFeature(t)
+		base, _ := NewContentAggregateOnBranch(feature.GetID(), "bettercode", "feature")
+		base.Version = feature.GetVersion()
+
+		// when
+		err := main.Merge(context.Background(), feature, base, nil, nil, "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, err, ErrNothingToMerge)
+	})
+}
+
+func newMainAndFeature(t *testing.T) (*ContentAggregate, *ContentAggregate) {
+	aggregateId := uuid.New().String()
+	main, err := NewContentAggregate(aggregateId, "bettercode")
+	assert.NoError(t, err)
+	assert.NoError(t, main.CreateContent(context.Background(), map[string]any{"name": "홍길동", "price": "1000"}))
+
+	feature, err := NewContentAggregateOnBranch(aggregateId, "bettercode", "feature")
+	assert.NoError(t, err)
+	assert.NoError(t, feature.CreateBranch(context.Background(), main, "testerId", "testerName"))
+
+	return main, feature
+}
+
+func mustBase(t *testing.T, aggregate *ContentAggregate, version uint64) *ContentAggregate {
+	base, err := NewContentAggregate(aggregate.GetID(), aggregate.GetTenantId())
+	assert.NoError(t, err)
+	assert.Equal(t, version, aggregate.GetVersion())
+	base.Content = copyContent(aggregate.Content)
+	base.Version = version
+	return base
+}
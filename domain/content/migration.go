@@ -0,0 +1,205 @@
+package content
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MigrationOperation is what a migration step does to a top-level field of a content.
+type MigrationOperation string
+
+const (
+	// MigrationRename moves the value of Field to To.
+	MigrationRename MigrationOperation = "rename"
+	// MigrationSplit splits the string value of Field at Separator into the fields of Into, the last one keeps the rest.
+	MigrationSplit MigrationOperation = "split"
+	// MigrationConvert converts the value of Field to Type.
+	MigrationConvert MigrationOperation = "convert"
+	// MigrationDefault sets Field to Value when the content has no value for it.
+	MigrationDefault MigrationOperation = "default"
+	// MigrationRemove removes Field.
+	MigrationRemove MigrationOperation = "remove"
+)
+
+// MigrationStep is a declarative change of the contents of a content type. A step leaves a content that does not have
+// its field as it is, so that running a migration again on a content that was already migrated changes nothing.
+type MigrationStep struct {
+	Op        MigrationOperation `json:"op"`
+	Field     string             `json:"field"`
+	To        string             `json:"to,omitempty"`
+	Into      []string           `json:"into,omitempty"`
+	Separator string             `json:"separator,omitempty"`
+	Type      FieldType          `json:"type,omitempty"`
+	Value     any                `json:"value,omitempty"`
+}
+
+// MigrationSteps are the steps bringing the contents of the previous version of a schema to its version, stored as jsonb.
+type MigrationSteps []MigrationStep
+
+func (jsonField MigrationSteps) Value() (driver.Value, error) {
+	return json.Marshal(jsonField)
+}
+
+// Scan Unmarshal
+func (jsonField *MigrationSteps) Scan(value any) error {
+	if value == nil {
+		*jsonField = nil
+		return nil
+	}
+	data, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(data, &jsonField)
+}
+
+func validateMigrationSteps(steps []MigrationStep) error {
+	for i, step := range steps {
+		if !isTopLevelFieldName(step.Field) {
+			return errors.Wrapf(ErrInvalidSchema, "migration step %d: field name: %q", i, step.Field)
+		}
+
+		switch step.Op {
+		case MigrationRename:
+			if !isTopLevelFieldName(step.To) || step.To == step.Field {
+				return errors.Wrapf(ErrInvalidSchema, "migration step %d: cannot rename %s to %q", i, step.Field, step.To)
+			}
+		case MigrationSplit:
+			if len(step.Into) < 2 || step.Separator == "" {
+				return errors.Wrapf(ErrInvalidSchema, "migration step %d: split of %s needs a separator and at least two fields", i, step.Field)
+			}
+			for _, name := range step.Into {
+				if !isTopLevelFieldName(name) {
+					return errors.Wrapf(ErrInvalidSchema, "migration step %d: field name: %q", i, name)
+				}
+			}
+		case MigrationConvert:
+			switch step.Type {
+			case FieldTypeString, FieldTypeNumber, FieldTypeInteger, FieldTypeBoolean:
+			default:
+				return errors.Wrapf(ErrInvalidSchema, "migration step %d: cannot convert %s to %q", i, step.Field, step.Type)
+			}
+		case MigrationDefault:
+			if step.Value == nil {
+				return errors.Wrapf(ErrInvalidSchema, "migration step %d: default of %s needs a value", i, step.Field)
+			}
+		case MigrationRemove:
+		default:
+			return errors.Wrapf(ErrInvalidSchema, "migration step %d: unknown operation %q", i, step.Op)
+		}
+	}
+	return nil
+}
+
+func isTopLevelFieldName(name string) bool {
+	return name != "" && !strings.Contains(name, "/")
+}
+
+// MigrateContent applies the migration steps of the schemas in order to a copy of the content. It returns
+// ErrMigrationFailed when a value cannot be migrated, for example a string that is not a number.
+func MigrateContent(content map[string]any, schemas []ContentTypeSchema) (map[string]any, error) {
+	migrated := copyContent(content)
+	for _, schema := range schemas {
+		for _, step := range schema.Migrations {
+			if err := step.apply(migrated); err != nil {
+				return nil, errors.Wrapf(err, "version: %d", schema.Version)
+			}
+		}
+	}
+	return migrated, nil
+}
+
+func (s MigrationStep) apply(content map[string]any) error {
+	value, ok := content[s.Field]
+
+	switch s.Op {
+	case MigrationRename:
+		if !ok {
+			return nil
+		}
+		if _, exists := content[s.To]; exists {
+			return errors.Wrapf(ErrMigrationFailed, "cannot rename %s, %s already exists", s.Field, s.To)
+		}
+		content[s.To] = value
+		delete(content, s.Field)
+	case MigrationSplit:
+		if !ok {
+			return nil
+		}
+		text, isString := value.(string)
+		if !isString {
+			return errors.Wrapf(ErrMigrationFailed, "cannot split %s, it is not a string", s.Field)
+		}
+		parts := strings.SplitN(text, s.Separator, len(s.Into))
+		delete(content, s.Field)
+		for i, name := range s.Into {
+			if i < len(parts) {
+				content[name] = strings.TrimSpace(parts[i])
+			} else {
+				content[name] = ""
+			}
+		}
+	case MigrationConvert:
+		if !ok || value == nil {
+			return nil
+		}
+		converted, err := convertValue(value, s.Type)
+		if err != nil {
+			return errors.Wrapf(ErrMigrationFailed, "cannot convert %s: %s", s.Field, err.Error())
+		}
+		content[s.Field] = converted
+	case MigrationDefault:
+		if !ok || value == nil {
+			content[s.Field] = copyValue(s.Value)
+		}
+	case MigrationRemove:
+		delete(content, s.Field)
+	}
+	return nil
+}
+
+func convertValue(value any, to FieldType) (any, error) {
+	if to.matches(value) {
+		return value, nil
+	}
+
+	switch to {
+	case FieldTypeString:
+		switch v := value.(type) {
+		case bool:
+			return strconv.FormatBool(v), nil
+		default:
+			if number, ok := numberOf(v); ok {
+				return strconv.FormatFloat(number, 'f', -1, 64), nil
+			}
+		}
+	case FieldTypeNumber, FieldTypeInteger:
+		number, ok := numberOf(value)
+		if text, isString := value.(string); isString {
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+			number, ok = parsed, err == nil
+		}
+		if !ok {
+			break
+		}
+		if to == FieldTypeInteger && number != math.Trunc(number) {
+			return nil, errors.New("the value is not an integer")
+		}
+		return number, nil
+	case FieldTypeBoolean:
+		if text, isString := value.(string); isString {
+			parsed, err := strconv.ParseBool(strings.TrimSpace(text))
+			if err == nil {
+				return parsed, nil
+			}
+		}
+	}
+	// the value itself is left out, the error ends up in the report of a schema migration
+	return nil, fmt.Errorf("the value is not convertible to %s", to)
+}
@@ -0,0 +1,260 @@
+package content
+
+import (
+	"contentgit/domain/content/events"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newArticleSchemas(t *testing.T) []ContentTypeSchema {
+	first, err := NewContentTypeSchema("gridge", "articles", nil, "기사", []FieldDefinition{
+		{Name: "author", Type: FieldTypeString},
+		{Name: "price", Type: FieldTypeString},
+	}, nil, "1", "사이트 관리자")
+	require.NoError(t, err)
+
+	second, err := NewContentTypeSchema("gridge", "articles", first, "기사", []FieldDefinition{
+		{Name: "lastName", Type: FieldTypeString, Required: true},
+		{Name: "firstName", Type: FieldTypeString},
+		{Name: "price", Type: FieldTypeInteger},
+		{Name: "published", Type: FieldTypeBoolean},
+	}, []MigrationStep{
+		{Op: MigrationSplit, Field: "author", Into: []string{"lastName", "firstName"}, Separator: " "},
+		{Op: MigrationConvert, Field: "price", Type: FieldTypeInteger},
+		{Op: MigrationDefault, Field: "published", Value: false},
+	}, "1", "사이트 관리자")
+	require.NoError(t, err)
+
+	return []ContentTypeSchema{*second}
+}
+
+func TestMigrateContent(t *testing.T) {
+	t.Run("마이그레이션 단계를 순서대로 적용한다", func(t *testing.T) {
+		// given
+		content := map[string]any{"author": "홍 길동", "price": "12000"}
+
+		// when
+		migrated, err := MigrateContent(content, newArticleSchemas(t))
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"lastName": "홍", "firstName": "길동", "price": float64(12000), "published": false}, migrated)
+		assert.Equal(t, map[string]any{"author": "홍 길동", "price": "12000"}, content)
+	})
+
+	t.Run("이미 마이그레이션된 컨텐츠는 바뀌지 않는다", func(t *testing.T) {
+		// given
+		content := map[string]any{"lastName": "홍", "firstName": "길동", "price": float64(12000), "published": true}
+
+		// when
+		migrated, err := MigrateContent(content, newArticleSchemas(t))
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, content, migrated)
+	})
+
+	t.Run("값을 변환할 수 없으면 ErrMigrationFailed를 반환한다", func(t *testing.T) {
+		// given
+		content := map[string]any{"author": "김 철수", "price": "무료"}
+
+		// when
+		_, err := MigrateContent(content, newArticleSchemas(t))
+
+		// then
+		assert.ErrorIs(t, err, ErrMigrationFailed)
+	})
+
+	t.Run("이름을 바꿀 필드가 이미 있으면 ErrMigrationFailed를 반환한다", func(t *testing.T) {
+		// given
+		schemas := []ContentTypeSchema{{Version: 2, Migrations: MigrationSteps{{Op: MigrationRename, Field: "title", To: "name"}}}}
+
+		// when
+		_, err := MigrateContent(map[string]any{"title": "제목", "name": "이름"}, schemas)
+
+		// then
+		assert.ErrorIs(t, err, ErrMigrationFailed)
+	})
+}
+
+func TestNewContentTypeSchema_Migrations(t *testing.T) {
+	t.Run("마이그레이션 단계가 잘못되면 ErrInvalidSchema를 반환한다", func(t *testing.T) {
+		first := newNoticeSchema(t)
+		invalidSteps := [][]MigrationStep{
+			{{Op: "merge", Field: "title"}},
+			{{Op: MigrationRename, Field: "title"}},
+			{{Op: MigrationRename, Field: "title", To: "title"}},
+			{{Op: MigrationSplit, Field: "title", Into: []string{"head"}, Separator: " "}},
+			{{Op: MigrationConvert, Field: "title", Type: FieldTypeObject}},
+			{{Op: MigrationDefault, Field: "title"}},
+			{{Op: MigrationRemove, Field: "/title"}},
+		}
+		for _, steps := range invalidSteps {
+			// when
+			_, err := NewContentTypeSchema("bettercode", "notices", first, "", first.Fields, steps, "1", "사이트 관리자")
+
+			// then
+			assert.ErrorIs(t, err, ErrInvalidSchema, steps)
+		}
+	})
+
+	t.Run("첫 스키마는 마이그레이션 단계를 가질 수 없다", func(t *testing.T) {
+		// when
+		_, err := NewContentTypeSchema("bettercode", "notices", nil, "", []FieldDefinition{{Name: "title", Type: FieldTypeString}},
+			[]MigrationStep{{Op: MigrationRemove, Field: "body"}}, "1", "사이트 관리자")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidSchema)
+	})
+}
+
+func TestContentAggregate_Migrate(t *testing.T) {
+	newArticle := func(t *testing.T, content map[string]any) *ContentAggregate {
+		aggregate, _ := NewContentAggregateWithType("3d9f2c1a-7b4e-4a8d-9c61-5e2b8f0a1d47", "gridge", "articles")
+		require.NoError(t, aggregate.CreateContent(context.Background(), content))
+		return aggregate
+	}
+
+	t.Run("마이그레이션한 필드를 이벤트로 기록하고 스키마 버전을 올린다", func(t *testing.T) {
+		// given
+		sut := newArticle(t, map[string]any{"author": "홍 길동", "price": "12000"})
+
+		// when
+		err := sut.Migrate(context.Background(), "migrationId", newArticleSchemas(t), "1", "사이트 관리자")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), sut.SchemaVersion)
+		assert.Equal(t, map[string]any{"lastName": "홍", "firstName": "길동", "price": float64(12000), "published": false}, sut.Content)
+
+		event := sut.GetChanges()[1].(*events.ContentMigratedEventV1)
+		assert.Equal(t, uint64(1), event.FromSchemaVersion)
+		assert.Equal(t, uint64(2), event.ToSchemaVersion)
+		assert.Equal(t, []events.MigratedField{
+			{FieldName: "price", BeforeValue: "12000", AfterValue: float64(12000)},
+			{FieldName: "firstName", AfterValue: "길동"},
+			{FieldName: "lastName", AfterValue: "홍"},
+			{FieldName: "published", AfterValue: false},
+			{FieldName: "author", BeforeValue: "홍 길동"},
+		}, event.Fields)
+	})
+
+	t.Run("이미 마이그레이션된 버전이면 ErrNothingToMigrate를 반환한다", func(t *testing.T) {
+		// given
+		sut := newArticle(t, map[string]any{"author": "홍 길동", "price": "12000"})
+		require.NoError(t, sut.Migrate(context.Background(), "migrationId", newArticleSchemas(t), "1", "사이트 관리자"))
+
+		// when
+		err := sut.Migrate(context.Background(), "migrationId", newArticleSchemas(t), "1", "사이트 관리자")
+
+		// then
+		assert.ErrorIs(t, err, ErrNothingToMigrate)
+	})
+
+	t.Run("마이그레이션한 컨텐츠가 스키마를 만족하지 않으면 ErrSchemaViolation을 반환한다", func(t *testing.T) {
+		// given
+		sut := newArticle(t, map[string]any{"price": "12000"})
+
+		// when
+		err := sut.Migrate(context.Background(), "migrationId", newArticleSchemas(t), "1", "사이트 관리자")
+
+		// then
+		assert.ErrorIs(t, err, ErrSchemaViolation)
+		assert.Len(t, sut.GetChanges(), 1)
+	})
+
+	t.Run("삭제된 컨텐츠는 ErrContentDeleted를 반환한다", func(t *testing.T) {
+		// given
+		sut := newArticle(t, map[string]any{"author": "홍 길동"})
+		require.NoError(t, sut.Delete(context.Background(), "1", "사이트 관리자"))
+
+		// when
+		err := sut.Migrate(context.Background(), "migrationId", newArticleSchemas(t), "1", "사이트 관리자")
+
+		// then
+		assert.ErrorIs(t, err, ErrContentDeleted)
+	})
+}
+
+func TestSchemaMigration_Record(t *testing.T) {
+	t.Run("컨텐츠마다 결과를 세고 커서를 옮긴다", func(t *testing.T) {
+		// given
+		sut, err := NewSchemaMigration("migrationId", "gridge", "articles", 1, 2, false, "1", "사이트 관리자")
+		require.NoError(t, err)
+
+		// when
+		assert.NoError(t, sut.Record("a", []events.MigratedField{{FieldName: "price"}}, nil))
+		assert.NoError(t, sut.Record("b", nil, ErrNothingToMigrate))
+		assert.NoError(t, sut.Record("c", nil, ErrContentDeleted))
+		assert.NoError(t, sut.Record("d", nil, ErrMigrationFailed))
+
+		// then
+		assert.Equal(t, 4, sut.Processed)
+		assert.Equal(t, 1, sut.Migrated)
+		assert.Equal(t, 1, sut.Unchanged)
+		assert.Equal(t, 1, sut.Skipped)
+		assert.Equal(t, 1, sut.Failed)
+		assert.Equal(t, "d", sut.Cursor)
+		assert.Len(t, sut.Results, 2)
+	})
+
+	t.Run("바뀐 필드는 값 없이 이름과 동작만 기록한다", func(t *testing.T) {
+		// given
+		sut, _ := NewSchemaMigration("migrationId", "gridge", "articles", 1, 2, true, "1", "사이트 관리자")
+
+		// when
+		err := sut.Record("a", []events.MigratedField{
+			{FieldName: "price", BeforeValue: "12000", AfterValue: float64(12000)},
+			{FieldName: "firstName", AfterValue: "길동"},
+			{FieldName: "author", BeforeValue: "홍 길동"},
+		}, nil)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []SchemaMigrationField{
+			{FieldName: "price", Operation: SchemaMigrationFieldChanged},
+			{FieldName: "firstName", Operation: SchemaMigrationFieldAdded},
+			{FieldName: "author", Operation: SchemaMigrationFieldRemoved},
+		}, sut.Results[0].Fields)
+	})
+
+	t.Run("마이그레이션하지 않은 브랜치는 건너뛴 결과로 남기고 세지 않는다", func(t *testing.T) {
+		// given
+		sut, _ := NewSchemaMigration("migrationId", "gridge", "articles", 1, 2, false, "1", "사이트 관리자")
+		require.NoError(t, sut.Record("a", nil, ErrNothingToMigrate))
+
+		// when
+		sut.RecordSkippedBranches("a", []string{"draft", "summer"})
+
+		// then
+		assert.Equal(t, 1, sut.Processed)
+		assert.Zero(t, sut.Skipped)
+		require.Len(t, sut.Results, 2)
+		assert.Equal(t, "draft", sut.Results[0].Branch)
+		assert.Equal(t, SchemaMigrationOutcomeSkipped, sut.Results[1].Outcome)
+	})
+
+	t.Run("컨텐츠와 무관한 에러는 기록하지 않고 반환한다", func(t *testing.T) {
+		// given
+		sut, _ := NewSchemaMigration("migrationId", "gridge", "articles", 1, 2, false, "1", "사이트 관리자")
+
+		// when
+		err := sut.Record("a", nil, assert.AnError)
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Zero(t, sut.Processed)
+		assert.Empty(t, sut.Cursor)
+	})
+
+	t.Run("대상 버전이 이후 버전이 아니면 ErrInvalidMigration을 반환한다", func(t *testing.T) {
+		// when
+		_, err := NewSchemaMigration("migrationId", "gridge", "articles", 2, 2, false, "1", "사이트 관리자")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidMigration)
+	})
+}
@@ -0,0 +1,222 @@
+package content
+
+import (
+	"contentgit/domain/content/events"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"contentgit/ports/out/persistance/eventsourcing/serializer"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// encryptedValueKey is the only key of the object an encrypted field value is stored as in the event data.
+const encryptedValueKey = "$encrypted"
+
+// PersonalDataFields are the top-level fields holding personal data, by content type. Content types are case-insensitive.
+type PersonalDataFields map[string][]string
+
+func NewPersonalDataFields(fields map[string][]string) PersonalDataFields {
+	personalDataFields := make(PersonalDataFields, len(fields))
+	for contentType, fieldNames := range fields {
+		personalDataFields[strings.ToLower(contentType)] = fieldNames
+	}
+	return personalDataFields
+}
+
+// Of returns the personal data fields of the content type.
+func (p PersonalDataFields) Of(contentType string) []string {
+	return p[strings.ToLower(contentType)]
+}
+
+// Contains reports whether the field, or the top-level field a JSON Pointer belongs to, holds personal data.
+func (p PersonalDataFields) Contains(contentType string, fieldName string) bool {
+	return slices.Contains(p.Of(contentType), rootFieldName(fieldName))
+}
+
+// FieldShredder encrypts the values of personal data fields with the key of their content, so that destroying the key erases them.
+// Besides the content events it encrypts the values other aggregates keep of a content, like the changes a change request proposes.
+type FieldShredder struct {
+	keyRepository      ContentKeyRepository
+	personalDataFields PersonalDataFields
+}
+
+func NewFieldShredder(keyRepository ContentKeyRepository, personalDataFields PersonalDataFields) *FieldShredder {
+	return &FieldShredder{keyRepository: keyRepository, personalDataFields: personalDataFields}
+}
+
+// Encrypter returns a function encrypting the personal data field values of the content, the key of the content is
+// created on first use.
+func (s *FieldShredder) Encrypter(ctx context.Context, tenantId string, contentId string, contentType string) func(fieldName string, value any) (any, error) {
+	var key *ContentKey
+	return func(fieldName string, value any) (any, error) {
+		if value == nil || !s.personalDataFields.Contains(contentType, fieldName) {
+			return value, nil
+		}
+
+		if key == nil {
+			var err error
+			if key, err = s.keyRepository.FindOrCreate(ctx, tenantId, contentId); err != nil {
+				return nil, errors.Wrapf(err, "keyRepository.FindOrCreate contentID: %s", contentId)
+			}
+		}
+
+		plaintext, err := serializer.Marshal(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "serializer.Marshal field: %s", fieldName)
+		}
+		ciphertext, err := key.Encrypt([]byte(plaintext))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{encryptedValueKey: ciphertext}, nil
+	}
+}
+
+// Decrypter returns a function decrypting the encrypted values of the content. The values encrypted with a destroyed key read as null.
+func (s *FieldShredder) Decrypter(ctx context.Context, contentId string) func(fieldName string, value any) (any, error) {
+	var key *ContentKey
+	return func(fieldName string, value any) (any, error) {
+		ciphertext, ok := encryptedValue(value)
+		if !ok {
+			return value, nil
+		}
+
+		if key == nil {
+			var err error
+			if key, err = s.keyRepository.FindByContentId(ctx, contentId); err != nil {
+				if errors.Is(err, persistence.ErrRecordNotFound) {
+					return nil, errors.Errorf("no key for encrypted field: %s, contentID: %s", fieldName, contentId)
+				}
+				return nil, errors.Wrapf(err, "keyRepository.FindByContentId contentID: %s", contentId)
+			}
+		}
+		if key.IsDestroyed() {
+			return nil, nil
+		}
+
+		plaintext, err := key.Decrypt(ciphertext)
+		if err != nil {
+			return nil, err
+		}
+		var decrypted any
+		if err := serializer.Unmarshal(string(plaintext), &decrypted); err != nil {
+			return nil, errors.Wrapf(err, "serializer.Unmarshal field: %s", fieldName)
+		}
+		return decrypted, nil
+	}
+}
+
+// encrypt returns a copy of the event whose personal data field values are encrypted.
+func (s *FieldShredder) encrypt(ctx context.Context, aggregate eventsourcing.Aggregate, event any) (any, error) {
+	contentAggregate, ok := aggregate.(*ContentAggregate)
+	if !ok || len(s.personalDataFields.Of(contentAggregate.ContentType)) == 0 {
+		return event, nil
+	}
+
+	return mapFieldValues(event, s.Encrypter(ctx, aggregate.GetTenantId(), aggregate.GetID(), contentAggregate.ContentType))
+}
+
+// decrypt returns a copy of the event with its encrypted values decrypted.
+func (s *FieldShredder) decrypt(ctx context.Context, esEvent eventsourcing.Event, event any) (any, error) {
+	return mapFieldValues(event, s.Decrypter(ctx, esEvent.GetAggregateID()))
+}
+
+func encryptedValue(value any) (string, bool) {
+	object, ok := value.(map[string]any)
+	if !ok || len(object) != 1 {
+		return "", false
+	}
+	ciphertext, ok := object[encryptedValueKey].(string)
+	return ciphertext, ok
+}
+
+// mapFieldValues returns a copy of the event with every field value it carries replaced by fn,
+// the event itself is left untouched since its values may be shared with the aggregate.
+func mapFieldValues(event any, fn func(fieldName string, value any) (any, error)) (any, error) {
+	var err error
+	mapValue := func(fieldName string, value any) any {
+		if err != nil {
+			return value
+		}
+		var mapped any
+		mapped, err = fn(fieldName, value)
+		return mapped
+	}
+	mapContent := func(content map[string]any) map[string]any {
+		if content == nil {
+			return nil
+		}
+		mapped := make(map[string]any, len(content))
+		for fieldName, value := range content {
+			mapped[fieldName] = mapValue(fieldName, value)
+		}
+		return mapped
+	}
+
+	switch evt := event.(type) {
+	case *events.ContentCreatedEventV1:
+		mapped := *evt
+		mapped.Content = mapContent(evt.Content)
+		return &mapped, err
+	case *events.ContentBranchCreatedEventV1:
+		mapped := *evt
+		mapped.Content = mapContent(evt.Content)
+		return &mapped, err
+	case *events.FieldUpdatedEventV1:
+		mapped := *evt
+		mapped.BeforeValue = mapValue(evt.FieldName, evt.BeforeValue)
+		mapped.AfterValue = mapValue(evt.FieldName, evt.AfterValue)
+		return &mapped, err
+	case *events.FieldAddedEventV1:
+		mapped := *evt
+		mapped.Value = mapValue(evt.FieldName, evt.Value)
+		return &mapped, err
+	case *events.FieldRemovedEventV1:
+		mapped := *evt
+		mapped.Value = mapValue(evt.FieldName, evt.Value)
+		return &mapped, err
+	case *events.ContentMergedEventV1:
+		mapped := *evt
+		mapped.Fields = make([]events.MergedField, len(evt.Fields))
+		for i, field := range evt.Fields {
+			field.BeforeValue = mapValue(field.FieldName, field.BeforeValue)
+			field.AfterValue = mapValue(field.FieldName, field.AfterValue)
+			mapped.Fields[i] = field
+		}
+		return &mapped, err
+	case *events.ContentRevertedEventV1:
+		mapped := *evt
+		mapped.Content = mapContent(evt.Content)
+		mapped.Fields = make([]events.RevertedField, len(evt.Fields))
+		for i, field := range evt.Fields {
+			field.BeforeValue = mapValue(field.FieldName, field.BeforeValue)
+			field.AfterValue = mapValue(field.FieldName, field.AfterValue)
+			mapped.Fields[i] = field
+		}
+		return &mapped, err
+	case *events.ContentMigratedEventV1:
+		mapped := *evt
+		mapped.Content = mapContent(evt.Content)
+		mapped.Fields = make([]events.MigratedField, len(evt.Fields))
+		for i, field := range evt.Fields {
+			field.BeforeValue = mapValue(field.FieldName, field.BeforeValue)
+			field.AfterValue = mapValue(field.FieldName, field.AfterValue)
+			mapped.Fields[i] = field
+		}
+		return &mapped, err
+	case *events.ContentCommittedEventV1:
+		mapped := *evt
+		mapped.Fields = make([]events.CommittedField, len(evt.Fields))
+		for i, field := range evt.Fields {
+			field.BeforeValue = mapValue(field.FieldName, field.BeforeValue)
+			field.AfterValue = mapValue(field.FieldName, field.AfterValue)
+			mapped.Fields[i] = field
+		}
+		return &mapped, err
+	default:
+		return event, nil
+	}
+}
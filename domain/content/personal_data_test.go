@@ -0,0 +1,126 @@
+package content
+
+import (
+	"contentgit/domain/content/events"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// inMemoryContentKeyRepository is a ContentKeyRepository kept in a map.
+type inMemoryContentKeyRepository struct {
+	keys map[string]*ContentKey
+}
+
+func newInMemoryContentKeyRepository() *inMemoryContentKeyRepository {
+	return &inMemoryContentKeyRepository{keys: make(map[string]*ContentKey)}
+}
+
+func (r *inMemoryContentKeyRepository) FindOrCreate(ctx context.Context, tenantId string, contentId string) (*ContentKey, error) {
+	if key, ok := r.keys[contentId]; ok {
+		return key, nil
+	}
+	key, err := NewContentKey(tenantId, contentId)
+	if err != nil {
+		return nil, err
+	}
+	r.keys[contentId] = key
+	return key, nil
+}
+
+func (r *inMemoryContentKeyRepository) FindByContentId(ctx context.Context, contentId string) (*ContentKey, error) {
+	if key, ok := r.keys[contentId]; ok {
+		return key, nil
+	}
+	return nil, persistence.ErrRecordNotFound
+}
+
+func (r *inMemoryContentKeyRepository) Destroy(ctx context.Context, tenantId string, contentId string) error {
+	destroyedAt := time.Now()
+	r.keys[contentId] = &ContentKey{ContentId: contentId, TenantId: tenantId, DestroyedAt: &destroyedAt}
+	return nil
+}
+
+func TestShreddingEventSerializer(t *testing.T) {
+	personalDataFields := NewPersonalDataFields(map[string][]string{"Customers": {"name", "address"}})
+
+	t.Run("개인정보 필드만 암호화해서 저장하고 원래 값으로 복원한다", func(t *testing.T) {
+		// given
+		keyRepository := newInMemoryContentKeyRepository()
+		sut := NewShreddingEventSerializer(keyRepository, personalDataFields)
+		aggregate, _ := NewContentAggregateWithType(uuid.New().String(), "bettercode", "customers")
+		_ = aggregate.CreateContent(context.Background(), map[string]any{"name": "홍길동", "address": map[string]any{"city": "서울"}, "grade": "gold"})
+		createdEvent, _ := sut.SerializeEvent(context.Background(), aggregate, aggregate.GetChanges()[0])
+		_ = aggregate.UpdateField(context.Background(), "/address/city", "서울", "부산", "1", "김영희")
+		updatedEvent, _ := sut.SerializeEvent(context.Background(), aggregate, aggregate.GetChanges()[1])
+
+		// when
+		deserializedCreatedEvent, createdErr := sut.DeserializeEvent(context.Background(), createdEvent)
+		deserializedUpdatedEvent, updatedErr := sut.DeserializeEvent(context.Background(), updatedEvent)
+
+		// then
+		assert.NotContains(t, createdEvent.GetData(), "홍길동")
+		assert.NotContains(t, createdEvent.GetData(), "서울")
+		assert.Contains(t, createdEvent.GetData(), "gold")
+		assert.NotContains(t, updatedEvent.GetData(), "부산")
+		assert.Equal(t, "홍길동", aggregate.GetChanges()[0].(*events.ContentCreatedEventV1).Content["name"])
+
+		assert.NoError(t, createdErr)
+		assert.Equal(t, map[string]any{"name": "홍길동", "address": map[string]any{"city": "서울"}, "grade": "gold"}, deserializedCreatedEvent.(*events.ContentCreatedEventV1).Content)
+		assert.NoError(t, updatedErr)
+		assert.Equal(t, "서울", deserializedUpdatedEvent.(*events.FieldUpdatedEventV1).BeforeValue)
+		assert.Equal(t, "부산", deserializedUpdatedEvent.(*events.FieldUpdatedEventV1).AfterValue)
+	})
+
+	t.Run("키가 파기되면 암호화된 값은 null로 읽힌다", func(t *testing.T) {
+		// given
+		keyRepository := newInMemoryContentKeyRepository()
+		sut := NewShreddingEventSerializer(keyRepository, personalDataFields)
+		aggregate, _ := NewContentAggregateWithType(uuid.New().String(), "bettercode", "customers")
+		_ = aggregate.CreateContent(context.Background(), map[string]any{"name": "홍길동", "grade": "gold"})
+		esEvent, _ := sut.SerializeEvent(context.Background(), aggregate, aggregate.GetChanges()[0])
+
+		// when
+		_ = keyRepository.Destroy(context.Background(), "bettercode", aggregate.GetID())
+		deserializedEvent, err := sut.DeserializeEvent(context.Background(), esEvent)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"name": nil, "grade": "gold"}, deserializedEvent.(*events.ContentCreatedEventV1).Content)
+	})
+
+	t.Run("키가 파기된 컨텐츠의 개인정보 필드는 저장할 수 없다", func(t *testing.T) {
+		// given
+		keyRepository := newInMemoryContentKeyRepository()
+		sut := NewShreddingEventSerializer(keyRepository, personalDataFields)
+		aggregate, _ := NewContentAggregateWithType(uuid.New().String(), "bettercode", "customers")
+		_ = keyRepository.Destroy(context.Background(), "bettercode", aggregate.GetID())
+		_ = aggregate.CreateContent(context.Background(), map[string]any{"name": "홍길동"})
+
+		// when
+		_, err := sut.SerializeEvent(context.Background(), aggregate, aggregate.GetChanges()[0])
+
+		// then
+		assert.ErrorIs(t, err, ErrContentPurged)
+	})
+
+	t.Run("개인정보 필드가 없는 컨텐츠 타입은 키를 만들지 않는다", func(t *testing.T) {
+		// given
+		keyRepository := newInMemoryContentKeyRepository()
+		sut := NewShreddingEventSerializer(keyRepository, personalDataFields)
+		aggregate, _ := NewContentAggregateWithType(uuid.New().String(), "bettercode", "products")
+		_ = aggregate.CreateContent(context.Background(), map[string]any{"name": "가습기"})
+
+		// when
+		esEvent, err := sut.SerializeEvent(context.Background(), aggregate, aggregate.GetChanges()[0])
+
+		// then
+		assert.NoError(t, err)
+		assert.Contains(t, esEvent.GetData(), "가습기")
+		assert.Empty(t, keyRepository.keys)
+	})
+}
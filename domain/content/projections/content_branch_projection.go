@@ -0,0 +1,74 @@
+package projections
+
+import (
+	"contentgit/domain/content/jsonpointer"
+	persistence "contentgit/ports/out/persistance"
+	"time"
+)
+
+// ContentBranchProjection is the head of a content branch other than the default branch.
+type ContentBranchProjection struct {
+	ContentId     string            `gorm:"primarykey"`
+	Branch        string            `gorm:"primarykey"`
+	TenantId      string            `gorm:"not null"`
+	Content       persistence.JSONB `gorm:"type:jsonb"`
+	ContentType   string            `gorm:"type:varchar(100)"`
+	SourceBranch  string            `gorm:"not null"`
+	SourceVersion uint
+	Version       uint
+	CreatedById   string
+	CreatedByName string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewContentBranchProjection(contentId string, branch string, tenantId string, content map[string]any, contentType string,
+	sourceBranch string, sourceVersion uint, createdById string, createdByName string, version uint) ContentBranchProjection {
+	return ContentBranchProjection{
+		ContentId:     contentId,
+		Branch:        branch,
+		TenantId:      tenantId,
+		Content:       content,
+		ContentType:   contentType,
+		SourceBranch:  sourceBranch,
+		SourceVersion: sourceVersion,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+		Version:       version,
+	}
+}
+
+func (*ContentBranchProjection) TableName() string {
+	return "content_branches"
+}
+
+// UpdateField sets the value of a top-level field or of the value a JSON Pointer refers to.
+func (e *ContentBranchProjection) UpdateField(fieldName string, value any) error {
+	path, err := jsonpointer.Parse(fieldName)
+	if err != nil {
+		return err
+	}
+	return path.Set(e.Content, value)
+}
+
+// AddField adds a field, or a value nested at a JSON Pointer.
+func (e *ContentBranchProjection) AddField(fieldName string, value any) error {
+	path, err := jsonpointer.Parse(fieldName)
+	if err != nil {
+		return err
+	}
+	return path.Add(e.Content, value)
+}
+
+func (e *ContentBranchProjection) RemoveField(fieldName string) error {
+	path, err := jsonpointer.Parse(fieldName)
+	if err != nil {
+		return err
+	}
+	_, err = path.Remove(e.Content)
+	return err
+}
+
+func (e *ContentBranchProjection) Purge(fields []string) {
+	purgeContent(e.Content, fields)
+}
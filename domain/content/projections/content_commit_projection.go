@@ -0,0 +1,77 @@
+package projections
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ContentCommitProjection is an entry of the commit log of a content branch.
+type ContentCommitProjection struct {
+	Id            string              `gorm:"primarykey"`
+	ContentId     string              `gorm:"not null;index:idx_content_commits_stream"`
+	Branch        string              `gorm:"type:varchar(100);not null;index:idx_content_commits_stream"`
+	TenantId      string              `gorm:"not null"`
+	Version       uint                `gorm:"not null"`
+	ParentVersion uint                `gorm:"not null"`
+	Message       string              `gorm:"type:text"`
+	Fields        CommitFieldChangeVO `gorm:"type:jsonb"`
+	CreatedById   string
+	CreatedByName string
+	CreatedAt     time.Time
+}
+
+func NewContentCommitProjection(id string, contentId string, branch string, tenantId string, version uint, parentVersion uint,
+	message string, fields CommitFieldChangeVO, createdById string, createdByName string, createdAt time.Time) ContentCommitProjection {
+	return ContentCommitProjection{
+		Id:            id,
+		ContentId:     contentId,
+		Branch:        branch,
+		TenantId:      tenantId,
+		Version:       version,
+		ParentVersion: parentVersion,
+		Message:       message,
+		Fields:        fields,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+		CreatedAt:     createdAt,
+	}
+}
+
+func (*ContentCommitProjection) TableName() string {
+	return "content_commits"
+}
+
+// Purge erases the values of the purged fields from the changes of the commit.
+func (e *ContentCommitProjection) Purge(fields []string) {
+	for i := range e.Fields {
+		if isPurgedField(e.Fields[i].Name, fields) {
+			e.Fields[i].BeforeValue = nil
+			e.Fields[i].AfterValue = nil
+		}
+	}
+}
+
+type CommitFieldChangeVO []CommitFieldChange
+
+type CommitFieldChange struct {
+	Name        string `json:"name"`
+	BeforeValue any    `json:"beforeValue"`
+	AfterValue  any    `json:"afterValue"`
+}
+
+// Value Marshal
+func (jsonField CommitFieldChangeVO) Value() (driver.Value, error) {
+	return json.Marshal(jsonField)
+}
+
+// Scan Unmarshal
+func (jsonField *CommitFieldChangeVO) Scan(value any) error {
+	data, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(data, &jsonField)
+}
@@ -1,10 +1,13 @@
 package projections
 
 import (
+	"contentgit/domain/content/jsonpointer"
+	"contentgit/domain/content/jsonvalue"
 	"contentgit/dtos"
 	persistence "contentgit/ports/out/persistance"
 	"database/sql/driver"
 	"encoding/json"
+	"slices"
 	"time"
 
 	"github.com/pkg/errors"
@@ -12,16 +15,21 @@ import (
 )
 
 type ContentProjection struct {
-	Id            string                `gorm:"primarykey"`
-	TenantId      string                `gorm:"not null"`
-	Content       persistence.JSONB     `gorm:"type:jsonb"`
-	ContentType   string                `gorm:"type:varchar(100)"`
-	FieldChanges  []ContentFieldChange  `gorm:"foreignKey:ContentId"`
-	FieldComments []ContentFieldComment `gorm:"foreignKey:ContentId"`
-	Version       uint
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	DeletedAt     gorm.DeletedAt `gorm:"index"`
+	Id           string            `gorm:"primarykey"`
+	TenantId     string            `gorm:"not null"`
+	Content      persistence.JSONB `gorm:"type:jsonb"`
+	ContentType  string            `gorm:"type:varchar(100)"`
+	Status       string            `gorm:"type:varchar(20);not null;default:draft;index"`
+	StatusReason string            `gorm:"type:text"`
+	// PublishedVersion is the version readers see, nil when the content is not published.
+	PublishedVersion *uint
+	FieldChanges     []ContentFieldChange  `gorm:"foreignKey:ContentId"`
+	FieldComments    []ContentFieldComment `gorm:"foreignKey:ContentId"`
+	Version          uint
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
+	PurgedAt         *time.Time
 }
 
 func NewContentProjection(id string, tenantId string, content map[string]any, contentType string, version uint) ContentProjection {
@@ -30,6 +38,7 @@ func NewContentProjection(id string, tenantId string, content map[string]any, co
 		TenantId:    tenantId,
 		Content:     content,
 		ContentType: contentType,
+		Status:      "draft",
 		Version:     version,
 	}
 }
@@ -38,8 +47,55 @@ func (*ContentProjection) TableName() string {
 	return "contents"
 }
 
-func (e *ContentProjection) UpdateField(fieldName string, updateField dtos.ContentUpdateField) {
-	e.Content[fieldName] = updateField.AfterValue
+// UpdateField sets the value of a top-level field or of the value a JSON Pointer refers to and records the change,
+// an update that leaves the value as it was, such as a merge resolution keeping the target value, records no change.
+func (e *ContentProjection) UpdateField(fieldName string, updateField dtos.ContentUpdateField) error {
+	path, err := jsonpointer.Parse(fieldName)
+	if err != nil {
+		return err
+	}
+	if err := path.Set(e.Content, updateField.AfterValue); err != nil {
+		return err
+	}
+
+	if !jsonvalue.Equal(updateField.BeforeValue, updateField.AfterValue) {
+		e.appendFieldChange(fieldName, updateField)
+	}
+	e.MarkEdited()
+	return nil
+}
+
+// AddField adds a field, or a value nested at a JSON Pointer, and records the change.
+func (e *ContentProjection) AddField(fieldName string, updateField dtos.ContentUpdateField) error {
+	path, err := jsonpointer.Parse(fieldName)
+	if err != nil {
+		return err
+	}
+	if err := path.Add(e.Content, updateField.AfterValue); err != nil {
+		return err
+	}
+
+	e.appendFieldChange(fieldName, updateField)
+	e.MarkEdited()
+	return nil
+}
+
+// RemoveField removes the field from the content, its changes and comments are kept.
+func (e *ContentProjection) RemoveField(fieldName string, updateField dtos.ContentUpdateField) error {
+	path, err := jsonpointer.Parse(fieldName)
+	if err != nil {
+		return err
+	}
+	if _, err := path.Remove(e.Content); err != nil {
+		return err
+	}
+
+	e.appendFieldChange(fieldName, updateField)
+	e.MarkEdited()
+	return nil
+}
+
+func (e *ContentProjection) appendFieldChange(fieldName string, updateField dtos.ContentUpdateField) {
 	e.FieldChanges = append(e.FieldChanges, ContentFieldChange{
 		Name: fieldName,
 		Content: FieldUpdateVO{
@@ -51,8 +107,66 @@ func (e *ContentProjection) UpdateField(fieldName string, updateField dtos.Conte
 	})
 }
 
-func (e *ContentProjection) AddFieldComment(fieldName, comment, createdById, createdByName string) {
+// MarkEdited sends the draft back to draft status once it is edited, the published version is left as it is.
+func (e *ContentProjection) MarkEdited() {
+	e.Status = "draft"
+	e.StatusReason = ""
+}
+
+// Delete moves the content to the trash, it is hidden from queries that do not include deleted contents.
+// A deleted content is no longer published.
+func (e *ContentProjection) Delete(deletedAt time.Time) {
+	e.DeletedAt = gorm.DeletedAt{Time: deletedAt, Valid: true}
+	e.Unpublish()
+	if e.Status == "published" {
+		e.Status = "draft"
+	}
+}
+
+func (e *ContentProjection) Restore() {
+	e.DeletedAt = gorm.DeletedAt{}
+}
+
+// DeletedTime returns when the content was moved to the trash, nil when it is not deleted.
+func (e *ContentProjection) DeletedTime() *time.Time {
+	if !e.DeletedAt.Valid {
+		return nil
+	}
+	return &e.DeletedAt.Time
+}
+
+// ChangeStatus moves the content to the status, reason is kept until the next change of status.
+func (e *ContentProjection) ChangeStatus(status string, reason string) {
+	e.Status = status
+	e.StatusReason = reason
+}
+
+// Publish records the version readers see.
+func (e *ContentProjection) Publish(version uint) {
+	e.PublishedVersion = &version
+}
+
+func (e *ContentProjection) Unpublish() {
+	e.PublishedVersion = nil
+}
+
+// Purge erases the values of the purged fields from the content and from the changes recorded for them.
+func (e *ContentProjection) Purge(fields []string, purgedAt time.Time) {
+	purgeContent(e.Content, fields)
+	for i := range e.FieldChanges {
+		if isPurgedField(e.FieldChanges[i].Name, fields) {
+			e.FieldChanges[i].Content.BeforeValue = nil
+			e.FieldChanges[i].Content.AfterValue = nil
+		}
+	}
+	e.PurgedAt = &purgedAt
+}
+
+// AddFieldComment adds the comment commentId to the field, as a reply to parentId when it is set.
+func (e *ContentProjection) AddFieldComment(commentId, parentId, fieldName, comment, createdById, createdByName string) {
 	e.FieldComments = append(e.FieldComments, ContentFieldComment{
+		CommentId:     commentId,
+		ParentId:      parentId,
 		Name:          fieldName,
 		Comment:       comment,
 		CreatedById:   createdById,
@@ -60,6 +174,16 @@ func (e *ContentProjection) AddFieldComment(fieldName, comment, createdById, cre
 	})
 }
 
+// FindFieldComment returns the comment commentId, which can be changed through the pointer before saving the projection.
+func (e *ContentProjection) FindFieldComment(commentId string) (*ContentFieldComment, bool) {
+	for i := range e.FieldComments {
+		if e.FieldComments[i].CommentId == commentId {
+			return &e.FieldComments[i], true
+		}
+	}
+	return nil, false
+}
+
 type ContentFieldChange struct {
 	gorm.Model
 	ContentId string        `gorm:"not null"`
@@ -71,13 +195,47 @@ func (ContentFieldChange) TableName() string {
 	return "content_field_changes"
 }
 
+// ContentFieldComment is a comment on a field, a reply when ParentId is set. Only the comment starting a thread is resolved.
 type ContentFieldComment struct {
 	gorm.Model
-	ContentId     string `gorm:"not null"`
-	Name          string `gorm:"not null"`
-	Comment       string `gorm:"not null;type:text"`
-	CreatedById   string
-	CreatedByName string
+	ContentId      string `gorm:"not null"`
+	CommentId      string `gorm:"type:varchar(100);index"`
+	ParentId       string `gorm:"type:varchar(100)"`
+	Name           string `gorm:"not null"`
+	Comment        string `gorm:"not null;type:text"`
+	CreatedById    string
+	CreatedByName  string
+	EditedAt       *time.Time
+	Deleted        bool `gorm:"not null;default:false"`
+	Resolved       bool `gorm:"not null;default:false"`
+	ResolvedById   string
+	ResolvedByName string
+	ResolvedAt     *time.Time
+}
+
+func (c *ContentFieldComment) Edit(comment string, editedAt time.Time) {
+	c.Comment = comment
+	c.EditedAt = &editedAt
+}
+
+// Delete erases the text of the comment and keeps it in its thread.
+func (c *ContentFieldComment) Delete() {
+	c.Comment = ""
+	c.Deleted = true
+}
+
+func (c *ContentFieldComment) Resolve(resolvedById string, resolvedByName string, resolvedAt time.Time) {
+	c.Resolved = true
+	c.ResolvedById = resolvedById
+	c.ResolvedByName = resolvedByName
+	c.ResolvedAt = &resolvedAt
+}
+
+func (c *ContentFieldComment) Reopen() {
+	c.Resolved = false
+	c.ResolvedById = ""
+	c.ResolvedByName = ""
+	c.ResolvedAt = nil
 }
 
 func (ContentFieldComment) TableName() string {
@@ -104,3 +262,21 @@ func (jsonField *FieldUpdateVO) Scan(value any) error {
 	}
 	return json.Unmarshal(data, &jsonField)
 }
+
+// purgeContent keeps the purged fields of the content with a null value.
+func purgeContent(content map[string]any, fields []string) {
+	for _, fieldName := range fields {
+		if _, ok := content[fieldName]; ok {
+			content[fieldName] = nil
+		}
+	}
+}
+
+// isPurgedField reports whether the field, or the top-level field a JSON Pointer belongs to, is one of the purged fields.
+func isPurgedField(fieldName string, fields []string) bool {
+	path, err := jsonpointer.Parse(fieldName)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(fields, path.Root())
+}
@@ -0,0 +1,35 @@
+package projections
+
+import "time"
+
+// ContentReferenceProjection is a reference of a field of a content, the source, to another content, the target.
+// The references are indexed by their target to tell which contents reference a content.
+type ContentReferenceProjection struct {
+	TenantId          string `gorm:"type:varchar(100);primaryKey;index:idx_content_references_target,priority:1"`
+	SourceId          string `gorm:"type:varchar(100);primaryKey"`
+	Field             string `gorm:"type:varchar(255);primaryKey"`
+	SourceContentType string `gorm:"type:varchar(100)"`
+	// SourceVersion is the version of the source the reference was read from.
+	SourceVersion     uint
+	TargetId          string `gorm:"type:varchar(100);not null;index:idx_content_references_target,priority:2"`
+	TargetContentType string `gorm:"type:varchar(100)"`
+	UpdatedAt         time.Time
+}
+
+func NewContentReferenceProjection(tenantId string, sourceId string, field string, sourceContentType string, sourceVersion uint,
+	targetId string, targetContentType string, updatedAt time.Time) ContentReferenceProjection {
+	return ContentReferenceProjection{
+		TenantId:          tenantId,
+		SourceId:          sourceId,
+		Field:             field,
+		SourceContentType: sourceContentType,
+		SourceVersion:     sourceVersion,
+		TargetId:          targetId,
+		TargetContentType: targetContentType,
+		UpdatedAt:         updatedAt,
+	}
+}
+
+func (*ContentReferenceProjection) TableName() string {
+	return "content_references"
+}
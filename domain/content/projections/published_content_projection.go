@@ -0,0 +1,41 @@
+package projections
+
+import (
+	persistence "contentgit/ports/out/persistance"
+	"time"
+)
+
+// PublishedContentProjection is the published version of a content, what readers see while editors keep changing the draft.
+type PublishedContentProjection struct {
+	Id              string            `gorm:"primarykey"`
+	TenantId        string            `gorm:"not null;index"`
+	ContentType     string            `gorm:"type:varchar(100);index"`
+	Content         persistence.JSONB `gorm:"type:jsonb"`
+	Version         uint              `gorm:"not null"`
+	PublishedById   string
+	PublishedByName string
+	PublishedAt     time.Time
+}
+
+func NewPublishedContentProjection(id string, tenantId string, contentType string, content map[string]any, version uint,
+	publishedById string, publishedByName string, publishedAt time.Time) PublishedContentProjection {
+	return PublishedContentProjection{
+		Id:              id,
+		TenantId:        tenantId,
+		ContentType:     contentType,
+		Content:         content,
+		Version:         version,
+		PublishedById:   publishedById,
+		PublishedByName: publishedByName,
+		PublishedAt:     publishedAt,
+	}
+}
+
+func (*PublishedContentProjection) TableName() string {
+	return "published_contents"
+}
+
+// Purge erases the values of the purged fields from the published content.
+func (e *PublishedContentProjection) Purge(fields []string) {
+	purgeContent(e.Content, fields)
+}
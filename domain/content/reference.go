@@ -0,0 +1,120 @@
+package content
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ContentReference is the value of a reference field, {"id": "...", "contentType": "..."}, pointing at a content of
+// the same tenant.
+type ContentReference struct {
+	Id          string
+	ContentType string
+}
+
+// ParseReference reads a reference from the value of a field, which has to be an object with exactly a non-empty id
+// and content type.
+func ParseReference(value any) (ContentReference, bool) {
+	object, ok := value.(map[string]any)
+	if !ok || len(object) != 2 {
+		return ContentReference{}, false
+	}
+	id, _ := object["id"].(string)
+	contentType, _ := object["contentType"].(string)
+	if id == "" || contentType == "" {
+		return ContentReference{}, false
+	}
+	return ContentReference{Id: id, ContentType: contentType}, true
+}
+
+// FieldReference is the reference a top-level field of a content holds.
+type FieldReference struct {
+	Field  string
+	Target ContentReference
+}
+
+// References returns the references held by the reference fields of a content in the order the schema declares them.
+func (s *ContentTypeSchema) References(content map[string]any) []FieldReference {
+	references := make([]FieldReference, 0)
+	for _, field := range s.Fields {
+		if field.Type != FieldTypeReference {
+			continue
+		}
+		if target, ok := ParseReference(content[field.Name]); ok {
+			references = append(references, FieldReference{Field: field.Name, Target: target})
+		}
+	}
+	return references
+}
+
+// ValidateReferences rejects the references of the top-level fields names of a content to contents that do not
+// exist, exists telling whether a content exists with the given content type and is not deleted.
+func (s *ContentTypeSchema) ValidateReferences(content map[string]any, exists func(reference ContentReference) (bool, error),
+	names ...string) error {
+	violations := make([]FieldViolation, 0)
+	for _, reference := range s.References(content) {
+		if !slices.Contains(names, reference.Field) {
+			continue
+		}
+		ok, err := exists(reference.Target)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			violations = append(violations, FieldViolation{Field: reference.Field,
+				Message: fmt.Sprintf("references %s %s that does not exist", reference.Target.ContentType, reference.Target.Id)})
+		}
+	}
+	return newSchemaViolationError(violations)
+}
+
+// ParseAssetReference reads the id of the asset the value of an asset field references, {"id": "..."}.
+func ParseAssetReference(value any) (string, bool) {
+	object, ok := value.(map[string]any)
+	if !ok || len(object) != 1 {
+		return "", false
+	}
+	id, _ := object["id"].(string)
+	return id, id != ""
+}
+
+// FieldAssetReference is the reference to an asset a top-level field of a content holds.
+type FieldAssetReference struct {
+	Field   string
+	AssetId string
+}
+
+// AssetReferences returns the references held by the asset fields of a content in the order the schema declares them.
+func (s *ContentTypeSchema) AssetReferences(content map[string]any) []FieldAssetReference {
+	references := make([]FieldAssetReference, 0)
+	for _, field := range s.Fields {
+		if field.Type != FieldTypeAsset {
+			continue
+		}
+		if assetId, ok := ParseAssetReference(content[field.Name]); ok {
+			references = append(references, FieldAssetReference{Field: field.Name, AssetId: assetId})
+		}
+	}
+	return references
+}
+
+// ValidateAssetReferences rejects the references of the top-level fields names of a content to assets that do not
+// exist, exists telling whether an asset exists and is not deleted.
+func (s *ContentTypeSchema) ValidateAssetReferences(content map[string]any, exists func(assetId string) (bool, error),
+	names ...string) error {
+	violations := make([]FieldViolation, 0)
+	for _, reference := range s.AssetReferences(content) {
+		if !slices.Contains(names, reference.Field) {
+			continue
+		}
+		ok, err := exists(reference.AssetId)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			violations = append(violations, FieldViolation{Field: reference.Field,
+				Message: fmt.Sprintf("references asset %s that does not exist", reference.AssetId)})
+		}
+	}
+	return newSchemaViolationError(violations)
+}
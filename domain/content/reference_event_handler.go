@@ -0,0 +1,93 @@
+package content
+
+import (
+	"contentgit/domain/content/events"
+	"contentgit/domain/content/projections"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// ReferenceEventHandler keeps the reverse-reference index from the events changing the contents on the default branch.
+// The references of a content are read again from its current state and the latest schema of its content type on
+// every such event, so that an event delivered again or after a later one leaves the index as it should be.
+// A content in the trash references nothing until it is restored.
+type ReferenceEventHandler struct {
+	aggregateStore                       eventsourcing.AggregateStore
+	contentTypeSchemaRepository          ContentTypeSchemaRepository
+	contentReferenceProjectionRepository ContentReferenceProjectionRepository
+}
+
+func NewReferenceEventHandler(aggregateStore eventsourcing.AggregateStore, contentTypeSchemaRepository ContentTypeSchemaRepository,
+	contentReferenceProjectionRepository ContentReferenceProjectionRepository) *ReferenceEventHandler {
+	return &ReferenceEventHandler{aggregateStore: aggregateStore, contentTypeSchemaRepository: contentTypeSchemaRepository,
+		contentReferenceProjectionRepository: contentReferenceProjectionRepository}
+}
+
+func (c *ReferenceEventHandler) Handle(ctx context.Context, esEvent eventsourcing.Event) error {
+	if esEvent.GetBranch() != eventsourcing.DefaultBranch {
+		return nil
+	}
+
+	switch esEvent.GetEventType() {
+	case events.ContentCreatedEventType, events.FieldUpdatedEventType, events.FieldAddedEventType, events.FieldRemovedEventType,
+		events.ContentMergedEventType, events.ContentRevertedEventType, events.ContentCommittedEventType,
+		events.ContentMigratedEventType, events.ContentDeletedEventType, events.ContentRestoredEventType, events.ContentPurgedEventType:
+	default:
+		return nil
+	}
+
+	contentAggregate, err := NewContentAggregate(esEvent.GetAggregateID(), esEvent.GetTenantId())
+	if err != nil {
+		return err
+	}
+	if err := c.aggregateStore.Load(ctx, contentAggregate); err != nil {
+		return errors.Wrapf(err, "aggregateStore.Load aggregateID: %s", esEvent.GetAggregateID())
+	}
+
+	references, err := c.findReferences(ctx, contentAggregate)
+	if err != nil {
+		return err
+	}
+
+	referenceProjections := make([]projections.ContentReferenceProjection, 0, len(references))
+	for _, reference := range references {
+		referenceProjections = append(referenceProjections, projections.NewContentReferenceProjection(
+			contentAggregate.GetTenantId(),
+			contentAggregate.GetID(),
+			reference.Field,
+			contentAggregate.ContentType,
+			uint(contentAggregate.GetVersion()),
+			reference.Target.Id,
+			reference.Target.ContentType,
+			esEvent.GetCreatedAt(),
+		))
+	}
+
+	if err := c.contentReferenceProjectionRepository.ReplaceAllBySourceId(ctx, contentAggregate.GetTenantId(),
+		contentAggregate.GetID(), referenceProjections); err != nil {
+		return errors.Wrap(err, "failed to replace content reference projections")
+	}
+	return nil
+}
+
+func (c *ReferenceEventHandler) GetAggregateType() eventsourcing.AggregateType {
+	return ContentAggregateType
+}
+
+func (c *ReferenceEventHandler) findReferences(ctx context.Context, contentAggregate *ContentAggregate) ([]FieldReference, error) {
+	if contentAggregate.GetVersion() == 0 || contentAggregate.Deleted || contentAggregate.Purged {
+		return nil, nil
+	}
+
+	schema, err := c.contentTypeSchemaRepository.FindLatest(ctx, contentAggregate.GetTenantId(), contentAggregate.ContentType)
+	if errors.Is(err, persistence.ErrRecordNotFound) || (err == nil && schema.Deleted) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return schema.References(contentAggregate.Content), nil
+}
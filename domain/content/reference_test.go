@@ -0,0 +1,178 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newProductSchema(t *testing.T) *ContentTypeSchema {
+	schema, err := NewContentTypeSchema("lumen", "products", nil, "상품", []FieldDefinition{
+		{Name: "name", Type: FieldTypeString, Required: true},
+		{Name: "brand", Type: FieldTypeReference, ReferenceType: "brands"},
+		{Name: "related", Type: FieldTypeReference},
+	}, nil, "1", "사이트 관리자")
+	require.NoError(t, err)
+	return schema
+}
+
+func TestParseReference(t *testing.T) {
+	t.Run("id와 컨텐츠 타입만 가진 객체를 참조로 읽는다", func(t *testing.T) {
+		// when
+		reference, ok := ParseReference(map[string]any{"id": "6a1e3c5b", "contentType": "brands"})
+
+		// then
+		assert.True(t, ok)
+		assert.Equal(t, ContentReference{Id: "6a1e3c5b", ContentType: "brands"}, reference)
+	})
+
+	t.Run("참조 형태가 아닌 값은 참조가 아니다", func(t *testing.T) {
+		values := []any{
+			"6a1e3c5b",
+			map[string]any{"id": "6a1e3c5b"},
+			map[string]any{"id": "", "contentType": "brands"},
+			map[string]any{"id": float64(1), "contentType": "brands"},
+			map[string]any{"id": "6a1e3c5b", "contentType": "brands", "name": "루멘"},
+		}
+		for _, value := range values {
+			// when
+			_, ok := ParseReference(value)
+
+			// then
+			assert.False(t, ok, value)
+		}
+	})
+}
+
+func TestContentTypeSchema_References(t *testing.T) {
+	t.Run("참조 필드의 참조를 스키마에 선언된 순서로 반환한다", func(t *testing.T) {
+		// given
+		sut := newProductSchema(t)
+
+		// when
+		references := sut.References(map[string]any{
+			"name":    "무드등",
+			"related": map[string]any{"id": "9e2b4d6f", "contentType": "products"},
+			"brand":   map[string]any{"id": "6a1e3c5b", "contentType": "brands"},
+		})
+
+		// then
+		assert.Equal(t, []FieldReference{
+			{Field: "brand", Target: ContentReference{Id: "6a1e3c5b", ContentType: "brands"}},
+			{Field: "related", Target: ContentReference{Id: "9e2b4d6f", ContentType: "products"}},
+		}, references)
+	})
+}
+
+func TestContentTypeSchema_Validate_Reference(t *testing.T) {
+	t.Run("참조 타입이 다른 컨텐츠를 참조하면 ErrSchemaViolation을 반환한다", func(t *testing.T) {
+		// given
+		sut := newProductSchema(t)
+
+		// when
+		err := sut.Validate(map[string]any{"name": "무드등", "brand": map[string]any{"id": "9e2b4d6f", "contentType": "products"}})
+
+		// then
+		assert.ErrorIs(t, err, ErrSchemaViolation)
+		assert.Equal(t, []FieldViolation{{Field: "brand", Message: "must reference a content of type brands"}}, err.(*SchemaViolationError).Violations)
+	})
+
+	t.Run("참조 형태가 아닌 값은 ErrSchemaViolation을 반환한다", func(t *testing.T) {
+		// given
+		sut := newProductSchema(t)
+
+		// when
+		err := sut.Validate(map[string]any{"name": "무드등", "related": "9e2b4d6f"})
+
+		// then
+		assert.ErrorIs(t, err, ErrSchemaViolation)
+		assert.Equal(t, []FieldViolation{{Field: "related", Message: "must be of type reference"}}, err.(*SchemaViolationError).Violations)
+	})
+}
+
+func TestContentTypeSchema_ValidateReferences(t *testing.T) {
+	content := map[string]any{
+		"name":    "무드등",
+		"brand":   map[string]any{"id": "6a1e3c5b", "contentType": "brands"},
+		"related": map[string]any{"id": "5c2e7a9d", "contentType": "products"},
+	}
+	exists := func(reference ContentReference) (bool, error) {
+		return reference.Id == "6a1e3c5b", nil
+	}
+
+	t.Run("존재하지 않는 컨텐츠를 참조하면 ErrSchemaViolation을 반환한다", func(t *testing.T) {
+		// given
+		sut := newProductSchema(t)
+
+		// when
+		err := sut.ValidateReferences(content, exists, "name", "brand", "related")
+
+		// then
+		assert.ErrorIs(t, err, ErrSchemaViolation)
+		assert.Equal(t, []FieldViolation{{Field: "related", Message: "references products 5c2e7a9d that does not exist"}},
+			err.(*SchemaViolationError).Violations)
+	})
+
+	t.Run("주어진 필드의 참조만 확인한다", func(t *testing.T) {
+		// given
+		sut := newProductSchema(t)
+
+		// when
+		err := sut.ValidateReferences(content, exists, "brand")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("확인하지 못하면 에러를 그대로 반환한다", func(t *testing.T) {
+		// given
+		sut := newProductSchema(t)
+
+		// when
+		err := sut.ValidateReferences(content, func(ContentReference) (bool, error) { return false, assert.AnError }, "brand")
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestContentTypeSchema_ValidateAssetReferences(t *testing.T) {
+	newSchema := func(t *testing.T) *ContentTypeSchema {
+		schema, err := NewContentTypeSchema("lumen", "banners", nil, "배너", []FieldDefinition{
+			{Name: "title", Type: FieldTypeString, Required: true},
+			{Name: "image", Type: FieldTypeAsset, Required: true},
+		}, nil, "1", "사이트 관리자")
+		require.NoError(t, err)
+		return schema
+	}
+
+	t.Run("에셋 필드는 id만 가진 객체여야 한다", func(t *testing.T) {
+		// given
+		sut := newSchema(t)
+
+		// when
+		err := sut.Validate(map[string]any{"title": "봄 세일", "image": "9b2f4e6a"})
+
+		// then
+		assert.ErrorIs(t, err, ErrSchemaViolation)
+		assert.Equal(t, []FieldViolation{{Field: "image", Message: "must be of type asset"}}, err.(*SchemaViolationError).Violations)
+		assert.NoError(t, sut.Validate(map[string]any{"title": "봄 세일", "image": map[string]any{"id": "9b2f4e6a"}}))
+	})
+
+	t.Run("존재하지 않는 에셋을 참조하면 ErrSchemaViolation을 반환한다", func(t *testing.T) {
+		// given
+		sut := newSchema(t)
+		exists := func(assetId string) (bool, error) { return assetId == "9b2f4e6a", nil }
+
+		// when
+		err := sut.ValidateAssetReferences(map[string]any{"title": "봄 세일", "image": map[string]any{"id": "1d3c5e7f"}},
+			exists, "title", "image")
+
+		// then
+		assert.ErrorIs(t, err, ErrSchemaViolation)
+		assert.Equal(t, []FieldViolation{{Field: "image", Message: "references asset 1d3c5e7f that does not exist"}},
+			err.(*SchemaViolationError).Violations)
+		assert.NoError(t, sut.ValidateAssetReferences(map[string]any{"image": map[string]any{"id": "9b2f4e6a"}}, exists, "image"))
+	})
+}
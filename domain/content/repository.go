@@ -4,11 +4,109 @@ import (
 	"contentgit/domain/content/projections"
 	"contentgit/dtos"
 	"context"
+	"time"
 )
 
+// ContentProjectionRepository hides deleted contents unless a method says it includes them.
 type ContentProjectionRepository interface {
 	Create(ctx context.Context, projection projections.ContentProjection) error
 	FindByID(ctx context.Context, tenantId string, id string) (*projections.ContentProjection, error)
-	FindAll(ctx context.Context, tenantId string, pageable dtos.Pageable, sort *dtos.Sort) ([]projections.ContentProjection, int64, error)
+	FindByIDIncludingDeleted(ctx context.Context, tenantId string, id string) (*projections.ContentProjection, error)
+	FindAll(ctx context.Context, tenantId string, pageable dtos.Pageable, sort *dtos.Sort, filter dtos.ContentFilter) ([]projections.ContentProjection, int64, error)
+	FindAllDeleted(ctx context.Context, tenantId string, pageable dtos.Pageable) ([]projections.ContentProjection, int64, error)
 	Save(ctx context.Context, projection *projections.ContentProjection) error
 }
+
+// ContentStreamRepository reads the event streams of contents, which the projections only catch up with, so that
+// a content just created is found as well.
+type ContentStreamRepository interface {
+	// FindIdsByContentType returns the ids of the contents of the content type following afterId in the order of their ids.
+	FindIdsByContentType(ctx context.Context, tenantId string, contentType string, afterId string, limit int) ([]string, error)
+	CountByContentType(ctx context.Context, tenantId string, contentType string) (int64, error)
+	// FindBranches returns the branches of a content other than the default branch, in the order of their names.
+	FindBranches(ctx context.Context, tenantId string, contentId string) ([]string, error)
+}
+
+type ContentBranchProjectionRepository interface {
+	Create(ctx context.Context, projection projections.ContentBranchProjection) error
+	FindByID(ctx context.Context, tenantId string, contentId string, branch string) (*projections.ContentBranchProjection, error)
+	FindAllByContentId(ctx context.Context, tenantId string, contentId string) ([]projections.ContentBranchProjection, error)
+	Save(ctx context.Context, projection *projections.ContentBranchProjection) error
+}
+
+type ContentCommitProjectionRepository interface {
+	Create(ctx context.Context, projection projections.ContentCommitProjection) error
+	FindAllByContentId(ctx context.Context, tenantId string, contentId string, branch string) ([]projections.ContentCommitProjection, error)
+	Save(ctx context.Context, projection *projections.ContentCommitProjection) error
+}
+
+type ContentTagRepository interface {
+	Create(ctx context.Context, tag ContentTag) error
+	FindByName(ctx context.Context, tenantId string, contentId string, name string) (*ContentTag, error)
+	FindAllByContentId(ctx context.Context, tenantId string, contentId string) ([]ContentTag, error)
+	FindAll(ctx context.Context, tenantId string, pageable dtos.Pageable) ([]ContentTag, int64, error)
+	Delete(ctx context.Context, tenantId string, contentId string, name string) error
+}
+
+// ContentKeyRepository stores the encryption keys of contents, a destroyed key is kept without its key material.
+type ContentKeyRepository interface {
+	FindOrCreate(ctx context.Context, tenantId string, contentId string) (*ContentKey, error)
+	FindByContentId(ctx context.Context, contentId string) (*ContentKey, error)
+	Destroy(ctx context.Context, tenantId string, contentId string) error
+}
+
+// PublishedContentProjectionRepository stores what readers see, a content that is not published has no projection.
+type PublishedContentProjectionRepository interface {
+	Save(ctx context.Context, projection *projections.PublishedContentProjection) error
+	FindByID(ctx context.Context, tenantId string, contentType string, id string) (*projections.PublishedContentProjection, error)
+	FindAll(ctx context.Context, tenantId string, contentType string, pageable dtos.Pageable) ([]projections.PublishedContentProjection, int64, error)
+	Delete(ctx context.Context, tenantId string, id string) error
+}
+
+// ContentScheduleRepository stores the scheduled jobs of contents, jobs are kept once they ran or were canceled.
+type ContentScheduleRepository interface {
+	Create(ctx context.Context, schedule ContentSchedule) error
+	FindByID(ctx context.Context, tenantId string, contentId string, id string) (*ContentSchedule, error)
+	FindAllByContentId(ctx context.Context, tenantId string, contentId string) ([]ContentSchedule, error)
+	// ClaimNextDue locks the earliest pending job due at now until the end of the transaction, jobs locked by
+	// another scheduler and the jobs skipIds are skipped. It returns persistence.ErrRecordNotFound when no job is due.
+	ClaimNextDue(ctx context.Context, now time.Time, skipIds []string) (*ContentSchedule, error)
+	Save(ctx context.Context, schedule *ContentSchedule) error
+}
+
+// ContentTypeSchemaRepository stores every version of the schemas of content types.
+type ContentTypeSchemaRepository interface {
+	// Create returns persistence.ErrDuplicateRecord when the version of the schema was already created.
+	Create(ctx context.Context, schema ContentTypeSchema) error
+	// FindLatest returns the latest version of the schema, which is deleted when the content type was deleted.
+	FindLatest(ctx context.Context, tenantId string, contentType string) (*ContentTypeSchema, error)
+	FindByVersion(ctx context.Context, tenantId string, contentType string, version uint64) (*ContentTypeSchema, error)
+	FindAllVersions(ctx context.Context, tenantId string, contentType string) ([]ContentTypeSchema, error)
+	// FindAllLatest returns the latest version of the schema of every content type of the tenant that is not deleted.
+	FindAllLatest(ctx context.Context, tenantId string) ([]ContentTypeSchema, error)
+}
+
+// SchemaMigrationRepository stores the schema migrations of content types, migrations are kept once they are done.
+type SchemaMigrationRepository interface {
+	Create(ctx context.Context, migration SchemaMigration) error
+	FindByID(ctx context.Context, tenantId string, contentType string, id string) (*SchemaMigration, error)
+	// FindAllByContentType returns the migrations of the content type, the latest first.
+	FindAllByContentType(ctx context.Context, tenantId string, contentType string) ([]SchemaMigration, error)
+	// ExistsActive tells whether a migration of the content type that is not a dry run is pending or running.
+	ExistsActive(ctx context.Context, tenantId string, contentType string) (bool, error)
+	// ClaimNextActive locks the oldest pending or running migration until the end of the transaction, migrations locked
+	// by another runner are skipped. It returns persistence.ErrRecordNotFound when no migration is to be run.
+	ClaimNextActive(ctx context.Context) (*SchemaMigration, error)
+	Save(ctx context.Context, migration *SchemaMigration) error
+}
+
+// ContentReferenceProjectionRepository stores the reverse-reference index, the references of the reference fields of
+// the contents on the default branch.
+type ContentReferenceProjectionRepository interface {
+	// ReplaceAllBySourceId replaces the references of a content with the given ones.
+	ReplaceAllBySourceId(ctx context.Context, tenantId string, sourceId string, references []projections.ContentReferenceProjection) error
+	// FindAllByTargetId returns the references to a content in the order of the ids of the contents holding them.
+	FindAllByTargetId(ctx context.Context, tenantId string, targetId string) ([]projections.ContentReferenceProjection, error)
+	// ExistsByTargetId tells whether another content references a content, a content referencing itself does not count.
+	ExistsByTargetId(ctx context.Context, tenantId string, targetId string) (bool, error)
+}
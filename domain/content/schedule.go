@@ -0,0 +1,124 @@
+package content
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ScheduleState is where a scheduled job is in its lifecycle, only pending jobs are run or canceled.
+type ScheduleState string
+
+const (
+	ScheduleStatePending  ScheduleState = "pending"
+	ScheduleStateDone     ScheduleState = "done"
+	ScheduleStateFailed   ScheduleState = "failed"
+	ScheduleStateCanceled ScheduleState = "canceled"
+)
+
+// Clock tells the current time, tests inject a fixed clock instead of waiting for jobs to become due.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct {
+}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock is the wall clock.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+// MaxScheduleAttempts is how many times a job that keeps losing races with editors is run before it is marked failed.
+const MaxScheduleAttempts = 5
+
+// ContentSchedule is a publish or unpublish of a content to run at RunAt through the workflow of its content type.
+// Schedules are not part of the event stream, the transition they run is.
+type ContentSchedule struct {
+	Id            string        `gorm:"type:varchar(100);primaryKey"`
+	TenantId      string        `gorm:"type:varchar(100);not null;index"`
+	ContentId     string        `gorm:"type:varchar(100);not null;index"`
+	Transition    Transition    `gorm:"type:varchar(20);not null"`
+	Version       uint64        `gorm:"not null"`
+	RunAt         time.Time     `gorm:"not null;index:idx_content_schedules_due"`
+	State         ScheduleState `gorm:"type:varchar(20);not null;index:idx_content_schedules_due"`
+	Error         string        `gorm:"type:text"`
+	Attempts      int           `gorm:"not null;default:0"`
+	CreatedById   string
+	CreatedByName string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	ExecutedAt    *time.Time
+}
+
+// NewContentSchedule schedules the transition at runAt, which must be later than now. A publish promotes the content
+// as it was at Version, it runs as long as the values of the content were not changed since, whatever workflow
+// transitions were made in between. An unpublish has no version.
+func NewContentSchedule(id string, tenantId string, contentId string, transition Transition, version uint64, runAt time.Time,
+	now time.Time, createdById string, createdByName string) (*ContentSchedule, error) {
+	switch transition {
+	case TransitionPublish:
+		if version == 0 {
+			return nil, errors.Wrap(ErrInvalidSchedule, "publish requires a version")
+		}
+	case TransitionUnpublish:
+		version = 0
+	default:
+		return nil, errors.Wrapf(ErrInvalidSchedule, "transition: %s", transition)
+	}
+	if !runAt.After(now) {
+		return nil, errors.Wrapf(ErrInvalidSchedule, "runAt: %s is not in the future", runAt.Format(time.RFC3339))
+	}
+
+	return &ContentSchedule{
+		Id:            id,
+		TenantId:      tenantId,
+		ContentId:     contentId,
+		Transition:    transition,
+		Version:       version,
+		RunAt:         runAt,
+		State:         ScheduleStatePending,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}, nil
+}
+
+func (*ContentSchedule) TableName() string {
+	return "content_schedules"
+}
+
+func (s *ContentSchedule) Cancel() error {
+	if s.State != ScheduleStatePending {
+		return errors.Wrapf(ErrScheduleNotPending, "state: %s", s.State)
+	}
+	s.State = ScheduleStateCanceled
+	return nil
+}
+
+func (s *ContentSchedule) Complete(executedAt time.Time) {
+	s.State = ScheduleStateDone
+	s.Error = ""
+	s.ExecutedAt = &executedAt
+}
+
+// Retry records an attempt that lost a race with an editor, the job stays pending and is run again later
+// until it used up MaxScheduleAttempts, it is then marked failed.
+func (s *ContentSchedule) Retry(executedAt time.Time, err error) {
+	s.Attempts++
+	if s.Attempts >= MaxScheduleAttempts {
+		s.Fail(executedAt, errors.Wrapf(err, "gave up after %d attempts", s.Attempts))
+		return
+	}
+	s.Error = err.Error()
+}
+
+// Fail records why the job could not run, a failed job is not retried.
+func (s *ContentSchedule) Fail(executedAt time.Time, err error) {
+	s.State = ScheduleStateFailed
+	s.Error = err.Error()
+	s.ExecutedAt = &executedAt
+}
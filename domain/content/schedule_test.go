@@ -0,0 +1,96 @@
+package content
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewContentSchedule(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	runAt := time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("게시할 버전과 시각으로 예약한다", func(t *testing.T) {
+		// when
+		schedule, err := NewContentSchedule("scheduleId", "bettercode", "contentId", TransitionPublish, 3, runAt, now, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, ScheduleStatePending, schedule.State)
+		assert.Equal(t, uint64(3), schedule.Version)
+	})
+
+	t.Run("게시 내리기는 버전 없이 예약한다", func(t *testing.T) {
+		// when
+		schedule, err := NewContentSchedule("scheduleId", "bettercode", "contentId", TransitionUnpublish, 3, runAt, now, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Zero(t, schedule.Version)
+	})
+
+	t.Run("게시와 게시 내리기가 아니거나 지난 시각이면 ErrInvalidSchedule을 반환한다", func(t *testing.T) {
+		// when
+		_, submitErr := NewContentSchedule("scheduleId", "bettercode", "contentId", TransitionSubmit, 3, runAt, now, "testerId", "testerName")
+		_, withoutVersionErr := NewContentSchedule("scheduleId", "bettercode", "contentId", TransitionPublish, 0, runAt, now, "testerId", "testerName")
+		_, pastErr := NewContentSchedule("scheduleId", "bettercode", "contentId", TransitionPublish, 3, now, now, "testerId", "testerName")
+
+		// then
+		assert.ErrorIs(t, submitErr, ErrInvalidSchedule)
+		assert.ErrorIs(t, withoutVersionErr, ErrInvalidSchedule)
+		assert.ErrorIs(t, pastErr, ErrInvalidSchedule)
+	})
+}
+
+func TestContentSchedule_Cancel(t *testing.T) {
+	t.Run("실행된 예약은 취소할 수 없다", func(t *testing.T) {
+		// given
+		schedule, _ := NewContentSchedule("scheduleId", "bettercode", "contentId", TransitionUnpublish, 0,
+			time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC), time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC), "testerId", "testerName")
+		schedule.Complete(time.Date(2026, 11, 1, 9, 0, 5, 0, time.UTC))
+
+		// when
+		err := schedule.Cancel()
+
+		// then
+		assert.ErrorIs(t, err, ErrScheduleNotPending)
+		assert.Equal(t, ScheduleStateDone, schedule.State)
+	})
+}
+
+func TestContentSchedule_Retry(t *testing.T) {
+	t.Run("다시 시도할 예약은 대기 상태로 남고 시도가 기록된다", func(t *testing.T) {
+		// given
+		schedule, _ := NewContentSchedule("scheduleId", "bettercode", "contentId", TransitionPublish, 3,
+			time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC), time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC), "testerId", "testerName")
+
+		// when
+		schedule.Retry(time.Date(2026, 11, 1, 9, 0, 5, 0, time.UTC), errors.New("concurrency conflict"))
+
+		// then
+		assert.Equal(t, ScheduleStatePending, schedule.State)
+		assert.Equal(t, 1, schedule.Attempts)
+		assert.Equal(t, "concurrency conflict", schedule.Error)
+		assert.Nil(t, schedule.ExecutedAt)
+	})
+
+	t.Run("시도를 다 쓰면 실패로 기록되고 다시 시도하지 않는다", func(t *testing.T) {
+		// given
+		schedule, _ := NewContentSchedule("scheduleId", "bettercode", "contentId", TransitionPublish, 3,
+			time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC), time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC), "testerId", "testerName")
+		executedAt := time.Date(2026, 11, 1, 9, 5, 0, 0, time.UTC)
+
+		// when
+		for i := 0; i < MaxScheduleAttempts; i++ {
+			schedule.Retry(executedAt, errors.New("concurrency conflict"))
+		}
+
+		// then
+		assert.Equal(t, ScheduleStateFailed, schedule.State)
+		assert.Equal(t, MaxScheduleAttempts, schedule.Attempts)
+		assert.Contains(t, schedule.Error, "concurrency conflict")
+		assert.Equal(t, executedAt, *schedule.ExecutedAt)
+	})
+}
@@ -0,0 +1,207 @@
+package content
+
+import (
+	"contentgit/domain/content/events"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SchemaMigrationState is where a schema migration is in its lifecycle, pending and running migrations are run
+// by the schema migration runner until they are done.
+type SchemaMigrationState string
+
+const (
+	SchemaMigrationStatePending SchemaMigrationState = "pending"
+	SchemaMigrationStateRunning SchemaMigrationState = "running"
+	SchemaMigrationStateDone    SchemaMigrationState = "done"
+	SchemaMigrationStateFailed  SchemaMigrationState = "failed"
+)
+
+// SchemaMigrationOutcome is what a schema migration did to a content it reports.
+type SchemaMigrationOutcome string
+
+const (
+	SchemaMigrationOutcomeMigrated SchemaMigrationOutcome = "migrated"
+	SchemaMigrationOutcomeFailed   SchemaMigrationOutcome = "failed"
+	// SchemaMigrationOutcomeSkipped reports a branch of a content, a migration migrates the default branch only.
+	SchemaMigrationOutcomeSkipped SchemaMigrationOutcome = "skipped"
+)
+
+// SchemaMigrationFieldOperation is what a schema migration did to a field of a content.
+type SchemaMigrationFieldOperation string
+
+const (
+	SchemaMigrationFieldAdded   SchemaMigrationFieldOperation = "added"
+	SchemaMigrationFieldRemoved SchemaMigrationFieldOperation = "removed"
+	SchemaMigrationFieldChanged SchemaMigrationFieldOperation = "changed"
+)
+
+// SchemaMigrationResult reports a content a schema migration migrated, or would migrate on a dry run, with the fields
+// it changed, a content it could not migrate with the reason, or a branch of a content it left as it is.
+type SchemaMigrationResult struct {
+	ContentId string                 `json:"contentId"`
+	Branch    string                 `json:"branch,omitempty"`
+	Outcome   SchemaMigrationOutcome `json:"outcome"`
+	Fields    []SchemaMigrationField `json:"fields,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+}
+
+// SchemaMigrationField is a field a schema migration changed. The values are left out, a report outlives the purge of
+// the personal data of a content and is kept for dry runs that change nothing.
+type SchemaMigrationField struct {
+	FieldName string                        `json:"fieldName"`
+	Operation SchemaMigrationFieldOperation `json:"operation"`
+}
+
+// SchemaMigrationResults are the results of a schema migration, stored as jsonb.
+type SchemaMigrationResults []SchemaMigrationResult
+
+func (jsonField SchemaMigrationResults) Value() (driver.Value, error) {
+	return json.Marshal(jsonField)
+}
+
+// Scan Unmarshal
+func (jsonField *SchemaMigrationResults) Scan(value any) error {
+	data, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(data, &jsonField)
+}
+
+// SchemaMigration is a background job bringing the contents of a content type from FromVersion to ToVersion of its
+// schema, content by content in the order of their ids. Cursor is the id of the last content it went through, it is
+// saved with the migrations of a batch of contents so that a migration interrupted by a crash resumes after
+// the last batch it completed. A dry run reports what it would do without changing any content.
+// Migrations are not part of the event stream, the migrations of the contents are.
+type SchemaMigration struct {
+	Id            string                 `gorm:"type:varchar(100);primaryKey"`
+	TenantId      string                 `gorm:"type:varchar(100);not null;index:idx_content_type_migrations_content_type"`
+	ContentType   string                 `gorm:"type:varchar(100);not null;index:idx_content_type_migrations_content_type"`
+	FromVersion   uint64                 `gorm:"not null"`
+	ToVersion     uint64                 `gorm:"not null"`
+	DryRun        bool                   `gorm:"not null;default:false"`
+	State         SchemaMigrationState   `gorm:"type:varchar(20);not null;index"`
+	Total         int                    `gorm:"not null;default:0"`
+	Processed     int                    `gorm:"not null;default:0"`
+	Migrated      int                    `gorm:"not null;default:0"`
+	Unchanged     int                    `gorm:"not null;default:0"`
+	Skipped       int                    `gorm:"not null;default:0"`
+	Failed        int                    `gorm:"not null;default:0"`
+	Cursor        string                 `gorm:"type:varchar(100)"`
+	Results       SchemaMigrationResults `gorm:"type:jsonb;not null"`
+	Error         string                 `gorm:"type:text"`
+	CreatedById   string
+	CreatedByName string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	StartedAt     *time.Time
+	CompletedAt   *time.Time
+}
+
+// NewSchemaMigration migrates the contents of a content type from fromVersion to toVersion, toVersion has to be later.
+func NewSchemaMigration(id string, tenantId string, contentType string, fromVersion uint64, toVersion uint64, dryRun bool,
+	createdById string, createdByName string) (*SchemaMigration, error) {
+	if fromVersion >= toVersion {
+		return nil, errors.Wrapf(ErrInvalidMigration, "fromVersion: %d, toVersion: %d", fromVersion, toVersion)
+	}
+
+	return &SchemaMigration{
+		Id:            id,
+		TenantId:      tenantId,
+		ContentType:   contentType,
+		FromVersion:   fromVersion,
+		ToVersion:     toVersion,
+		DryRun:        dryRun,
+		State:         SchemaMigrationStatePending,
+		Results:       SchemaMigrationResults{},
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}, nil
+}
+
+func (*SchemaMigration) TableName() string {
+	return "content_type_migrations"
+}
+
+// IsActive tells whether the migration is still to be run.
+func (m *SchemaMigration) IsActive() bool {
+	return m.State == SchemaMigrationStatePending || m.State == SchemaMigrationStateRunning
+}
+
+// Start counts the contents the migration goes through, a running migration is resumed as it is.
+func (m *SchemaMigration) Start(startedAt time.Time, total int) {
+	if m.State != SchemaMigrationStatePending {
+		return
+	}
+	m.State = SchemaMigrationStateRunning
+	m.Total = total
+	m.StartedAt = &startedAt
+}
+
+// Record moves the cursor past a content and counts what migrating it did, err is what the migration of the content
+// returned. An error that tells nothing about the content itself, such as a database error, is returned as it is
+// and nothing is recorded.
+func (m *SchemaMigration) Record(contentId string, fields []events.MigratedField, err error) error {
+	switch {
+	case err == nil:
+		m.Migrated++
+		m.Results = append(m.Results, SchemaMigrationResult{ContentId: contentId, Outcome: SchemaMigrationOutcomeMigrated,
+			Fields: toSchemaMigrationFields(fields)})
+	case errors.Is(err, ErrNothingToMigrate):
+		m.Unchanged++
+	case errors.Is(err, ErrContentDeleted), errors.Is(err, ErrContentPurged), errors.Is(err, eventsourcing.ErrAggregateNotFound):
+		m.Skipped++
+	case errors.Is(err, ErrMigrationFailed), errors.Is(err, ErrSchemaViolation):
+		m.Failed++
+		m.Results = append(m.Results, SchemaMigrationResult{ContentId: contentId, Outcome: SchemaMigrationOutcomeFailed, Error: err.Error()})
+	default:
+		return err
+	}
+
+	m.Processed++
+	m.Cursor = contentId
+	return nil
+}
+
+// RecordSkippedBranches reports the branches of a content the migration went through, which keep the schema version
+// they had. The contents and the cursor are counted by Record.
+func (m *SchemaMigration) RecordSkippedBranches(contentId string, branches []string) {
+	for _, branch := range branches {
+		m.Results = append(m.Results, SchemaMigrationResult{ContentId: contentId, Branch: branch, Outcome: SchemaMigrationOutcomeSkipped,
+			Error: "branches are not migrated"})
+	}
+}
+
+// toSchemaMigrationFields reports the migrated fields by name, a field without a value before the migration was added
+// by it and one without a value after it was removed.
+func toSchemaMigrationFields(fields []events.MigratedField) []SchemaMigrationField {
+	migrationFields := make([]SchemaMigrationField, 0, len(fields))
+	for _, field := range fields {
+		operation := SchemaMigrationFieldChanged
+		switch {
+		case field.BeforeValue == nil:
+			operation = SchemaMigrationFieldAdded
+		case field.AfterValue == nil:
+			operation = SchemaMigrationFieldRemoved
+		}
+		migrationFields = append(migrationFields, SchemaMigrationField{FieldName: field.FieldName, Operation: operation})
+	}
+	return migrationFields
+}
+
+func (m *SchemaMigration) Complete(completedAt time.Time) {
+	m.State = SchemaMigrationStateDone
+	m.CompletedAt = &completedAt
+}
+
+// Fail stops the migration, the contents it already migrated stay migrated.
+func (m *SchemaMigration) Fail(completedAt time.Time, err error) {
+	m.State = SchemaMigrationStateFailed
+	m.Error = err.Error()
+	m.CompletedAt = &completedAt
+}
@@ -4,6 +4,8 @@ import (
 	"contentgit/domain/content/events"
 	"contentgit/ports/out/persistance/eventsourcing"
 	"contentgit/ports/out/persistance/eventsourcing/serializer"
+	"context"
+
 	"github.com/pkg/errors"
 )
 
@@ -12,14 +14,29 @@ var (
 )
 
 type eventSerializer struct {
+	shredder *FieldShredder
 }
 
 func NewEventSerializer() *eventSerializer {
 	return &eventSerializer{}
 }
 
-func (s *eventSerializer) SerializeEvent(aggregate eventsourcing.Aggregate, event any) (eventsourcing.Event, error) {
-	eventJson, err := serializer.Marshal(event)
+// NewShreddingEventSerializer returns a serializer that stores the values of personal data fields encrypted with the key of their content.
+func NewShreddingEventSerializer(keyRepository ContentKeyRepository, personalDataFields PersonalDataFields) *eventSerializer {
+	return &eventSerializer{shredder: NewFieldShredder(keyRepository, personalDataFields)}
+}
+
+func (s *eventSerializer) SerializeEvent(ctx context.Context, aggregate eventsourcing.Aggregate, event any) (eventsourcing.Event, error) {
+	data := event
+	if s.shredder != nil {
+		encrypted, err := s.shredder.encrypt(ctx, aggregate, event)
+		if err != nil {
+			return eventsourcing.Event{}, errors.Wrapf(err, "shredder.encrypt aggregateID: %s", aggregate.GetID())
+		}
+		data = encrypted
+	}
+
+	eventJson, err := serializer.Marshal(data)
 	if err != nil {
 		return eventsourcing.Event{}, errors.Wrapf(err, "serializer.Marshal aggregateID: %s", aggregate.GetID())
 	}
@@ -31,12 +48,55 @@ func (s *eventSerializer) SerializeEvent(aggregate eventsourcing.Aggregate, even
 		return eventsourcing.NewEvent(aggregate, events.FieldUpdatedEventType, eventJson, evt.Metadata), nil
 	case *events.FieldCommentAddedEventV1:
 		return eventsourcing.NewEvent(aggregate, events.FieldCommentAddedEventType, eventJson, evt.Metadata), nil
+	case *events.FieldCommentEditedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.FieldCommentEditedEventType, eventJson, evt.Metadata), nil
+	case *events.FieldCommentDeletedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.FieldCommentDeletedEventType, eventJson, evt.Metadata), nil
+	case *events.FieldCommentResolvedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.FieldCommentResolvedEventType, eventJson, evt.Metadata), nil
+	case *events.FieldCommentReopenedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.FieldCommentReopenedEventType, eventJson, evt.Metadata), nil
+	case *events.ContentBranchCreatedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ContentBranchCreatedEventType, eventJson, evt.Metadata), nil
+	case *events.ContentMergedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ContentMergedEventType, eventJson, evt.Metadata), nil
+	case *events.ContentRevertedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ContentRevertedEventType, eventJson, evt.Metadata), nil
+	case *events.ContentCommittedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ContentCommittedEventType, eventJson, evt.Metadata), nil
+	case *events.FieldAddedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.FieldAddedEventType, eventJson, evt.Metadata), nil
+	case *events.FieldRemovedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.FieldRemovedEventType, eventJson, evt.Metadata), nil
+	case *events.ContentDeletedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ContentDeletedEventType, eventJson, evt.Metadata), nil
+	case *events.ContentRestoredEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ContentRestoredEventType, eventJson, evt.Metadata), nil
+	case *events.ContentPurgedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ContentPurgedEventType, eventJson, evt.Metadata), nil
+	case *events.ContentStatusChangedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ContentStatusChangedEventType, eventJson, evt.Metadata), nil
+	case *events.ContentMigratedEventV1:
+		return eventsourcing.NewEvent(aggregate, events.ContentMigratedEventType, eventJson, evt.Metadata), nil
 	default:
 		return eventsourcing.Event{}, errors.Wrapf(ErrInvalidEvent, "aggregateID: %s, type: %T", aggregate.GetID(), event)
 	}
 }
 
-func (s *eventSerializer) DeserializeEvent(event eventsourcing.Event) (any, error) {
+func (s *eventSerializer) DeserializeEvent(ctx context.Context, event eventsourcing.Event) (any, error) {
+	deserializedEvent, err := s.deserializeEvent(event)
+	if err != nil || s.shredder == nil {
+		return deserializedEvent, err
+	}
+
+	decrypted, err := s.shredder.decrypt(ctx, event, deserializedEvent)
+	if err != nil {
+		return nil, errors.Wrapf(err, "shredder.decrypt aggregateID: %s", event.GetAggregateID())
+	}
+	return decrypted, nil
+}
+
+func (s *eventSerializer) deserializeEvent(event eventsourcing.Event) (any, error) {
 	switch event.GetEventType() {
 	case events.ContentCreatedEventType:
 		return deserializeEvent(event, new(events.ContentCreatedEventV1))
@@ -44,6 +104,36 @@ func (s *eventSerializer) DeserializeEvent(event eventsourcing.Event) (any, erro
 		return deserializeEvent(event, new(events.FieldUpdatedEventV1))
 	case events.FieldCommentAddedEventType:
 		return deserializeEvent(event, new(events.FieldCommentAddedEventV1))
+	case events.FieldCommentEditedEventType:
+		return deserializeEvent(event, new(events.FieldCommentEditedEventV1))
+	case events.FieldCommentDeletedEventType:
+		return deserializeEvent(event, new(events.FieldCommentDeletedEventV1))
+	case events.FieldCommentResolvedEventType:
+		return deserializeEvent(event, new(events.FieldCommentResolvedEventV1))
+	case events.FieldCommentReopenedEventType:
+		return deserializeEvent(event, new(events.FieldCommentReopenedEventV1))
+	case events.ContentBranchCreatedEventType:
+		return deserializeEvent(event, new(events.ContentBranchCreatedEventV1))
+	case events.ContentMergedEventType:
+		return deserializeEvent(event, new(events.ContentMergedEventV1))
+	case events.ContentRevertedEventType:
+		return deserializeEvent(event, new(events.ContentRevertedEventV1))
+	case events.ContentCommittedEventType:
+		return deserializeEvent(event, new(events.ContentCommittedEventV1))
+	case events.FieldAddedEventType:
+		return deserializeEvent(event, new(events.FieldAddedEventV1))
+	case events.FieldRemovedEventType:
+		return deserializeEvent(event, new(events.FieldRemovedEventV1))
+	case events.ContentDeletedEventType:
+		return deserializeEvent(event, new(events.ContentDeletedEventV1))
+	case events.ContentRestoredEventType:
+		return deserializeEvent(event, new(events.ContentRestoredEventV1))
+	case events.ContentPurgedEventType:
+		return deserializeEvent(event, new(events.ContentPurgedEventV1))
+	case events.ContentStatusChangedEventType:
+		return deserializeEvent(event, new(events.ContentStatusChangedEventV1))
+	case events.ContentMigratedEventType:
+		return deserializeEvent(event, new(events.ContentMigratedEventV1))
 	default:
 		return nil, errors.Wrapf(ErrInvalidEvent, "type: %s", event.GetEventType())
 	}
@@ -0,0 +1,41 @@
+package content
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ContentTag is an immutable name for a version of a content branch, tags are not part of the event stream
+// so that tagging never moves the version of the content.
+type ContentTag struct {
+	TenantId      string `gorm:"type:varchar(100);not null;index"`
+	ContentId     string `gorm:"type:varchar(100);primaryKey"`
+	Name          string `gorm:"type:varchar(100);primaryKey"`
+	Branch        string `gorm:"type:varchar(100);not null"`
+	Version       uint64 `gorm:"not null"`
+	CreatedById   string
+	CreatedByName string
+	CreatedAt     time.Time
+}
+
+func NewContentTag(tenantId string, contentId string, name string, branch string, version uint64,
+	createdById string, createdByName string) (*ContentTag, error) {
+	if !refNamePattern.MatchString(name) {
+		return nil, errors.Wrapf(ErrInvalidTagName, "tag: %s", name)
+	}
+
+	return &ContentTag{
+		TenantId:      tenantId,
+		ContentId:     contentId,
+		Name:          name,
+		Branch:        branch,
+		Version:       version,
+		CreatedById:   createdById,
+		CreatedByName: createdByName,
+	}, nil
+}
+
+func (*ContentTag) TableName() string {
+	return "content_tags"
+}
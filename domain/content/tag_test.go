@@ -0,0 +1,30 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewContentTag(t *testing.T) {
+	t.Run("이름과 버전으로 태그를 생성한다", func(t *testing.T) {
+		// when
+		tag, err := NewContentTag("bettercode", "contentId", "release-2026-10", "main", 3, "testerId", "testerName")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "release-2026-10", tag.Name)
+		assert.Equal(t, "main", tag.Branch)
+		assert.Equal(t, uint64(3), tag.Version)
+	})
+
+	t.Run("태그 이름 형식이 잘못되면 ErrInvalidTagName을 반환한다", func(t *testing.T) {
+		for _, name := range []string{"", "-release", "release 2026", "release/2026"} {
+			// when
+			_, err := NewContentTag("bettercode", "contentId", name, "main", 3, "testerId", "testerName")
+
+			// then
+			assert.ErrorIs(t, err, ErrInvalidTagName, name)
+		}
+	})
+}
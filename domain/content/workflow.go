@@ -0,0 +1,127 @@
+package content
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Status is the editorial state of a content.
+type Status string
+
+const (
+	StatusDraft     Status = "draft"
+	StatusInReview  Status = "in_review"
+	StatusApproved  Status = "approved"
+	StatusPublished Status = "published"
+)
+
+// Transition is an editorial action moving a content to the status it targets.
+type Transition string
+
+const (
+	TransitionSubmit    Transition = "submit"
+	TransitionApprove   Transition = "approve"
+	TransitionReject    Transition = "reject"
+	TransitionPublish   Transition = "publish"
+	TransitionUnpublish Transition = "unpublish"
+)
+
+var transitionTargets = map[Transition]Status{
+	TransitionSubmit:    StatusInReview,
+	TransitionApprove:   StatusApproved,
+	TransitionReject:    StatusDraft,
+	TransitionPublish:   StatusPublished,
+	TransitionUnpublish: StatusDraft,
+}
+
+func ParseStatus(status string) (Status, error) {
+	switch s := Status(status); s {
+	case StatusDraft, StatusInReview, StatusApproved, StatusPublished:
+		return s, nil
+	default:
+		return "", errors.Wrapf(ErrInvalidStatus, "status: %s", status)
+	}
+}
+
+// Target returns the status the transition moves a content to.
+func (t Transition) Target() Status {
+	return transitionTargets[t]
+}
+
+// Workflow is the statuses each transition may start from, a transition that is not listed is not allowed.
+type Workflow map[Transition][]Status
+
+// DefaultWorkflow is the workflow of content types without a workflow of their own: a draft is reviewed
+// and approved before it is published, a rejected or unpublished content goes back to draft.
+// A published content can be unpublished whatever the status of its draft.
+var DefaultWorkflow = Workflow{
+	TransitionSubmit:    {StatusDraft},
+	TransitionApprove:   {StatusInReview},
+	TransitionReject:    {StatusInReview},
+	TransitionPublish:   {StatusApproved},
+	TransitionUnpublish: {StatusPublished, StatusDraft, StatusInReview, StatusApproved},
+}
+
+func (w Workflow) Allows(transition Transition, from Status) bool {
+	for _, status := range w[transition] {
+		if status == from {
+			return true
+		}
+	}
+	return false
+}
+
+// Reaches reports whether the transition is allowed from the status, or from a status the other transitions of the
+// workflow can move the content to from it.
+func (w Workflow) Reaches(transition Transition, from Status) bool {
+	reached := map[Status]bool{from: true}
+	statuses := []Status{from}
+	for len(statuses) > 0 {
+		status := statuses[0]
+		statuses = statuses[1:]
+		if w.Allows(transition, status) {
+			return true
+		}
+		for next := range w {
+			if target := next.Target(); !reached[target] && w.Allows(next, status) {
+				reached[target] = true
+				statuses = append(statuses, target)
+			}
+		}
+	}
+	return false
+}
+
+// Workflows are the workflows of content types. Content types are case-insensitive.
+type Workflows map[string]Workflow
+
+// NewWorkflows builds the workflows of content types from the statuses each of their transitions may start from.
+func NewWorkflows(workflows map[string]map[string][]string) (Workflows, error) {
+	contentTypeWorkflows := make(Workflows, len(workflows))
+	for contentType, transitions := range workflows {
+		workflow := make(Workflow, len(transitions))
+		for transition, statuses := range transitions {
+			if _, ok := transitionTargets[Transition(transition)]; !ok {
+				return nil, errors.Errorf("unknown transition: %s, contentType: %s", transition, contentType)
+			}
+			for _, status := range statuses {
+				from, err := ParseStatus(status)
+				if err != nil {
+					return nil, errors.Wrapf(err, "transition: %s, contentType: %s", transition, contentType)
+				}
+				workflow[Transition(transition)] = append(workflow[Transition(transition)], from)
+			}
+		}
+		contentTypeWorkflows[strings.ToLower(contentType)] = workflow
+	}
+	return contentTypeWorkflows, nil
+}
+
+// Of returns the workflow of the content type, DefaultWorkflow when it has none of its own.
+func (w Workflows) Of(contentType string) Workflow {
+	if workflow, ok := w[strings.ToLower(contentType)]; ok {
+		return workflow
+	}
+	return DefaultWorkflow
+}
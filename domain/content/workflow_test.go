@@ -0,0 +1,41 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflow_Reaches(t *testing.T) {
+	t.Run("다른 전이를 거쳐 도달할 수 있는 전이를 허용한다", func(t *testing.T) {
+		// when
+		fromDraft := DefaultWorkflow.Reaches(TransitionPublish, StatusDraft)
+		fromApproved := DefaultWorkflow.Reaches(TransitionPublish, StatusApproved)
+
+		// then
+		assert.True(t, fromDraft)
+		assert.True(t, fromApproved)
+	})
+
+	t.Run("워크플로에 없는 전이에는 도달할 수 없다", func(t *testing.T) {
+		// given
+		workflow := Workflow{TransitionSubmit: {StatusDraft}, TransitionApprove: {StatusInReview}}
+
+		// when
+		actual := workflow.Reaches(TransitionPublish, StatusDraft)
+
+		// then
+		assert.False(t, actual)
+	})
+
+	t.Run("현재 상태에서 나아갈 수 없는 전이에는 도달할 수 없다", func(t *testing.T) {
+		// given
+		workflow := Workflow{TransitionSubmit: {StatusDraft}, TransitionPublish: {StatusApproved}}
+
+		// when
+		actual := workflow.Reaches(TransitionPublish, StatusDraft)
+
+		// then
+		assert.False(t, actual)
+	})
+}
@@ -0,0 +1,111 @@
+package notification
+
+import (
+	"contentgit/domain/content"
+	"contentgit/domain/content/events"
+	"contentgit/domain/notification/projections"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// NotificationEventHandler fills the inboxes of the users from the content events, ignoring the events notifying nobody.
+// The users a comment mentions are notified once per comment, when it is added or when an edit mentions them.
+type NotificationEventHandler struct {
+	serializer                       eventsourcing.Serializer
+	contentProjectionRepository      content.ContentProjectionRepository
+	notificationProjectionRepository NotificationProjectionRepository
+}
+
+func NewNotificationEventHandler(serializer eventsourcing.Serializer, contentProjectionRepository content.ContentProjectionRepository,
+	notificationProjectionRepository NotificationProjectionRepository) *NotificationEventHandler {
+	return &NotificationEventHandler{serializer: serializer, contentProjectionRepository: contentProjectionRepository,
+		notificationProjectionRepository: notificationProjectionRepository}
+}
+
+func (c *NotificationEventHandler) Handle(ctx context.Context, esEvent eventsourcing.Event) error {
+	// only the events notifying users are deserialized
+	switch esEvent.GetEventType() {
+	case events.FieldCommentAddedEventType, events.FieldCommentEditedEventType, events.FieldCommentDeletedEventType:
+	default:
+		return nil
+	}
+
+	deserializedEvent, err := c.serializer.DeserializeEvent(ctx, esEvent)
+	if err != nil {
+		return errors.Wrapf(err, "serializer.DeserializeEvent aggregateID: %s, type: %s", esEvent.GetAggregateID(), esEvent.GetEventType())
+	}
+
+	switch event := deserializedEvent.(type) {
+	case *events.FieldCommentAddedEventV1:
+		commentId := content.FieldCommentId(event.CommentId, esEvent.Version)
+		return c.notifyMentionedUsers(ctx, esEvent, event.FieldName, commentId, event.Comment, event.CreatedById, event.CreatedByName)
+	case *events.FieldCommentEditedEventV1:
+		return c.onFieldCommentEdited(ctx, esEvent, event)
+	case *events.FieldCommentDeletedEventV1:
+		return c.onFieldCommentDeleted(ctx, esEvent, event)
+	default:
+		return nil
+	}
+}
+
+func (c *NotificationEventHandler) GetAggregateType() eventsourcing.AggregateType {
+	return content.ContentAggregateType
+}
+
+// notifyMentionedUsers notifies every user the comment mentions except its author, a user already notified of the comment is not notified again.
+func (c *NotificationEventHandler) notifyMentionedUsers(ctx context.Context, esEvent eventsourcing.Event, fieldName string, commentId string,
+	comment string, createdById string, createdByName string) error {
+	userIds := make([]string, 0)
+	for _, userId := range content.ParseMentions(comment) {
+		if userId != createdById {
+			userIds = append(userIds, userId)
+		}
+	}
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	contentProjection, err := c.contentProjectionRepository.FindByIDIncludingDeleted(ctx, esEvent.TenantId, esEvent.AggregateID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find content projection")
+	}
+
+	for _, userId := range userIds {
+		notificationProjection := projections.NewNotificationProjection(
+			esEvent.TenantId,
+			userId,
+			esEvent.AggregateID,
+			uint(esEvent.Version),
+			contentProjection.ContentType,
+			fieldName,
+			commentId,
+			comment,
+			createdById,
+			createdByName,
+			esEvent.GetCreatedAt(),
+		)
+
+		if err := c.notificationProjectionRepository.Create(ctx, notificationProjection); err != nil {
+			return errors.Wrap(err, "failed to create notification projection")
+		}
+	}
+	return nil
+}
+
+// onFieldCommentEdited shows the edited text in the notifications of the comment and notifies the users the edit mentions first.
+func (c *NotificationEventHandler) onFieldCommentEdited(ctx context.Context, esEvent eventsourcing.Event, event *events.FieldCommentEditedEventV1) error {
+	if err := c.notificationProjectionRepository.UpdateCommentByCommentId(ctx, esEvent.TenantId, esEvent.AggregateID, event.CommentId, event.Comment); err != nil {
+		return errors.Wrap(err, "failed to update notification projections")
+	}
+	return c.notifyMentionedUsers(ctx, esEvent, event.FieldName, event.CommentId, event.Comment, event.CreatedById, event.CreatedByName)
+}
+
+// onFieldCommentDeleted takes the notifications of a deleted comment out of the inboxes, they would only show its text.
+func (c *NotificationEventHandler) onFieldCommentDeleted(ctx context.Context, esEvent eventsourcing.Event, event *events.FieldCommentDeletedEventV1) error {
+	if err := c.notificationProjectionRepository.DeleteAllByCommentId(ctx, esEvent.TenantId, esEvent.AggregateID, event.CommentId); err != nil {
+		return errors.Wrap(err, "failed to delete notification projections")
+	}
+	return nil
+}
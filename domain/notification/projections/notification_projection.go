@@ -0,0 +1,52 @@
+package projections
+
+import "time"
+
+// NotificationProjection is a notification in the inbox of a user about a comment on a field of a content mentioning them.
+type NotificationProjection struct {
+	Id              uint       `gorm:"primarykey"`
+	TenantId        string     `gorm:"type:varchar(100);not null;index:idx_notifications_inbox"`
+	UserId          string     `gorm:"type:varchar(100);not null;index:idx_notifications_inbox;uniqueIndex:idx_notifications_mention"`
+	ContentId       string     `gorm:"type:varchar(100);not null;uniqueIndex:idx_notifications_mention"`
+	ContentVersion  uint       `gorm:"not null"` // ContentVersion is the version of the comment event that mentioned the user.
+	ContentType     string     `gorm:"type:varchar(100)"`
+	FieldName       string     `gorm:"not null"`
+	CommentId       string     `gorm:"type:varchar(100);index;uniqueIndex:idx_notifications_mention"`
+	Comment         string     `gorm:"type:text"`
+	MentionedById   string     `gorm:"type:varchar(100)"`
+	MentionedByName string     `gorm:"type:varchar(100)"`
+	ReadAt          *time.Time `gorm:"index:idx_notifications_inbox"`
+	CreatedAt       time.Time
+}
+
+func NewNotificationProjection(tenantId string, userId string, contentId string, contentVersion uint, contentType string,
+	fieldName string, commentId string, comment string, mentionedById string, mentionedByName string, createdAt time.Time) NotificationProjection {
+	return NotificationProjection{
+		TenantId:        tenantId,
+		UserId:          userId,
+		ContentId:       contentId,
+		ContentVersion:  contentVersion,
+		ContentType:     contentType,
+		FieldName:       fieldName,
+		CommentId:       commentId,
+		Comment:         comment,
+		MentionedById:   mentionedById,
+		MentionedByName: mentionedByName,
+		CreatedAt:       createdAt,
+	}
+}
+
+func (*NotificationProjection) TableName() string {
+	return "notifications"
+}
+
+func (e *NotificationProjection) IsRead() bool {
+	return e.ReadAt != nil
+}
+
+// MarkAsRead keeps the time the notification was first read.
+func (e *NotificationProjection) MarkAsRead(readAt time.Time) {
+	if e.ReadAt == nil {
+		e.ReadAt = &readAt
+	}
+}
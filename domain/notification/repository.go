@@ -0,0 +1,21 @@
+package notification
+
+import (
+	"contentgit/domain/notification/projections"
+	"contentgit/dtos"
+	"context"
+	"time"
+)
+
+// NotificationProjectionRepository stores the inboxes of the users.
+type NotificationProjectionRepository interface {
+	// Create ignores a notification of a user already notified of the comment, by an earlier event or by the same event
+	// delivered again when its handling failed.
+	Create(ctx context.Context, projection projections.NotificationProjection) error
+	FindByID(ctx context.Context, tenantId string, userId string, id uint) (*projections.NotificationProjection, error)
+	FindAllByUserId(ctx context.Context, tenantId string, userId string, unreadOnly bool, pageable dtos.Pageable) ([]projections.NotificationProjection, int64, error)
+	Save(ctx context.Context, projection *projections.NotificationProjection) error
+	MarkAllAsRead(ctx context.Context, tenantId string, userId string, readAt time.Time) error
+	UpdateCommentByCommentId(ctx context.Context, tenantId string, contentId string, commentId string, comment string) error
+	DeleteAllByCommentId(ctx context.Context, tenantId string, contentId string, commentId string) error
+}
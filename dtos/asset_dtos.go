@@ -0,0 +1,35 @@
+package dtos
+
+import (
+	"mime/multipart"
+	"time"
+)
+
+// AssetUpload is the multipart form to upload an asset, File is its bytes.
+type AssetUpload struct {
+	File          *multipart.FileHeader `form:"file" binding:"required"`
+	CreatedById   string                `form:"createdById" binding:"required"`
+	CreatedByName string                `form:"createdByName" binding:"required"`
+}
+
+type AssetCreated struct {
+	Id string `json:"id"`
+}
+
+// Asset is the metadata of an asset, its bytes are downloaded from /assets/:id/content.
+type Asset struct {
+	Id            string     `json:"id"`
+	FileName      string     `json:"fileName"`
+	MediaType     string     `json:"mediaType"`
+	Size          int64      `json:"size"`
+	Sha256        string     `json:"sha256"`
+	CreatedById   string     `json:"createdById"`
+	CreatedByName string     `json:"createdByName"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	DeletedAt     *time.Time `json:"deletedAt,omitempty"`
+}
+
+type AssetDelete struct {
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
@@ -0,0 +1,82 @@
+package dtos
+
+import "time"
+
+type ChangeRequestCreate struct {
+	Title         string                     `json:"title" binding:"required"`
+	Description   string                     `json:"description"`
+	Changes       []ContentCommitFieldChange `json:"changes" binding:"required,min=1,dive"`
+	Reviewers     []ChangeRequestReviewer    `json:"reviewers" binding:"required,min=1,dive"`
+	CreatedById   string                     `json:"createdById" binding:"required"`
+	CreatedByName string                     `json:"createdByName" binding:"required"`
+}
+
+type ChangeRequestReviewer struct {
+	Id   string `json:"id" binding:"required"`
+	Name string `json:"name"`
+}
+
+type ChangeRequestCreated struct {
+	Id string `json:"id"`
+}
+
+// ChangeRequestCommentCreate comments on the change request, or on its change of Field when it is set.
+type ChangeRequestCommentCreate struct {
+	Field         string `json:"field"`
+	Comment       string `json:"comment" binding:"required"`
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+// ChangeRequestReviewCreate is the decision of a reviewer, approve or reject. Comment is required to reject.
+type ChangeRequestReviewCreate struct {
+	Decision     string `json:"decision" binding:"required"`
+	Comment      string `json:"comment"`
+	ReviewerId   string `json:"reviewerId" binding:"required"`
+	ReviewerName string `json:"reviewerName" binding:"required"`
+}
+
+type ChangeRequestMerge struct {
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+type ChangeRequestClose struct {
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+type ChangeRequest struct {
+	Id             string                     `json:"id"`
+	ContentId      string                     `json:"contentId"`
+	Title          string                     `json:"title"`
+	Description    string                     `json:"description,omitempty"`
+	Changes        []ContentCommitFieldChange `json:"changes"`
+	Reviewers      []ChangeRequestReviewer    `json:"reviewers"`
+	State          string                     `json:"state"`
+	CommitId       string                     `json:"commitId,omitempty"`
+	ContentVersion *uint                      `json:"contentVersion,omitempty"`
+	Reviews        []ChangeRequestReview      `json:"reviews,omitempty"`
+	Comments       []ChangeRequestComment     `json:"comments,omitempty"`
+	Version        uint                       `json:"version"`
+	CreatedById    string                     `json:"createdById"`
+	CreatedByName  string                     `json:"createdByName"`
+	CreatedAt      time.Time                  `json:"createdAt"`
+	ClosedAt       *time.Time                 `json:"closedAt,omitempty"`
+}
+
+type ChangeRequestReview struct {
+	Decision     string    `json:"decision"`
+	Comment      string    `json:"comment,omitempty"`
+	ReviewerId   string    `json:"reviewerId"`
+	ReviewerName string    `json:"reviewerName"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type ChangeRequestComment struct {
+	Field         string    `json:"field,omitempty"`
+	Comment       string    `json:"comment"`
+	CreatedById   string    `json:"createdById"`
+	CreatedByName string    `json:"createdByName"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
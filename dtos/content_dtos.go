@@ -3,13 +3,31 @@ package dtos
 import "time"
 
 type ContentDetails struct {
-	Id            string                       `json:"id"`
-	Content       map[string]any               `json:"content"`
-	ContentType   string                       `json:"contentType"`
-	FieldChanges  []ContentDetailsFieldChange  `json:"fieldChanges"`
-	FieldComments []ContentDetailsFieldComment `json:"fieldComments"`
-	CreatedAt     time.Time                    `json:"createdAt"`
-	UpdatedAt     time.Time                    `json:"updatedAt"`
+	Id               string                       `json:"id"`
+	Content          map[string]any               `json:"content"`
+	ContentType      string                       `json:"contentType"`
+	FieldChanges     []ContentDetailsFieldChange  `json:"fieldChanges"`
+	FieldComments    []ContentDetailsFieldComment `json:"fieldComments"`
+	CreatedAt        time.Time                    `json:"createdAt"`
+	UpdatedAt        time.Time                    `json:"updatedAt"`
+	Status           string                       `json:"status"`
+	StatusReason     string                       `json:"statusReason,omitempty"`
+	PublishedVersion *uint                        `json:"publishedVersion,omitempty"`
+	DeletedAt        *time.Time                   `json:"deletedAt,omitempty"`
+	PurgedAt         *time.Time                   `json:"purgedAt,omitempty"`
+	// Locale is the locale the localized fields were read in, FieldLocales the locale each of them fell back to.
+	Locale             string                      `json:"locale,omitempty"`
+	FieldLocales       map[string]string           `json:"fieldLocales,omitempty"`
+	LocaleCompleteness []ContentLocaleCompleteness `json:"localeCompleteness,omitempty"`
+}
+
+// ContentLocaleCompleteness is how many of the localized fields of a content are translated to a locale.
+type ContentLocaleCompleteness struct {
+	Locale     string   `json:"locale"`
+	Translated int      `json:"translated"`
+	Total      int      `json:"total"`
+	Complete   bool     `json:"complete"`
+	Missing    []string `json:"missing"`
 }
 
 type ContentDetailsFieldChange struct {
@@ -31,12 +49,41 @@ type ContentDetailsFieldComment struct {
 	Comments []ContentDetailsComment `json:"comments"`
 }
 
+// ContentDetailsComment is a comment with its replies. CommentId addresses it in the field-comments endpoints,
+// Resolved is set only on the comment starting a thread.
 type ContentDetailsComment struct {
-	Id            uint      `json:"id"`
-	Comment       string    `json:"comment"`
-	CreatedAt     time.Time `json:"createdAt"`
-	CreatedById   string    `json:"createdById"`
-	CreatedByName string    `json:"createdByName"`
+	Id             uint                    `json:"id"`
+	CommentId      string                  `json:"commentId"`
+	Comment        string                  `json:"comment"`
+	CreatedAt      time.Time               `json:"createdAt"`
+	CreatedById    string                  `json:"createdById"`
+	CreatedByName  string                  `json:"createdByName"`
+	EditedAt       *time.Time              `json:"editedAt,omitempty"`
+	Deleted        bool                    `json:"deleted,omitempty"`
+	Resolved       *bool                   `json:"resolved,omitempty"`
+	ResolvedById   string                  `json:"resolvedById,omitempty"`
+	ResolvedByName string                  `json:"resolvedByName,omitempty"`
+	ResolvedAt     *time.Time              `json:"resolvedAt,omitempty"`
+	Replies        []ContentDetailsComment `json:"replies,omitempty"`
+}
+
+type ContentVersionDetails struct {
+	Id            string                       `json:"id"`
+	Content       map[string]any               `json:"content"`
+	ContentType   string                       `json:"contentType"`
+	Version       uint64                       `json:"version"`
+	FieldComments []ContentVersionFieldComment `json:"fieldComments"`
+}
+
+type ContentVersionFieldComment struct {
+	Field    string                  `json:"field"`
+	Comments []ContentVersionComment `json:"comments"`
+}
+
+type ContentVersionComment struct {
+	Comment       string `json:"comment"`
+	CreatedById   string `json:"createdById"`
+	CreatedByName string `json:"createdByName"`
 }
 
 type ContentSummary struct {
@@ -45,6 +92,21 @@ type ContentSummary struct {
 	ContentType string         `json:"contentType"`
 	CreatedAt   time.Time      `json:"createdAt"`
 	UpdatedAt   time.Time      `json:"updatedAt"`
+	Status      string         `json:"status"`
+	DeletedAt   *time.Time     `json:"deletedAt,omitempty"`
+	PurgedAt    *time.Time     `json:"purgedAt,omitempty"`
+}
+
+// PublishedContent is the published version of a content as readers see it.
+type PublishedContent struct {
+	Id          string         `json:"id"`
+	Content     map[string]any `json:"content"`
+	ContentType string         `json:"contentType"`
+	Version     uint           `json:"version"`
+	PublishedAt time.Time      `json:"publishedAt"`
+	// Locale is the locale the localized fields were read in, FieldLocales the locale each of them fell back to.
+	Locale       string            `json:"locale,omitempty"`
+	FieldLocales map[string]string `json:"fieldLocales,omitempty"`
 }
 
 type ContentUpdateField struct {
@@ -54,8 +116,272 @@ type ContentUpdateField struct {
 	CreatedByName string `json:"createdByName" binding:"required"`
 }
 
+// ContentLocalizedFieldUpdate updates the value of a localized field in a locale, a null value is a missing translation.
+type ContentLocalizedFieldUpdate struct {
+	BeforeValue   any    `json:"beforeValue"`
+	AfterValue    any    `json:"afterValue"`
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
 type ContentFieldComment struct {
 	Comment       string `json:"comment" binding:"required"`
 	CreatedById   string `json:"createdById" binding:"required"`
 	CreatedByName string `json:"createdByName" binding:"required"`
 }
+
+type ContentFieldCommentCreated struct {
+	Id string `json:"id"`
+}
+
+// ContentFieldCommentChange is the request body to delete a comment or to resolve or reopen its thread.
+type ContentFieldCommentChange struct {
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+type ContentBranchCreate struct {
+	Name          string `json:"name" binding:"required"`
+	SourceBranch  string `json:"sourceBranch"`
+	SourceVersion uint64 `json:"sourceVersion"`
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+type ContentBranchSummary struct {
+	Name          string    `json:"name"`
+	SourceBranch  string    `json:"sourceBranch,omitempty"`
+	SourceVersion uint      `json:"sourceVersion,omitempty"`
+	Version       uint      `json:"version"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+type ContentBranchDetails struct {
+	Id            string         `json:"id"`
+	Branch        string         `json:"branch"`
+	Content       map[string]any `json:"content"`
+	ContentType   string         `json:"contentType"`
+	SourceBranch  string         `json:"sourceBranch"`
+	SourceVersion uint           `json:"sourceVersion"`
+	Version       uint           `json:"version"`
+	CreatedById   string         `json:"createdById"`
+	CreatedByName string         `json:"createdByName"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	UpdatedAt     time.Time      `json:"updatedAt"`
+}
+
+type ContentMerge struct {
+	Into          string `json:"into"`
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+// ContentMergeResolve resolves the conflicts of a merge, a conflicting field is set to its value in Resolutions
+// or removed when it is listed in Removals.
+type ContentMergeResolve struct {
+	Into          string         `json:"into"`
+	SourceVersion uint64         `json:"sourceVersion" binding:"required"`
+	TargetVersion uint64         `json:"targetVersion" binding:"required"`
+	Resolutions   map[string]any `json:"resolutions" binding:"required"`
+	Removals      []string       `json:"removals"`
+	CreatedById   string         `json:"createdById" binding:"required"`
+	CreatedByName string         `json:"createdByName" binding:"required"`
+}
+
+type ContentMergePreview struct {
+	SourceBranch  string                      `json:"sourceBranch"`
+	SourceVersion uint64                      `json:"sourceVersion"`
+	TargetBranch  string                      `json:"targetBranch"`
+	TargetVersion uint64                      `json:"targetVersion"`
+	BaseBranch    string                      `json:"baseBranch"`
+	BaseVersion   uint64                      `json:"baseVersion"`
+	Fields        []ContentMergeField         `json:"fields"`
+	Conflicts     []ContentMergeFieldConflict `json:"conflicts"`
+}
+
+type ContentMergeField struct {
+	Field       string `json:"field"`
+	BeforeValue any    `json:"beforeValue"`
+	AfterValue  any    `json:"afterValue"`
+	Removed     bool   `json:"removed,omitempty"`
+}
+
+type ContentMergeFieldConflict struct {
+	Field         string `json:"field"`
+	BaseValue     any    `json:"baseValue"`
+	SourceValue   any    `json:"sourceValue"`
+	TargetValue   any    `json:"targetValue"`
+	SourceRemoved bool   `json:"sourceRemoved,omitempty"`
+	TargetRemoved bool   `json:"targetRemoved,omitempty"`
+}
+
+type ContentMergeConflicts struct {
+	Conflicts []ContentMergeFieldConflict `json:"conflicts"`
+}
+
+type ContentDiff struct {
+	From    uint64                `json:"from"`
+	To      uint64                `json:"to"`
+	Added   []ContentDiffField    `json:"added"`
+	Removed []ContentDiffField    `json:"removed"`
+	Changed []ContentChangedField `json:"changed"`
+}
+
+type ContentDiffField struct {
+	Field string `json:"field"`
+	Value any    `json:"value"`
+}
+
+type ContentChangedField struct {
+	Field       string `json:"field"`
+	BeforeValue any    `json:"beforeValue"`
+	AfterValue  any    `json:"afterValue"`
+}
+
+// ContentPatchOperation is a RFC 6902 operation, Value is a pointer so that a null value is kept while remove has none.
+type ContentPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value *any   `json:"value,omitempty"`
+}
+
+type ContentRevert struct {
+	Version       uint64 `json:"version" binding:"required"`
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+type ContentCommitCreate struct {
+	Message       string                     `json:"message" binding:"required"`
+	ParentVersion uint64                     `json:"parentVersion" binding:"required"`
+	Fields        []ContentCommitFieldChange `json:"fields" binding:"required,min=1,dive"`
+	CreatedById   string                     `json:"createdById" binding:"required"`
+	CreatedByName string                     `json:"createdByName" binding:"required"`
+}
+
+type ContentCommitFieldChange struct {
+	Field       string `json:"field" binding:"required"`
+	BeforeValue any    `json:"beforeValue"`
+	AfterValue  any    `json:"afterValue"`
+}
+
+type ContentCommitCreated struct {
+	Id string `json:"id"`
+}
+
+type ContentCommit struct {
+	Id            string                     `json:"id"`
+	Branch        string                     `json:"branch"`
+	Version       uint                       `json:"version"`
+	ParentVersion uint                       `json:"parentVersion"`
+	Message       string                     `json:"message"`
+	Fields        []ContentCommitFieldChange `json:"fields"`
+	CreatedById   string                     `json:"createdById"`
+	CreatedByName string                     `json:"createdByName"`
+	CreatedAt     time.Time                  `json:"createdAt"`
+}
+
+type ContentTagCreate struct {
+	Name          string `json:"name" binding:"required"`
+	Branch        string `json:"branch"`
+	Version       uint64 `json:"version"`
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+type ContentTag struct {
+	Name          string    `json:"name"`
+	ContentId     string    `json:"contentId"`
+	Branch        string    `json:"branch"`
+	Version       uint64    `json:"version"`
+	CreatedById   string    `json:"createdById"`
+	CreatedByName string    `json:"createdByName"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// ContentReference is a reference of a field of another content to a content.
+type ContentReference struct {
+	ContentId   string    `json:"contentId"`
+	ContentType string    `json:"contentType"`
+	Field       string    `json:"field"`
+	Version     uint      `json:"version"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// ContentScheduleCreate schedules a publish or an unpublish, a publish promotes the content as it was at Version or the
+// current version when it is omitted.
+type ContentScheduleCreate struct {
+	Transition    string    `json:"transition" binding:"required"`
+	Version       uint64    `json:"version"`
+	RunAt         time.Time `json:"runAt" binding:"required"`
+	CreatedById   string    `json:"createdById" binding:"required"`
+	CreatedByName string    `json:"createdByName" binding:"required"`
+}
+
+type ContentScheduleCreated struct {
+	Id string `json:"id"`
+}
+
+type ContentSchedule struct {
+	Id            string     `json:"id"`
+	ContentId     string     `json:"contentId"`
+	Transition    string     `json:"transition"`
+	Version       uint64     `json:"version,omitempty"`
+	RunAt         time.Time  `json:"runAt"`
+	State         string     `json:"state"`
+	Error         string     `json:"error,omitempty"`
+	Attempts      int        `json:"attempts,omitempty"`
+	CreatedById   string     `json:"createdById"`
+	CreatedByName string     `json:"createdByName"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	ExecutedAt    *time.Time `json:"executedAt,omitempty"`
+}
+
+type ContentFieldBlame struct {
+	Field         string    `json:"field"`
+	Version       uint64    `json:"version"`
+	Timestamp     time.Time `json:"timestamp"`
+	CreatedById   string    `json:"createdById"`
+	CreatedByName string    `json:"createdByName"`
+}
+
+type ContentFieldAdd struct {
+	Field         string `json:"field" binding:"required"`
+	Value         any    `json:"value"`
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+type ContentFieldRemove struct {
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+type ContentDelete struct {
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+type ContentRestore struct {
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+// ContentFilter narrows down the contents of a tenant, an empty Status matches every status.
+type ContentFilter struct {
+	Status         string
+	IncludeDeleted bool
+}
+
+// ContentStatusChange is the request body of an editorial transition, Reason is required to reject a content.
+type ContentStatusChange struct {
+	Reason        string `json:"reason"`
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+type ContentPurge struct {
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
@@ -0,0 +1,130 @@
+package dtos
+
+import "time"
+
+// ContentTypeField declares a top-level field of the contents of a content type.
+type ContentTypeField struct {
+	Name          string `json:"name" binding:"required"`
+	Type          string `json:"type" binding:"required"`
+	Required      bool   `json:"required"`
+	Enum          []any  `json:"enum,omitempty"`
+	Pattern       string `json:"pattern,omitempty"`
+	ReferenceType string `json:"referenceType,omitempty"`
+	Localized     bool   `json:"localized,omitempty"`
+}
+
+type ContentTypeCreate struct {
+	Name          string             `json:"name" binding:"required"`
+	Description   string             `json:"description"`
+	Fields        []ContentTypeField `json:"fields" binding:"required,dive"`
+	CreatedById   string             `json:"createdById" binding:"required"`
+	CreatedByName string             `json:"createdByName" binding:"required"`
+}
+
+// ContentTypeMigrationStep declares how a schema migration changes a field of the contents of the previous version.
+type ContentTypeMigrationStep struct {
+	Op        string   `json:"op" binding:"required"`
+	Field     string   `json:"field" binding:"required"`
+	To        string   `json:"to,omitempty"`
+	Into      []string `json:"into,omitempty"`
+	Separator string   `json:"separator,omitempty"`
+	Type      string   `json:"type,omitempty"`
+	Value     any      `json:"value,omitempty"`
+}
+
+// ContentTypeUpdate replaces the schema of a content type with a new version, Migrations bring the existing contents
+// to the new version when a schema migration runs.
+type ContentTypeUpdate struct {
+	Description   string                     `json:"description"`
+	Fields        []ContentTypeField         `json:"fields" binding:"required,dive"`
+	Migrations    []ContentTypeMigrationStep `json:"migrations" binding:"dive"`
+	CreatedById   string                     `json:"createdById" binding:"required"`
+	CreatedByName string                     `json:"createdByName" binding:"required"`
+}
+
+type ContentTypeDelete struct {
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+// ContentTypeVersion is the version of the schema of a content type a change created.
+type ContentTypeVersion struct {
+	Name    string `json:"name"`
+	Version uint64 `json:"version"`
+}
+
+type ContentTypeDetails struct {
+	Name          string                     `json:"name"`
+	Description   string                     `json:"description"`
+	Version       uint64                     `json:"version"`
+	Fields        []ContentTypeField         `json:"fields"`
+	Migrations    []ContentTypeMigrationStep `json:"migrations,omitempty"`
+	Deleted       bool                       `json:"deleted,omitempty"`
+	CreatedById   string                     `json:"createdById"`
+	CreatedByName string                     `json:"createdByName"`
+	CreatedAt     time.Time                  `json:"createdAt"`
+}
+
+// ContentValidationError lists the fields of a content that do not match the schema of its content type,
+// Index is the position of the content in a bulk create.
+type ContentValidationError struct {
+	Index  *int                `json:"index,omitempty"`
+	Errors []ContentFieldError `json:"errors"`
+}
+
+type ContentFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// SchemaMigrationCreate starts a schema migration to ToVersion, the latest version when it is omitted, of the contents
+// following FromVersion, the version before ToVersion when it is omitted. A dry run only reports what it would change.
+type SchemaMigrationCreate struct {
+	FromVersion   uint64 `json:"fromVersion"`
+	ToVersion     uint64 `json:"toVersion"`
+	DryRun        bool   `json:"dryRun"`
+	CreatedById   string `json:"createdById" binding:"required"`
+	CreatedByName string `json:"createdByName" binding:"required"`
+}
+
+type SchemaMigrationCreated struct {
+	Id string `json:"id"`
+}
+
+// SchemaMigration is the progress of a schema migration, Progress is the percentage of the contents it went through.
+type SchemaMigration struct {
+	Id            string                  `json:"id"`
+	ContentType   string                  `json:"contentType"`
+	FromVersion   uint64                  `json:"fromVersion"`
+	ToVersion     uint64                  `json:"toVersion"`
+	DryRun        bool                    `json:"dryRun"`
+	State         string                  `json:"state"`
+	Total         int                     `json:"total"`
+	Processed     int                     `json:"processed"`
+	Migrated      int                     `json:"migrated"`
+	Unchanged     int                     `json:"unchanged"`
+	Skipped       int                     `json:"skipped"`
+	Failed        int                     `json:"failed"`
+	Progress      int                     `json:"progress"`
+	Results       []SchemaMigrationResult `json:"results,omitempty"`
+	Error         string                  `json:"error,omitempty"`
+	CreatedById   string                  `json:"createdById"`
+	CreatedByName string                  `json:"createdByName"`
+	CreatedAt     time.Time               `json:"createdAt"`
+	StartedAt     *time.Time              `json:"startedAt,omitempty"`
+	CompletedAt   *time.Time              `json:"completedAt,omitempty"`
+}
+
+type SchemaMigrationResult struct {
+	ContentId string                 `json:"contentId"`
+	Branch    string                 `json:"branch,omitempty"`
+	Outcome   string                 `json:"outcome"`
+	Fields    []SchemaMigrationField `json:"fields,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+}
+
+// SchemaMigrationField is a field a migration changed, Operation is added, removed or changed.
+type SchemaMigrationField struct {
+	Field     string `json:"field"`
+	Operation string `json:"operation"`
+}
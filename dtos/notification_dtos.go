@@ -0,0 +1,18 @@
+package dtos
+
+import "time"
+
+// Notification tells a user that the comment CommentId on the field Field of a content mentioned them.
+type Notification struct {
+	Id              uint       `json:"id"`
+	ContentId       string     `json:"contentId"`
+	ContentType     string     `json:"contentType"`
+	Field           string     `json:"field"`
+	CommentId       string     `json:"commentId"`
+	Comment         string     `json:"comment"`
+	MentionedById   string     `json:"mentionedById"`
+	MentionedByName string     `json:"mentionedByName"`
+	Read            bool       `json:"read"`
+	ReadAt          *time.Time `json:"readAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
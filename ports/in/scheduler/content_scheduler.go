@@ -0,0 +1,117 @@
+package scheduler
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// ContentScheduler runs the scheduled publishes and unpublishes of contents once they are due. Jobs are claimed
+// with a row lock, so that several schedulers never run the same job, and a job is marked done in the transaction
+// that runs its transition, so that it runs once.
+type ContentScheduler struct {
+	contentScheduleRepository content.ContentScheduleRepository
+	changeContentStatus       commands.ChangeContentStatus
+	clock                     content.Clock
+}
+
+func NewContentScheduler(contentScheduleRepository content.ContentScheduleRepository, changeContentStatus commands.ChangeContentStatus,
+	clock content.Clock) *ContentScheduler {
+	return &ContentScheduler{contentScheduleRepository: contentScheduleRepository, changeContentStatus: changeContentStatus, clock: clock}
+}
+
+// Start runs the due jobs every interval until the context is done.
+func (s *ContentScheduler) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := s.RunDue(ctx); err != nil {
+				log.Error(err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunDue runs the jobs due at the current time of the clock, one transaction each, and returns how many it ran.
+// A job whose transition is rejected, for example because the content was changed since it was scheduled,
+// is marked failed. A job that lost a race with an editor records the attempt and stays pending, it is skipped
+// for the rest of the run so that the jobs due after it still run, and is retried on the next run until it used up
+// content.MaxScheduleAttempts.
+func (s *ContentScheduler) RunDue(ctx context.Context) (int, error) {
+	count := 0
+	skipIds := make([]string, 0)
+	for {
+		schedule, err := s.runNext(ctx, skipIds)
+		if err != nil {
+			return count, err
+		}
+		if schedule == nil {
+			return count, nil
+		}
+		if schedule.State == content.ScheduleStatePending {
+			skipIds = append(skipIds, schedule.Id)
+			continue
+		}
+		count++
+	}
+}
+
+// runNext runs the next due job and returns it, none when no job is due.
+func (s *ContentScheduler) runNext(ctx context.Context, skipIds []string) (*content.ContentSchedule, error) {
+	var ranSchedule *content.ContentSchedule
+	err := datasource.TransactionalWithContext(ctx, func(ctx context.Context) error {
+		now := s.clock.Now()
+		schedule, err := s.contentScheduleRepository.ClaimNextDue(ctx, now, skipIds)
+		if err != nil {
+			if errors.Is(err, persistence.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		// the transition runs in a savepoint, a rejected transition leaves nothing behind but the failed job
+		err = foundation.ContextProvider().GetDB(ctx).Transaction(func(tx *gorm.DB) error {
+			return s.changeContentStatus.Handle(foundation.ContextProvider().SetDB(ctx, tx), commands.ChangeContentStatusCommand{
+				AggregateID:   schedule.ContentId,
+				TenantId:      schedule.TenantId,
+				Transition:    schedule.Transition,
+				Version:       schedule.Version,
+				CreatedById:   schedule.CreatedById,
+				CreatedByName: schedule.CreatedByName,
+			})
+		})
+		switch {
+		case err == nil:
+			schedule.Complete(now)
+		case errors.Is(err, eventsourcing.ErrConcurrencyConflict):
+			schedule.Retry(now, err)
+		case schedule.Transition == content.TransitionUnpublish && errors.Is(err, content.ErrContentNotPublished):
+			// already taken offline by hand
+			schedule.Complete(now)
+		default:
+			schedule.Fail(now, err)
+		}
+
+		ranSchedule = schedule
+		return s.contentScheduleRepository.Save(ctx, schedule)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ranSchedule, nil
+}
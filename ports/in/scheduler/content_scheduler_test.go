@@ -0,0 +1,165 @@
+package scheduler_test
+
+import (
+	"contentgit/appservices"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/foundation"
+	"contentgit/ports/in/scheduler"
+	"contentgit/ports/in/web"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"contentgit/testdata/testserver"
+	"contentgit/testdata/testsuite"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// fixedClock is a clock standing still at a time after the jobs of the fixture are due.
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
+// conflictingChangeContentStatus loses every race with an editor.
+type conflictingChangeContentStatus struct {
+}
+
+func (conflictingChangeContentStatus) Handle(ctx context.Context, cmd commands.ChangeContentStatusCommand) error {
+	return eventsourcing.ErrConcurrencyConflict
+}
+
+type ContentSchedulerTestSuite struct {
+	testsuite.BaseDatabaseTestSuite
+}
+
+func TestContentSchedulerTestSuite(t *testing.T) {
+	suite.Run(t, new(ContentSchedulerTestSuite))
+}
+
+func (suite *ContentSchedulerTestSuite) TestRunDue() {
+	// given
+	clock := fixedClock{now: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)}
+	server := testserver.NewTestAppServerBuilder(web.Router{}, suite.TestDbContainer).
+		SetMockComponent("Clock", clock).
+		WithDatabaseFixture().
+		Build()
+	sut := server.GetComponent("ContentScheduler").(*scheduler.ContentScheduler)
+	ctx := foundation.ContextProvider().SetDB(context.Background(), server.GetDB())
+
+	// when
+	_, err := sut.RunDue(ctx)
+	_, rerunErr := sut.RunDue(ctx)
+
+	// then
+	suite.NoError(err)
+	suite.NoError(rerunErr)
+
+	mellowSchedules := suite.getSchedules(server, "mellow", "dresses", "8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34")
+	suite.Equal("done", mellowSchedules[0]["state"])
+	suite.Equal("2100-01-01T09:00:00+09:00", mellowSchedules[0]["executedAt"])
+	suite.Equal("canceled", mellowSchedules[1]["state"])
+	suite.Equal("pending", mellowSchedules[2]["state"])
+
+	yurenSchedules := suite.getSchedules(server, "yuren", "products", "03ab7edb-881b-49f8-848a-3e8266376ffe")
+	suite.Equal("failed", yurenSchedules[0]["state"])
+	suite.Contains(yurenSchedules[0]["error"], content.ErrTransitionNotAllowed.Error())
+
+	contentAggregate, _ := content.NewContentAggregate("8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34", "mellow")
+	suite.NoError(server.GetComponent("ContentAggregateStore").(eventsourcing.AggregateStore).Load(ctx, contentAggregate))
+	suite.Equal(uint64(6), contentAggregate.GetVersion())
+	suite.Zero(contentAggregate.PublishedVersion)
+}
+
+func (suite *ContentSchedulerTestSuite) TestRunDue_승인_전에_예약한_게시는_승인된_뒤에_실행된다() {
+	// given
+	clock := fixedClock{now: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)}
+	server := testserver.NewTestAppServerBuilder(web.Router{}, suite.TestDbContainer).
+		SetMockComponent("Clock", fixedClock{now: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)}).
+		WithDatabaseFixture().
+		Build()
+	ctx := foundation.ContextProvider().SetDB(context.Background(), server.GetDB())
+
+	contentPath := "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465"
+	suite.post(server, contentPath+"/schedules", `{
+			"transition": "publish",
+			"runAt": "2099-11-01T09:00:00+09:00",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`, http.StatusCreated)
+	suite.post(server, contentPath+"/submit", `{"createdById": "1", "createdByName": "사이트 관리자"}`, http.StatusNoContent)
+	suite.post(server, contentPath+"/approve", `{"createdById": "2", "createdByName": "검토자"}`, http.StatusNoContent)
+
+	sut := scheduler.NewContentScheduler(server.GetComponent("ContentScheduleRepository").(content.ContentScheduleRepository),
+		server.GetComponent("ContentService").(*appservices.ContentService).Commands.ChangeContentStatus, clock)
+
+	// when
+	_, err := sut.RunDue(ctx)
+
+	// then
+	suite.NoError(err)
+
+	schedules := suite.getSchedules(server, "bettercode", "products", "074c7322-e7fa-4d5c-8938-8dbe0ce67465")
+	suite.Equal("done", schedules[0]["state"])
+
+	contentAggregate, _ := content.NewContentAggregate("074c7322-e7fa-4d5c-8938-8dbe0ce67465", "bettercode")
+	suite.NoError(server.GetComponent("ContentAggregateStore").(eventsourcing.AggregateStore).Load(ctx, contentAggregate))
+	suite.Equal(content.StatusPublished, contentAggregate.CurrentStatus())
+}
+
+func (suite *ContentSchedulerTestSuite) TestRunDue_계속_충돌하는_예약은_시도를_다_쓰면_실패한다() {
+	// given
+	clock := fixedClock{now: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)}
+	server := testserver.NewTestAppServerBuilder(web.Router{}, suite.TestDbContainer).
+		SetMockComponent("Clock", clock).
+		WithDatabaseFixture().
+		Build()
+	sut := scheduler.NewContentScheduler(server.GetComponent("ContentScheduleRepository").(content.ContentScheduleRepository),
+		conflictingChangeContentStatus{}, clock)
+	ctx := foundation.ContextProvider().SetDB(context.Background(), server.GetDB())
+
+	// when
+	for i := 1; i < content.MaxScheduleAttempts; i++ {
+		_, err := sut.RunDue(ctx)
+		suite.NoError(err)
+	}
+	pendingSchedules := suite.getSchedules(server, "mellow", "dresses", "8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34")
+	_, err := sut.RunDue(ctx)
+
+	// then
+	suite.NoError(err)
+	suite.Equal("pending", pendingSchedules[0]["state"])
+
+	mellowSchedules := suite.getSchedules(server, "mellow", "dresses", "8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34")
+	suite.Equal("failed", mellowSchedules[0]["state"])
+	suite.Equal(float64(content.MaxScheduleAttempts), mellowSchedules[0]["attempts"])
+	suite.Equal("2100-01-01T09:00:00+09:00", mellowSchedules[0]["executedAt"])
+	suite.Contains(mellowSchedules[0]["error"], eventsourcing.ErrConcurrencyConflict.Error())
+}
+
+func (suite *ContentSchedulerTestSuite) post(server *testserver.TestAppServer, path string, requestBody string, expectedCode int) {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	suite.Equal(expectedCode, rec.Code, rec.Body.String())
+}
+
+func (suite *ContentSchedulerTestSuite) getSchedules(server *testserver.TestAppServer, tenantId string, contentType string, id string) []map[string]any {
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/"+tenantId+"/"+contentType+"/contents/"+id+"/schedules", nil)
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var schedules []map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &schedules)
+	return schedules
+}
@@ -0,0 +1,185 @@
+package scheduler
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/domain/content/events"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// schemaMigrationBatchSize is how many contents a schema migration goes through in one transaction.
+const schemaMigrationBatchSize = 100
+
+// SchemaMigrationRunner runs the schema migrations of content types in the background, one batch of contents per
+// transaction. A migration is claimed with a row lock, so that several runners never run the same batch, and its
+// cursor is saved in the transaction that migrates the batch, so that a migration interrupted by a crash resumes
+// after the last batch that was committed. The contents are read from their event streams rather than from their
+// projections, which may not have caught up, and only their default branch is migrated, the other branches are reported.
+type SchemaMigrationRunner struct {
+	schemaMigrationRepository   content.SchemaMigrationRepository
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository
+	contentStreamRepository     content.ContentStreamRepository
+	migrateContent              commands.MigrateContent
+	clock                       content.Clock
+}
+
+func NewSchemaMigrationRunner(schemaMigrationRepository content.SchemaMigrationRepository,
+	contentTypeSchemaRepository content.ContentTypeSchemaRepository, contentStreamRepository content.ContentStreamRepository,
+	migrateContent commands.MigrateContent, clock content.Clock) *SchemaMigrationRunner {
+	return &SchemaMigrationRunner{
+		schemaMigrationRepository:   schemaMigrationRepository,
+		contentTypeSchemaRepository: contentTypeSchemaRepository,
+		contentStreamRepository:     contentStreamRepository,
+		migrateContent:              migrateContent,
+		clock:                       clock,
+	}
+}
+
+// Start runs the pending and running migrations every interval until the context is done.
+func (r *SchemaMigrationRunner) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := r.RunActive(ctx); err != nil {
+				log.Error(err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunActive runs the pending and running migrations batch by batch until none is left and returns how many batches
+// it ran. A batch that lost a race with an editor is rolled back and run again on the next run.
+func (r *SchemaMigrationRunner) RunActive(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		ran, err := r.runNextBatch(ctx)
+		if err != nil {
+			return count, err
+		}
+		if !ran {
+			return count, nil
+		}
+		count++
+	}
+}
+
+func (r *SchemaMigrationRunner) runNextBatch(ctx context.Context) (bool, error) {
+	ran := false
+	err := datasource.TransactionalWithContext(ctx, func(ctx context.Context) error {
+		migration, err := r.schemaMigrationRepository.ClaimNextActive(ctx)
+		if err != nil {
+			if errors.Is(err, persistence.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		ran = true
+
+		now := r.clock.Now()
+		schemas, err := r.findSchemas(ctx, migration)
+		if err != nil {
+			if !errors.Is(err, content.ErrContentTypeNotFound) {
+				return err
+			}
+			migration.Fail(now, err)
+			return r.schemaMigrationRepository.Save(ctx, migration)
+		}
+
+		if migration.State == content.SchemaMigrationStatePending {
+			total, err := r.contentStreamRepository.CountByContentType(ctx, migration.TenantId, migration.ContentType)
+			if err != nil {
+				return err
+			}
+			migration.Start(now, int(total))
+		}
+
+		contentIds, err := r.contentStreamRepository.FindIdsByContentType(ctx, migration.TenantId, migration.ContentType,
+			migration.Cursor, schemaMigrationBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(contentIds) == 0 {
+			migration.Complete(now)
+			return r.schemaMigrationRepository.Save(ctx, migration)
+		}
+
+		for _, contentId := range contentIds {
+			fields, err := r.migrate(ctx, migration, schemas, contentId)
+			if errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+				return errors.Wrapf(err, "migration: %s, content: %s", migration.Id, contentId)
+			}
+			if err := migration.Record(contentId, fields, err); err != nil {
+				migration.Fail(now, errors.Wrapf(err, "content: %s", contentId))
+				break
+			}
+
+			branches, err := r.contentStreamRepository.FindBranches(ctx, migration.TenantId, contentId)
+			if err != nil {
+				return err
+			}
+			migration.RecordSkippedBranches(contentId, branches)
+		}
+
+		return r.schemaMigrationRepository.Save(ctx, migration)
+	})
+
+	return ran, err
+}
+
+// migrate migrates a content in a savepoint, a content that cannot be migrated leaves nothing behind.
+func (r *SchemaMigrationRunner) migrate(ctx context.Context, migration *content.SchemaMigration, schemas []content.ContentTypeSchema,
+	contentId string) ([]events.MigratedField, error) {
+	var fields []events.MigratedField
+	err := foundation.ContextProvider().GetDB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		fields, err = r.migrateContent.Handle(foundation.ContextProvider().SetDB(ctx, tx), commands.MigrateContentCommand{
+			AggregateID:   contentId,
+			TenantId:      migration.TenantId,
+			MigrationId:   migration.Id,
+			Schemas:       schemas,
+			DryRun:        migration.DryRun,
+			CreatedById:   migration.CreatedById,
+			CreatedByName: migration.CreatedByName,
+		})
+		return err
+	})
+	return fields, err
+}
+
+// findSchemas returns the versions of the schema following FromVersion up to ToVersion of the migration.
+func (r *SchemaMigrationRunner) findSchemas(ctx context.Context, migration *content.SchemaMigration) ([]content.ContentTypeSchema, error) {
+	versions, err := r.contentTypeSchemaRepository.FindAllVersions(ctx, migration.TenantId, migration.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make([]content.ContentTypeSchema, 0, migration.ToVersion-migration.FromVersion)
+	for _, schema := range versions {
+		if schema.Version <= migration.FromVersion || schema.Version > migration.ToVersion {
+			continue
+		}
+		if schema.Deleted {
+			return nil, errors.Wrapf(content.ErrContentTypeNotFound, "content type: %s was deleted at version %d", migration.ContentType, schema.Version)
+		}
+		schemas = append(schemas, schema)
+	}
+	if uint64(len(schemas)) != migration.ToVersion-migration.FromVersion {
+		return nil, errors.Wrapf(content.ErrContentTypeNotFound, "content type: %s, version: %d", migration.ContentType, migration.ToVersion)
+	}
+
+	return schemas, nil
+}
@@ -0,0 +1,208 @@
+package scheduler_test
+
+import (
+	"contentgit/domain/content"
+	"contentgit/foundation"
+	"contentgit/ports/in/scheduler"
+	"contentgit/ports/in/web"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"contentgit/testdata/testserver"
+	"contentgit/testdata/testsuite"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	migratableArticleId   = "3d9f2c1a-7b4e-4a8d-9c61-5e2b8f0a1d47"
+	unmigratableArticleId = "b81e4f6c-2a9d-4c3e-8f57-1d6a3e9b0c28"
+)
+
+type SchemaMigrationRunnerTestSuite struct {
+	testsuite.BaseDatabaseTestSuite
+}
+
+func TestSchemaMigrationRunnerTestSuite(t *testing.T) {
+	suite.Run(t, new(SchemaMigrationRunnerTestSuite))
+}
+
+func (suite *SchemaMigrationRunnerTestSuite) TestRunActive() {
+	// given
+	server := suite.newServer()
+	sut := server.GetComponent("SchemaMigrationRunner").(*scheduler.SchemaMigrationRunner)
+	ctx := foundation.ContextProvider().SetDB(context.Background(), server.GetDB())
+	migrationId := suite.startMigration(server, false)
+
+	// when
+	_, err := sut.RunActive(ctx)
+
+	// then
+	suite.NoError(err)
+
+	migration := suite.getMigration(server, migrationId)
+	suite.Equal("done", migration["state"])
+	suite.Equal(float64(2), migration["total"])
+	suite.Equal(float64(2), migration["processed"])
+	suite.Equal(float64(1), migration["migrated"])
+	suite.Equal(float64(1), migration["failed"])
+	suite.Equal(float64(100), migration["progress"])
+	suite.Equal("2100-01-01T09:00:00+09:00", migration["completedAt"])
+
+	results := migration["results"].([]any)
+	suite.Equal(map[string]any{
+		"contentId": migratableArticleId,
+		"outcome":   "migrated",
+		"fields": []any{
+			map[string]any{"field": "price", "operation": "changed"},
+			map[string]any{"field": "firstName", "operation": "added"},
+			map[string]any{"field": "lastName", "operation": "added"},
+			map[string]any{"field": "author", "operation": "removed"},
+		},
+	}, results[0])
+	suite.Equal(unmigratableArticleId, results[1].(map[string]any)["contentId"])
+	suite.Equal("failed", results[1].(map[string]any)["outcome"])
+	suite.Contains(results[1].(map[string]any)["error"], content.ErrMigrationFailed.Error())
+	suite.NotContains(results[1].(map[string]any)["error"], "무료")
+
+	migrated := suite.loadAggregate(ctx, server, migratableArticleId)
+	suite.Equal(uint64(2), migrated.GetVersion())
+	suite.Equal(uint64(2), migrated.SchemaVersion)
+	suite.Equal(map[string]any{"lastName": "홍", "firstName": "길동", "price": float64(12000)}, migrated.Content)
+	suite.Equal(uint64(1), suite.loadAggregate(ctx, server, unmigratableArticleId).GetVersion())
+}
+
+func (suite *SchemaMigrationRunnerTestSuite) TestRunActive_드라이런은_컨텐츠를_바꾸지_않고_결과만_보고한다() {
+	// given
+	server := suite.newServer()
+	sut := server.GetComponent("SchemaMigrationRunner").(*scheduler.SchemaMigrationRunner)
+	ctx := foundation.ContextProvider().SetDB(context.Background(), server.GetDB())
+	migrationId := suite.startMigration(server, true)
+
+	// when
+	_, err := sut.RunActive(ctx)
+
+	// then
+	suite.NoError(err)
+
+	migration := suite.getMigration(server, migrationId)
+	suite.Equal("done", migration["state"])
+	suite.Equal(true, migration["dryRun"])
+	suite.Equal(float64(1), migration["migrated"])
+	suite.Equal(float64(1), migration["failed"])
+	suite.Len(migration["results"], 2)
+
+	suite.Equal(uint64(1), suite.loadAggregate(ctx, server, migratableArticleId).GetVersion())
+}
+
+func (suite *SchemaMigrationRunnerTestSuite) TestRunActive_이미_마이그레이션된_컨텐츠는_다시_바꾸지_않는다() {
+	// given
+	server := suite.newServer()
+	sut := server.GetComponent("SchemaMigrationRunner").(*scheduler.SchemaMigrationRunner)
+	ctx := foundation.ContextProvider().SetDB(context.Background(), server.GetDB())
+	suite.startMigration(server, false)
+	_, err := sut.RunActive(ctx)
+	suite.NoError(err)
+	migrationId := suite.startMigration(server, false)
+
+	// when
+	_, err = sut.RunActive(ctx)
+
+	// then
+	suite.NoError(err)
+
+	migration := suite.getMigration(server, migrationId)
+	suite.Equal(float64(0), migration["migrated"])
+	suite.Equal(float64(1), migration["unchanged"])
+	suite.Equal(float64(1), migration["failed"])
+	suite.Equal(uint64(2), suite.loadAggregate(ctx, server, migratableArticleId).GetVersion())
+}
+
+func (suite *SchemaMigrationRunnerTestSuite) TestRunActive_중단된_마이그레이션은_커서_다음부터_이어서_실행한다() {
+	// given
+	server := suite.newServer()
+	sut := server.GetComponent("SchemaMigrationRunner").(*scheduler.SchemaMigrationRunner)
+	ctx := foundation.ContextProvider().SetDB(context.Background(), server.GetDB())
+	migrationId := suite.startMigration(server, false)
+
+	// a crash after the batch of the first content was committed
+	repository := server.GetComponent("SchemaMigrationRepository").(content.SchemaMigrationRepository)
+	interrupted, err := repository.FindByID(ctx, "gridge", "articles", migrationId)
+	suite.NoError(err)
+	interrupted.Start(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), 2)
+	suite.NoError(interrupted.Record(migratableArticleId, nil, content.ErrNothingToMigrate))
+	suite.NoError(repository.Save(ctx, interrupted))
+
+	// when
+	_, err = sut.RunActive(ctx)
+
+	// then
+	suite.NoError(err)
+
+	migration := suite.getMigration(server, migrationId)
+	suite.Equal("done", migration["state"])
+	suite.Equal(float64(2), migration["processed"])
+	suite.Equal(float64(1), migration["unchanged"])
+	suite.Equal(float64(1), migration["failed"])
+	suite.Equal(uint64(1), suite.loadAggregate(ctx, server, migratableArticleId).GetVersion())
+}
+
+func (suite *SchemaMigrationRunnerTestSuite) TestStartMigration_진행중인_마이그레이션이_있으면_Conflict를_반환한다() {
+	// given
+	server := suite.newServer()
+	suite.startMigration(server, false)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/gridge/content-types/articles/migrations",
+		strings.NewReader(`{"createdById": "1", "createdByName": "사이트 관리자"}`))
+	rec := httptest.NewRecorder()
+
+	// when
+	server.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *SchemaMigrationRunnerTestSuite) newServer() *testserver.TestAppServer {
+	return testserver.NewTestAppServerBuilder(web.Router{}, suite.TestDbContainer).
+		SetMockComponent("Clock", fixedClock{now: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)}).
+		WithDatabaseFixture().
+		Build()
+}
+
+func (suite *SchemaMigrationRunnerTestSuite) startMigration(server *testserver.TestAppServer, dryRun bool) string {
+	requestBody := `{"dryRun": false, "createdById": "1", "createdByName": "사이트 관리자"}`
+	if dryRun {
+		requestBody = `{"dryRun": true, "createdById": "1", "createdByName": "사이트 관리자"}`
+	}
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/gridge/content-types/articles/migrations", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	suite.Equal(http.StatusAccepted, rec.Code)
+
+	var created map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &created)
+	return created["id"].(string)
+}
+
+func (suite *SchemaMigrationRunnerTestSuite) getMigration(server *testserver.TestAppServer, id string) map[string]any {
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/gridge/content-types/articles/migrations/"+id, nil)
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var migration map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &migration)
+	return migration
+}
+
+func (suite *SchemaMigrationRunnerTestSuite) loadAggregate(ctx context.Context, server *testserver.TestAppServer, id string) *content.ContentAggregate {
+	contentAggregate, _ := content.NewContentAggregate(id, "gridge")
+	suite.NoError(server.GetComponent("ContentAggregateStore").(eventsourcing.AggregateStore).Load(ctx, contentAggregate))
+	return contentAggregate
+}
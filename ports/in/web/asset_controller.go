@@ -0,0 +1,310 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/appservices"
+	"contentgit/domain/asset"
+	"contentgit/domain/asset/commands"
+	"contentgit/domain/asset/projections"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// maxAssetUploadSize caps the body of an upload, the file and the form fields along with it.
+const maxAssetUploadSize int64 = 100 << 20
+
+// inlineAssetMediaTypes are the media types browsers show without running anything in them, other assets are
+// downloaded as attachments so that an uploaded html or svg file never runs in the origin of the api.
+var inlineAssetMediaTypes = map[string]bool{
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+type AssetController struct {
+	routerGroup  *gin.RouterGroup
+	assetService *appservices.AssetService
+	assetQuery   *appservices.AssetQuery
+}
+
+func NewAssetController(rg *gin.RouterGroup, assetService *appservices.AssetService,
+	assetQuery *appservices.AssetQuery) *AssetController {
+	return &AssetController{
+		routerGroup:  rg,
+		assetService: assetService,
+		assetQuery:   assetQuery,
+	}
+}
+
+func (controller AssetController) MapRoutes() {
+	route := controller.routerGroup.Group("/tenants/:tenantId/assets")
+	route.POST("", controller.uploadAsset)
+	route.GET("", controller.getAssets)
+	route.GET(":id", controller.getAsset)
+	route.GET(":id/content", controller.downloadAsset)
+	route.DELETE(":id", controller.deleteAsset)
+}
+
+func (controller AssetController) uploadAsset(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxAssetUploadSize)
+	var assetUpload dtos.AssetUpload
+	if err := ctx.ShouldBind(&assetUpload); err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			ctx.JSON(http.StatusRequestEntityTooLarge, err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	file, err := assetUpload.File.Open()
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+	defer file.Close()
+
+	mediaType, err := assetMediaType(assetUpload.File, file)
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	assetId := uuid.New().String()
+	err = datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.UploadAssetCommand{
+			AggregateID:   assetId,
+			TenantId:      tenantId,
+			FileName:      assetUpload.File.Filename,
+			MediaType:     mediaType,
+			File:          file,
+			CreatedById:   assetUpload.CreatedById,
+			CreatedByName: assetUpload.CreatedByName,
+		}
+
+		return controller.assetService.Commands.UploadAsset.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if errors.Is(err, asset.ErrInvalidAsset) {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.AssetCreated{Id: assetId})
+}
+
+func (controller AssetController) getAssets(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	pageable := dtos.NewPageableFromRequest(ctx)
+
+	assetProjections, totalCount, err := controller.assetQuery.GetAssets(ctx.Request.Context(), tenantId, pageable)
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	assets := make([]dtos.Asset, 0, len(assetProjections))
+	for _, assetProjection := range assetProjections {
+		assets = append(assets, toAsset(assetProjection))
+	}
+
+	pageResult := dtos.PageResult[[]dtos.Asset]{
+		Result:     assets,
+		TotalCount: totalCount,
+	}
+
+	ctx.JSON(http.StatusOK, pageResult)
+}
+
+func (controller AssetController) getAsset(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	assetProjection, err := controller.assetQuery.GetAsset(ctx.Request.Context(), tenantId, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toAsset(*assetProjection))
+}
+
+// downloadAsset serves the bytes of an asset, a request with a Range header gets the requested part of them.
+// The media type is the one the uploader gave, so that browsers are told not to sniff another one.
+func (controller AssetController) downloadAsset(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	assetAggregate, file, err := controller.assetQuery.OpenAsset(ctx.Request.Context(), tenantId, id)
+	if err != nil {
+		if errors.Is(err, eventsourcing.ErrAggregateNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, asset.ErrAssetDeleted) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+	defer file.Close()
+
+	ctx.Header("Content-Type", assetAggregate.MediaType)
+	ctx.Header("X-Content-Type-Options", "nosniff")
+	ctx.Header("Content-Disposition", mime.FormatMediaType(assetDisposition(assetAggregate.MediaType), map[string]string{"filename": assetAggregate.FileName}))
+	// the bytes of an asset never change, so its SHA-256 is a strong validator for If-None-Match and If-Range
+	ctx.Header("ETag", `"`+assetAggregate.Sha256+`"`)
+	http.ServeContent(ctx.Writer, ctx.Request, assetAggregate.FileName, time.Time{}, file)
+}
+
+func (controller AssetController) deleteAsset(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	var assetDelete dtos.AssetDelete
+	if err := ctx.BindJSON(&assetDelete); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.DeleteAssetCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			CreatedById:   assetDelete.CreatedById,
+			CreatedByName: assetDelete.CreatedByName,
+		}
+
+		return controller.assetService.Commands.DeleteAsset.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if errors.Is(err, eventsourcing.ErrAggregateNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, asset.ErrAssetDeleted) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+			ctx.Status(http.StatusConflict)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// assetMediaType takes the media type of the uploaded part, or sniffs it from the first bytes of the file when the
+// client sent none.
+func assetMediaType(fileHeader *multipart.FileHeader, file multipart.File) (string, error) {
+	if mediaType := fileHeader.Header.Get("Content-Type"); len(mediaType) > 0 {
+		return mediaType, nil
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", errors.Wrap(err, "failed to read the uploaded file")
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", errors.Wrap(err, "failed to rewind the uploaded file")
+	}
+	return http.DetectContentType(head[:n]), nil
+}
+
+// assetDisposition shows the assets of the allowed media types inline and has the others downloaded.
+func assetDisposition(mediaType string) string {
+	parsed, _, err := mime.ParseMediaType(mediaType)
+	if err == nil && inlineAssetMediaTypes[parsed] {
+		return "inline"
+	}
+	return "attachment"
+}
+
+func toAsset(assetProjection projections.AssetProjection) dtos.Asset {
+	return dtos.Asset{
+		Id:            assetProjection.Id,
+		FileName:      assetProjection.FileName,
+		MediaType:     assetProjection.MediaType,
+		Size:          assetProjection.Size,
+		Sha256:        assetProjection.Sha256,
+		CreatedById:   assetProjection.CreatedById,
+		CreatedByName: assetProjection.CreatedByName,
+		CreatedAt:     assetProjection.CreatedAt,
+		DeletedAt:     assetProjection.DeletedAt,
+	}
+}
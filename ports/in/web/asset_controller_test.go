@@ -0,0 +1,196 @@
+package web
+
+import (
+	"bytes"
+	"contentgit/testdata/testserver"
+	"contentgit/testdata/testsuite"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AssetControllerTestSuite struct {
+	testsuite.BaseDatabaseTestSuite
+}
+
+func TestAssetControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(AssetControllerTestSuite))
+}
+
+func (suite *AssetControllerTestSuite) uploadAsset(sut *testserver.TestAppServer, fileName string, content string) *httptest.ResponseRecorder {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", fileName)
+	part.Write([]byte(content))
+	writer.WriteField("createdById", "1")
+	writer.WriteField("createdByName", "사이트 관리자")
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/lumen/assets", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	return rec
+}
+
+func (suite *AssetControllerTestSuite) TestUploadAsset() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	// when
+	rec := suite.uploadAsset(sut, "hello.txt", "hello asset")
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+
+	var created map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &created)
+	suite.NotEmpty(created["id"])
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/lumen/assets/"+created["id"].(string)+"/content", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal("hello asset", rec.Body.String())
+	suite.Equal("application/octet-stream", rec.Header().Get("Content-Type"))
+	suite.Equal("nosniff", rec.Header().Get("X-Content-Type-Options"))
+	suite.Equal(`attachment; filename=hello.txt`, rec.Header().Get("Content-Disposition"))
+}
+
+func (suite *AssetControllerTestSuite) TestDownloadAsset_이미지는_인라인으로_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="file"; filename="pixel.png"`)
+	header.Set("Content-Type", "image/png")
+	part, _ := writer.CreatePart(header)
+	part.Write([]byte("\x89PNG\r\n\x1a\n"))
+	writer.WriteField("createdById", "1")
+	writer.WriteField("createdByName", "사이트 관리자")
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/lumen/assets", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+
+	var created map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &created)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/lumen/assets/"+created["id"].(string)+"/content", nil)
+	rec = httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal("image/png", rec.Header().Get("Content-Type"))
+	suite.Equal(`inline; filename=pixel.png`, rec.Header().Get("Content-Disposition"))
+}
+
+func (suite *AssetControllerTestSuite) TestUploadAsset_파일이_없으면_400을_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/lumen/assets",
+		strings.NewReader("createdById=1&createdByName=%EC%82%AC%EC%9D%B4%ED%8A%B8"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (suite *AssetControllerTestSuite) TestDownloadAsset_Range를_요청하면_일부만_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	var created map[string]any
+	json.Unmarshal(suite.uploadAsset(sut, "hello.txt", "hello asset").Body.Bytes(), &created)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/lumen/assets/"+created["id"].(string)+"/content", nil)
+	req.Header.Set("Range", "bytes=0-4")
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusPartialContent, rec.Code)
+	suite.Equal("hello", rec.Body.String())
+	suite.Equal("bytes 0-4/11", rec.Header().Get("Content-Range"))
+}
+
+func (suite *AssetControllerTestSuite) TestDownloadAsset_없는_에셋이면_404를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/lumen/assets/0b1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e/content", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *AssetControllerTestSuite) TestDownloadAsset_다른_테넌트의_에셋이면_404를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	var created map[string]any
+	json.Unmarshal(suite.uploadAsset(sut, "hello.txt", "hello asset").Body.Bytes(), &created)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/atlas/assets/"+created["id"].(string)+"/content", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/tenants/atlas/assets/"+created["id"].(string),
+		strings.NewReader(`{"createdById": "1", "createdByName": "사이트 관리자"}`))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *AssetControllerTestSuite) TestDeleteAsset() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	var created map[string]any
+	json.Unmarshal(suite.uploadAsset(sut, "hello.txt", "hello asset").Body.Bytes(), &created)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tenants/lumen/assets/"+created["id"].(string),
+		strings.NewReader(`{"createdById": "1", "createdByName": "사이트 관리자"}`))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/lumen/assets/"+created["id"].(string)+"/content", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusGone, rec.Code)
+}
@@ -0,0 +1,445 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/appservices"
+	"contentgit/domain/changerequest"
+	"contentgit/domain/changerequest/commands"
+	"contentgit/domain/changerequest/events"
+	"contentgit/domain/changerequest/projections"
+	"contentgit/domain/content"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type ChangeRequestController struct {
+	routerGroup          *gin.RouterGroup
+	changeRequestService *appservices.ChangeRequestService
+	changeRequestQuery   *appservices.ChangeRequestQuery
+}
+
+func NewChangeRequestController(rg *gin.RouterGroup, changeRequestService *appservices.ChangeRequestService,
+	changeRequestQuery *appservices.ChangeRequestQuery) *ChangeRequestController {
+	return &ChangeRequestController{
+		routerGroup:          rg,
+		changeRequestService: changeRequestService,
+		changeRequestQuery:   changeRequestQuery,
+	}
+}
+
+func (controller ChangeRequestController) MapRoutes() {
+	route := controller.routerGroup.Group("/tenants/:tenantId/:contentType/contents/:id/change-requests")
+	route.POST("", controller.createChangeRequest)
+	route.GET("", controller.getChangeRequests)
+	route.GET(":changeRequestId", controller.getChangeRequest)
+	route.POST(":changeRequestId/comments", controller.addChangeRequestComment)
+	route.POST(":changeRequestId/reviews", controller.reviewChangeRequest)
+	route.POST(":changeRequestId/merge", controller.mergeChangeRequest)
+	route.POST(":changeRequestId/close", controller.closeChangeRequest)
+}
+
+func (controller ChangeRequestController) createChangeRequest(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	var changeRequestCreate dtos.ChangeRequestCreate
+	if err := ctx.BindJSON(&changeRequestCreate); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	changeRequestId := uuid.New().String()
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		changes := make([]events.ChangedField, 0, len(changeRequestCreate.Changes))
+		for _, change := range changeRequestCreate.Changes {
+			changes = append(changes, events.ChangedField{FieldName: change.Field, BeforeValue: change.BeforeValue, AfterValue: change.AfterValue})
+		}
+		reviewers := make([]events.Reviewer, 0, len(changeRequestCreate.Reviewers))
+		for _, reviewer := range changeRequestCreate.Reviewers {
+			reviewers = append(reviewers, events.Reviewer{Id: reviewer.Id, Name: reviewer.Name})
+		}
+
+		command := commands.OpenChangeRequestCommand{
+			AggregateID:   changeRequestId,
+			TenantId:      tenantId,
+			ContentId:     id,
+			Title:         changeRequestCreate.Title,
+			Description:   changeRequestCreate.Description,
+			Changes:       changes,
+			Reviewers:     reviewers,
+			CreatedById:   changeRequestCreate.CreatedById,
+			CreatedByName: changeRequestCreate.CreatedByName,
+		}
+
+		return controller.changeRequestService.Commands.OpenChangeRequest.Handle(ctx, command)
+	})
+
+	if err != nil {
+		handleChangeRequestError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.ChangeRequestCreated{Id: changeRequestId})
+}
+
+// getChangeRequests serves the change requests of the content, only those in ?state= when it is given.
+func (controller ChangeRequestController) getChangeRequests(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	state := ctx.Query("state")
+	switch changerequest.State(state) {
+	case "", changerequest.StateOpen, changerequest.StateMerged, changerequest.StateClosed:
+	default:
+		ctx.JSON(http.StatusBadRequest, "state must be one of open, merged and closed")
+		return
+	}
+
+	changeRequestProjections, err := controller.changeRequestQuery.GetChangeRequests(ctx.Request.Context(), tenantId, id, state)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	changeRequests := make([]dtos.ChangeRequest, 0, len(changeRequestProjections))
+	for _, changeRequestProjection := range changeRequestProjections {
+		changeRequests = append(changeRequests, toChangeRequestDto(changeRequestProjection))
+	}
+
+	ctx.JSON(http.StatusOK, changeRequests)
+}
+
+func (controller ChangeRequestController) getChangeRequest(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	changeRequestId := ctx.Param("changeRequestId")
+	if len(changeRequestId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "changeRequestId is required")
+		return
+	}
+
+	changeRequestProjection, err := controller.changeRequestQuery.GetChangeRequest(ctx.Request.Context(), tenantId, id, changeRequestId)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	changeRequest := toChangeRequestDto(*changeRequestProjection)
+	changeRequest.Reviews = make([]dtos.ChangeRequestReview, 0, len(changeRequestProjection.Reviews))
+	for _, review := range changeRequestProjection.Reviews {
+		changeRequest.Reviews = append(changeRequest.Reviews, dtos.ChangeRequestReview{
+			Decision:     review.Decision,
+			Comment:      review.Comment,
+			ReviewerId:   review.ReviewerId,
+			ReviewerName: review.ReviewerName,
+			CreatedAt:    review.CreatedAt,
+		})
+	}
+	changeRequest.Comments = make([]dtos.ChangeRequestComment, 0, len(changeRequestProjection.Comments))
+	for _, comment := range changeRequestProjection.Comments {
+		changeRequest.Comments = append(changeRequest.Comments, dtos.ChangeRequestComment{
+			Field:         comment.Name,
+			Comment:       comment.Comment,
+			CreatedById:   comment.CreatedById,
+			CreatedByName: comment.CreatedByName,
+			CreatedAt:     comment.CreatedAt,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, changeRequest)
+}
+
+func (controller ChangeRequestController) addChangeRequestComment(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	changeRequestId := ctx.Param("changeRequestId")
+	if len(changeRequestId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "changeRequestId is required")
+		return
+	}
+
+	var commentCreate dtos.ChangeRequestCommentCreate
+	if err := ctx.BindJSON(&commentCreate); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.CommentChangeRequestCommand{
+			AggregateID:   changeRequestId,
+			TenantId:      tenantId,
+			ContentId:     id,
+			FieldName:     commentCreate.Field,
+			Comment:       commentCreate.Comment,
+			CreatedById:   commentCreate.CreatedById,
+			CreatedByName: commentCreate.CreatedByName,
+		}
+
+		return controller.changeRequestService.Commands.CommentChangeRequest.Handle(ctx, command)
+	})
+
+	if err != nil {
+		handleChangeRequestError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusCreated)
+}
+
+func (controller ChangeRequestController) reviewChangeRequest(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	changeRequestId := ctx.Param("changeRequestId")
+	if len(changeRequestId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "changeRequestId is required")
+		return
+	}
+
+	var reviewCreate dtos.ChangeRequestReviewCreate
+	if err := ctx.BindJSON(&reviewCreate); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.ReviewChangeRequestCommand{
+			AggregateID:  changeRequestId,
+			TenantId:     tenantId,
+			ContentId:    id,
+			Decision:     reviewCreate.Decision,
+			Comment:      reviewCreate.Comment,
+			ReviewerId:   reviewCreate.ReviewerId,
+			ReviewerName: reviewCreate.ReviewerName,
+		}
+
+		return controller.changeRequestService.Commands.ReviewChangeRequest.Handle(ctx, command)
+	})
+
+	if err != nil {
+		handleChangeRequestError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusCreated)
+}
+
+// mergeChangeRequest applies the changes of the change request to the content as one commit.
+func (controller ChangeRequestController) mergeChangeRequest(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	changeRequestId := ctx.Param("changeRequestId")
+	if len(changeRequestId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "changeRequestId is required")
+		return
+	}
+
+	var changeRequestMerge dtos.ChangeRequestMerge
+	if err := ctx.BindJSON(&changeRequestMerge); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	commitId := uuid.New().String()
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.MergeChangeRequestCommand{
+			AggregateID:   changeRequestId,
+			TenantId:      tenantId,
+			ContentId:     id,
+			CommitId:      commitId,
+			CreatedById:   changeRequestMerge.CreatedById,
+			CreatedByName: changeRequestMerge.CreatedByName,
+		}
+
+		return controller.changeRequestService.Commands.MergeChangeRequest.Handle(ctx, command)
+	})
+
+	if err != nil {
+		handleChangeRequestError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.ContentCommitCreated{Id: commitId})
+}
+
+func (controller ChangeRequestController) closeChangeRequest(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	changeRequestId := ctx.Param("changeRequestId")
+	if len(changeRequestId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "changeRequestId is required")
+		return
+	}
+
+	var changeRequestClose dtos.ChangeRequestClose
+	if err := ctx.BindJSON(&changeRequestClose); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.CloseChangeRequestCommand{
+			AggregateID:   changeRequestId,
+			TenantId:      tenantId,
+			ContentId:     id,
+			CreatedById:   changeRequestClose.CreatedById,
+			CreatedByName: changeRequestClose.CreatedByName,
+		}
+
+		return controller.changeRequestService.Commands.CloseChangeRequest.Handle(ctx, command)
+	})
+
+	if err != nil {
+		handleChangeRequestError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func handleChangeRequestError(ctx *gin.Context, err error) {
+	if errors.Is(err, changerequest.ErrInvalidChangeRequest) || errors.Is(err, changerequest.ErrInvalidDecision) ||
+		errors.Is(err, changerequest.ErrCommentRequired) || errors.Is(err, content.ErrInvalidCommit) {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if respondSchemaViolation(ctx, err) {
+		return
+	}
+
+	if errors.Is(err, changerequest.ErrNotReviewer) {
+		ctx.JSON(http.StatusForbidden, err.Error())
+		return
+	}
+
+	if errors.Is(err, eventsourcing.ErrAggregateNotFound) {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	if errors.Is(err, changerequest.ErrChangeRequestNotOpen) || errors.Is(err, changerequest.ErrNotApproved) ||
+		errors.Is(err, content.ErrFieldUpdateConflict) || errors.Is(err, content.ErrFieldNotFound) ||
+		errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+		ctx.JSON(http.StatusConflict, err.Error())
+		return
+	}
+
+	if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+		ctx.Status(http.StatusGone)
+		return
+	}
+
+	foundation.GinErrorHandler().InternalServerError(ctx, err)
+}
+
+func toChangeRequestDto(changeRequestProjection projections.ChangeRequestProjection) dtos.ChangeRequest {
+	changes := make([]dtos.ContentCommitFieldChange, 0, len(changeRequestProjection.Changes))
+	for _, change := range changeRequestProjection.Changes {
+		changes = append(changes, dtos.ContentCommitFieldChange{Field: change.Name, BeforeValue: change.BeforeValue, AfterValue: change.AfterValue})
+	}
+	reviewers := make([]dtos.ChangeRequestReviewer, 0, len(changeRequestProjection.Reviewers))
+	for _, reviewer := range changeRequestProjection.Reviewers {
+		reviewers = append(reviewers, dtos.ChangeRequestReviewer{Id: reviewer.Id, Name: reviewer.Name})
+	}
+
+	return dtos.ChangeRequest{
+		Id:             changeRequestProjection.Id,
+		ContentId:      changeRequestProjection.ContentId,
+		Title:          changeRequestProjection.Title,
+		Description:    changeRequestProjection.Description,
+		Changes:        changes,
+		Reviewers:      reviewers,
+		State:          changeRequestProjection.State,
+		CommitId:       changeRequestProjection.CommitId,
+		ContentVersion: changeRequestProjection.ContentVersion,
+		Version:        changeRequestProjection.Version,
+		CreatedById:    changeRequestProjection.CreatedById,
+		CreatedByName:  changeRequestProjection.CreatedByName,
+		CreatedAt:      changeRequestProjection.CreatedAt,
+		ClosedAt:       changeRequestProjection.ClosedAt,
+	}
+}
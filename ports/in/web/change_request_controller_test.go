@@ -0,0 +1,300 @@
+package web
+
+import (
+	"contentgit/testdata/testserver"
+	"contentgit/testdata/testsuite"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ChangeRequestControllerTestSuite struct {
+	testsuite.BaseDatabaseTestSuite
+}
+
+func TestChangeRequestControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ChangeRequestControllerTestSuite))
+}
+
+func (suite *ChangeRequestControllerTestSuite) TestCreateChangeRequest() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"title": "소재 정보 추가",
+			"changes": [
+				{"field": "material", "beforeValue": null, "afterValue": "린넨 100%"}
+			],
+			"reviewers": [{"id": "8", "name": "정하늘"}],
+			"createdById": "7",
+			"createdByName": "최유진"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.NotEmpty(actual["id"])
+}
+
+func (suite *ChangeRequestControllerTestSuite) TestCreateChangeRequest_작성자가_리뷰어면_BadRequest를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"title": "소재 정보 추가",
+			"changes": [
+				{"field": "material", "beforeValue": null, "afterValue": "린넨 100%"}
+			],
+			"reviewers": [{"id": "7", "name": "최유진"}],
+			"createdById": "7",
+			"createdByName": "최유진"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (suite *ChangeRequestControllerTestSuite) TestGetChangeRequests() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests?state=open", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual []map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(2, len(actual))
+	suite.Equal("a7e3c1d9-2b4f-4c6e-8a10-3f5d7b9e1c24", actual[0]["id"])
+	suite.Equal("b5d8f2a6-9c1e-4a3b-8f47-6e0c2d4a8b19", actual[1]["id"])
+}
+
+func (suite *ChangeRequestControllerTestSuite) TestGetChangeRequest() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests/a7e3c1d9-2b4f-4c6e-8a10-3f5d7b9e1c24", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal("여름 할인가 반영", actual["title"])
+	suite.Equal("open", actual["state"])
+	suite.Equal([]any{
+		map[string]any{"field": "price", "beforeValue": "35000", "afterValue": "29000"},
+	}, actual["changes"])
+	suite.Equal([]any{
+		map[string]any{"decision": "approve", "reviewerId": "8", "reviewerName": "정하늘", "createdAt": "1982-02-07T00:00:00+09:00"},
+	}, actual["reviews"])
+	suite.Equal([]any{
+		map[string]any{"field": "price", "comment": "할인율 확인 부탁드려요.", "createdById": "8", "createdByName": "정하늘", "createdAt": "1982-02-06T12:00:00+09:00"},
+	}, actual["comments"])
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/mellow/dresses/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465/change-requests/a7e3c1d9-2b4f-4c6e-8a10-3f5d7b9e1c24", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ChangeRequestControllerTestSuite) TestReviewChangeRequest() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{"decision": "approve", "reviewerId": "7", "reviewerName": "최유진"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests/a7e3c1d9-2b4f-4c6e-8a10-3f5d7b9e1c24/reviews", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusForbidden, rec.Code)
+
+	requestBody = `{"decision": "reject", "reviewerId": "8", "reviewerName": "정하늘"}`
+	req = httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests/a7e3c1d9-2b4f-4c6e-8a10-3f5d7b9e1c24/reviews", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusBadRequest, rec.Code)
+
+	requestBody = `{"decision": "reject", "comment": "할인 기간이 정해지지 않았어요.", "reviewerId": "8", "reviewerName": "정하늘"}`
+	req = httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests/a7e3c1d9-2b4f-4c6e-8a10-3f5d7b9e1c24/reviews", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusCreated, rec.Code)
+
+	// 반려된 변경 요청은 머지할 수 없다
+	requestBody = `{"createdById": "7", "createdByName": "최유진"}`
+	req = httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests/a7e3c1d9-2b4f-4c6e-8a10-3f5d7b9e1c24/merge", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *ChangeRequestControllerTestSuite) TestMergeChangeRequest() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{"createdById": "7", "createdByName": "최유진"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests/a7e3c1d9-2b4f-4c6e-8a10-3f5d7b9e1c24/merge", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.NotEmpty(actual["id"])
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34?version=6", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusOK, rec.Code)
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal("29000", actual["content"].(map[string]any)["price"])
+
+	req = httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests/a7e3c1d9-2b4f-4c6e-8a10-3f5d7b9e1c24/merge", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *ChangeRequestControllerTestSuite) TestMergeChangeRequest_현재_값이_다르면_Conflict를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{"createdById": "7", "createdByName": "최유진"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests/b5d8f2a6-9c1e-4a3b-8f47-6e0c2d4a8b19/merge", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *ChangeRequestControllerTestSuite) TestMergeChangeRequest_스키마에_맞지_않으면_UnprocessableEntity를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"name": "dresses",
+			"fields": [{"name": "price", "type": "integer"}],
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/content-types", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusCreated, rec.Code)
+
+	requestBody = `{"createdById": "7", "createdByName": "최유진"}`
+	req = httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests/a7e3c1d9-2b4f-4c6e-8a10-3f5d7b9e1c24/merge", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusUnprocessableEntity, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal("price", actual["errors"].([]any)[0].(map[string]any)["field"])
+}
+
+func (suite *ChangeRequestControllerTestSuite) TestMergeChangeRequest_다른_테넌트의_변경_요청이면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{"createdById": "7", "createdByName": "최유진"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/atlas/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests/a7e3c1d9-2b4f-4c6e-8a10-3f5d7b9e1c24/merge", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34?version=6", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ChangeRequestControllerTestSuite) TestCreateChangeRequest_다른_테넌트의_컨텐츠면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"title": "소재 정보 추가",
+			"changes": [
+				{"field": "material", "beforeValue": null, "afterValue": "린넨 100%"}
+			],
+			"reviewers": [{"id": "8", "name": "정하늘"}],
+			"createdById": "7",
+			"createdByName": "최유진"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/atlas/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ChangeRequestControllerTestSuite) TestCloseChangeRequest() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{"createdById": "7", "createdByName": "최유진"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests/b5d8f2a6-9c1e-4a3b-8f47-6e0c2d4a8b19/close", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/change-requests/b5d8f2a6-9c1e-4a3b-8f47-6e0c2d4a8b19/close", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusConflict, rec.Code)
+}
@@ -0,0 +1,167 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+func (controller ContentController) createBranch(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	var branchCreate dtos.ContentBranchCreate
+	if err := ctx.BindJSON(&branchCreate); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.CreateContentBranchCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			Branch:        branchCreate.Name,
+			SourceBranch:  branchCreate.SourceBranch,
+			SourceVersion: branchCreate.SourceVersion,
+			CreatedById:   branchCreate.CreatedById,
+			CreatedByName: branchCreate.CreatedByName,
+		}
+
+		return controller.contentService.Commands.CreateContentBranch.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if errors.Is(err, content.ErrInvalidBranchName) {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrAggregateNotFound) || errors.Is(err, eventsourcing.ErrVersionNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, content.ErrBranchAlreadyExists) || errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+			ctx.Status(http.StatusConflict)
+			return
+		}
+
+		if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusCreated)
+}
+
+func (controller ContentController) getBranches(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	contentProjection, err := controller.contentQuery.GetContent(ctx.Request.Context(), tenantId, id, false)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	branchProjections, err := controller.contentQuery.GetContentBranches(ctx.Request.Context(), tenantId, id)
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	branchSummaries := []dtos.ContentBranchSummary{
+		{
+			Name:      eventsourcing.DefaultBranch,
+			Version:   contentProjection.Version,
+			CreatedAt: contentProjection.CreatedAt,
+			UpdatedAt: contentProjection.UpdatedAt,
+		},
+	}
+	for _, branchProjection := range branchProjections {
+		branchSummaries = append(branchSummaries, dtos.ContentBranchSummary{
+			Name:          branchProjection.Branch,
+			SourceBranch:  branchProjection.SourceBranch,
+			SourceVersion: branchProjection.SourceVersion,
+			Version:       branchProjection.Version,
+			CreatedAt:     branchProjection.CreatedAt,
+			UpdatedAt:     branchProjection.UpdatedAt,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, branchSummaries)
+}
+
+func (controller ContentController) getBranch(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	branch := ctx.Param("branch")
+	if len(id) == 0 || len(branch) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id and branch are required")
+		return
+	}
+
+	branchProjection, err := controller.contentQuery.GetContentBranch(ctx.Request.Context(), tenantId, id, branch)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.ContentBranchDetails{
+		Id:            branchProjection.ContentId,
+		Branch:        branchProjection.Branch,
+		Content:       branchProjection.Content,
+		ContentType:   branchProjection.ContentType,
+		SourceBranch:  branchProjection.SourceBranch,
+		SourceVersion: branchProjection.SourceVersion,
+		Version:       branchProjection.Version,
+		CreatedById:   branchProjection.CreatedById,
+		CreatedByName: branchProjection.CreatedByName,
+		CreatedAt:     branchProjection.CreatedAt,
+		UpdatedAt:     branchProjection.UpdatedAt,
+	})
+}
@@ -0,0 +1,266 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/domain/content/projections"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (controller ContentController) replyToFieldComment(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	parentId := ctx.Param("commentId")
+	if len(id) == 0 || len(parentId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id and commentId are required")
+		return
+	}
+
+	var fieldComment dtos.ContentFieldComment
+	if err := ctx.BindJSON(&fieldComment); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	commentId := uuid.New().String()
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.AddContentFieldCommentCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			CommentId:     commentId,
+			ParentId:      parentId,
+			Comment:       fieldComment.Comment,
+			CreatedById:   fieldComment.CreatedById,
+			CreatedByName: fieldComment.CreatedByName,
+		}
+
+		return controller.contentService.Commands.AddContentFieldComment.Handle(ctx, command)
+	})
+
+	if err != nil {
+		handleFieldCommentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.ContentFieldCommentCreated{Id: commentId})
+}
+
+func (controller ContentController) editFieldComment(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	commentId := ctx.Param("commentId")
+	if len(id) == 0 || len(commentId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id and commentId are required")
+		return
+	}
+
+	var fieldComment dtos.ContentFieldComment
+	if err := ctx.BindJSON(&fieldComment); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.EditContentFieldCommentCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			CommentId:     commentId,
+			Comment:       fieldComment.Comment,
+			CreatedById:   fieldComment.CreatedById,
+			CreatedByName: fieldComment.CreatedByName,
+		}
+
+		return controller.contentService.Commands.EditContentFieldComment.Handle(ctx, command)
+	})
+
+	if err != nil {
+		handleFieldCommentError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (controller ContentController) deleteFieldComment(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	commentId := ctx.Param("commentId")
+	if len(id) == 0 || len(commentId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id and commentId are required")
+		return
+	}
+
+	var commentChange dtos.ContentFieldCommentChange
+	if err := ctx.BindJSON(&commentChange); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.DeleteContentFieldCommentCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			CommentId:     commentId,
+			CreatedById:   commentChange.CreatedById,
+			CreatedByName: commentChange.CreatedByName,
+		}
+
+		return controller.contentService.Commands.DeleteContentFieldComment.Handle(ctx, command)
+	})
+
+	if err != nil {
+		handleFieldCommentError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// resolveFieldComment serves the endpoint resolving a comment thread, or reopening it when resolved is false.
+func (controller ContentController) resolveFieldComment(resolved bool) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		tenantId := ctx.Param("tenantId")
+		if len(tenantId) == 0 {
+			ctx.JSON(http.StatusBadRequest, "tenantId is required")
+			return
+		}
+
+		id := ctx.Param("id")
+		commentId := ctx.Param("commentId")
+		if len(id) == 0 || len(commentId) == 0 {
+			ctx.JSON(http.StatusBadRequest, "id and commentId are required")
+			return
+		}
+
+		var commentChange dtos.ContentFieldCommentChange
+		if err := ctx.BindJSON(&commentChange); err != nil {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+			command := commands.ResolveContentFieldCommentCommand{
+				AggregateID:   id,
+				TenantId:      tenantId,
+				CommentId:     commentId,
+				Resolved:      resolved,
+				CreatedById:   commentChange.CreatedById,
+				CreatedByName: commentChange.CreatedByName,
+			}
+
+			return controller.contentService.Commands.ResolveContentFieldComment.Handle(ctx, command)
+		})
+
+		if err != nil {
+			handleFieldCommentError(ctx, err)
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+func handleFieldCommentError(ctx *gin.Context, err error) {
+	if errors.Is(err, content.ErrInvalidComment) {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if errors.Is(err, content.ErrNotCommentAuthor) {
+		ctx.JSON(http.StatusForbidden, err.Error())
+		return
+	}
+
+	if errors.Is(err, eventsourcing.ErrAggregateNotFound) || errors.Is(err, persistence.ErrRecordNotFound) ||
+		errors.Is(err, content.ErrCommentNotFound) {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	if errors.Is(err, content.ErrThreadResolved) || errors.Is(err, content.ErrThreadNotResolved) ||
+		errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+		ctx.JSON(http.StatusConflict, err.Error())
+		return
+	}
+
+	foundation.GinErrorHandler().InternalServerError(ctx, err)
+}
+
+// toCommentThreads nests the comments of a field under the comments they reply to, in the order they were written.
+// A reply whose parent is missing is shown as a thread of its own.
+func toCommentThreads(fieldComments []projections.ContentFieldComment) []dtos.ContentDetailsComment {
+	sort.Slice(fieldComments, func(i, j int) bool {
+		return fieldComments[i].ID < fieldComments[j].ID
+	})
+
+	commentIds := make(map[string]bool, len(fieldComments))
+	replies := make(map[string][]projections.ContentFieldComment)
+	roots := make([]projections.ContentFieldComment, 0)
+	for _, fieldComment := range fieldComments {
+		commentIds[fieldComment.CommentId] = true
+	}
+	for _, fieldComment := range fieldComments {
+		if fieldComment.ParentId != "" && commentIds[fieldComment.ParentId] {
+			replies[fieldComment.ParentId] = append(replies[fieldComment.ParentId], fieldComment)
+			continue
+		}
+		roots = append(roots, fieldComment)
+	}
+
+	var toComment func(fieldComment projections.ContentFieldComment, root bool) dtos.ContentDetailsComment
+	toComment = func(fieldComment projections.ContentFieldComment, root bool) dtos.ContentDetailsComment {
+		comment := dtos.ContentDetailsComment{
+			Id:            fieldComment.ID,
+			CommentId:     fieldComment.CommentId,
+			Comment:       fieldComment.Comment,
+			CreatedAt:     fieldComment.CreatedAt,
+			CreatedById:   fieldComment.CreatedById,
+			CreatedByName: fieldComment.CreatedByName,
+			EditedAt:      fieldComment.EditedAt,
+			Deleted:       fieldComment.Deleted,
+		}
+		if root {
+			resolved := fieldComment.Resolved
+			comment.Resolved = &resolved
+			comment.ResolvedById = fieldComment.ResolvedById
+			comment.ResolvedByName = fieldComment.ResolvedByName
+			comment.ResolvedAt = fieldComment.ResolvedAt
+		}
+		for _, reply := range replies[fieldComment.CommentId] {
+			comment.Replies = append(comment.Replies, toComment(reply, false))
+		}
+		return comment
+	}
+
+	threads := make([]dtos.ContentDetailsComment, 0, len(roots))
+	for _, root := range roots {
+		threads = append(threads, toComment(root, true))
+	}
+	return threads
+}
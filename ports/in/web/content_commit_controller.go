@@ -0,0 +1,141 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/domain/content/events"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func (controller ContentController) createCommit(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+	branch := ctx.Param("branch")
+
+	var commitCreate dtos.ContentCommitCreate
+	if err := ctx.BindJSON(&commitCreate); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	commitId := uuid.New().String()
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		fields := make([]events.CommittedField, 0, len(commitCreate.Fields))
+		for _, field := range commitCreate.Fields {
+			fields = append(fields, events.CommittedField{FieldName: field.Field, BeforeValue: field.BeforeValue, AfterValue: field.AfterValue})
+		}
+
+		command := commands.CommitContentCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			Branch:        branch,
+			CommitId:      commitId,
+			Message:       commitCreate.Message,
+			ParentVersion: commitCreate.ParentVersion,
+			Fields:        fields,
+			CreatedById:   commitCreate.CreatedById,
+			CreatedByName: commitCreate.CreatedByName,
+		}
+
+		return controller.contentService.Commands.CommitContent.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if respondSchemaViolation(ctx, err) {
+			return
+		}
+
+		if errors.Is(err, content.ErrInvalidBranchName) || errors.Is(err, content.ErrInvalidCommit) {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrAggregateNotFound) || errors.Is(err, content.ErrFieldNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, content.ErrStaleParentVersion) || errors.Is(err, content.ErrFieldUpdateConflict) ||
+			errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+			ctx.JSON(http.StatusConflict, err.Error())
+			return
+		}
+
+		if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.ContentCommitCreated{Id: commitId})
+}
+
+// getCommits serves the commit log of ?branch=, the default branch when omitted.
+func (controller ContentController) getCommits(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+	branch := ctx.DefaultQuery("branch", eventsourcing.DefaultBranch)
+
+	contentCommits, err := controller.contentQuery.GetContentCommits(ctx.Request.Context(), tenantId, id, branch)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	commits := make([]dtos.ContentCommit, 0, len(contentCommits))
+	for _, contentCommit := range contentCommits {
+		fields := make([]dtos.ContentCommitFieldChange, 0, len(contentCommit.Fields))
+		for _, field := range contentCommit.Fields {
+			fields = append(fields, dtos.ContentCommitFieldChange{Field: field.Name, BeforeValue: field.BeforeValue, AfterValue: field.AfterValue})
+		}
+
+		commits = append(commits, dtos.ContentCommit{
+			Id:            contentCommit.Id,
+			Branch:        contentCommit.Branch,
+			Version:       contentCommit.Version,
+			ParentVersion: contentCommit.ParentVersion,
+			Message:       contentCommit.Message,
+			Fields:        fields,
+			CreatedById:   contentCommit.CreatedById,
+			CreatedByName: contentCommit.CreatedByName,
+			CreatedAt:     contentCommit.CreatedAt,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, commits)
+}
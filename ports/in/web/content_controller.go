@@ -9,6 +9,7 @@ import (
 	"contentgit/dtos"
 	"contentgit/foundation"
 	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
 	"context"
 	"net/http"
 	"sort"
@@ -37,9 +38,59 @@ func (controller ContentController) MapRoutes() {
 	route.POST("bulk", controller.createBulkContents)
 	route.POST("", controller.createContent)
 	route.GET("", controller.getContents)
+	route.GET("trash", controller.getTrash)
 	route.GET(":id", controller.getContent)
+	route.DELETE(":id", controller.deleteContent)
+	route.POST(":id/restore", controller.restoreContent)
+	route.POST(":id/purge", controller.purgeContent)
+	route.POST(":id/submit", controller.changeContentStatus(content.TransitionSubmit))
+	route.POST(":id/approve", controller.changeContentStatus(content.TransitionApprove))
+	route.POST(":id/reject", controller.changeContentStatus(content.TransitionReject))
+	route.POST(":id/publish", controller.changeContentStatus(content.TransitionPublish))
+	route.POST(":id/unpublish", controller.changeContentStatus(content.TransitionUnpublish))
+	route.GET(":id/diff", controller.getContentDiff)
+	route.GET(":id/blame", controller.getContentBlame)
+	route.GET(":id/referenced-by", controller.getReferencedBy)
+	route.POST(":id/revert", controller.revertContent)
+	route.POST(":id/commits", controller.createCommit)
+	route.GET(":id/commits", controller.getCommits)
+	route.POST(":id/fields", controller.addContentField)
+	route.PUT(":id/fields/*path", controller.updateContentField)
+	route.DELETE(":id/fields/*path", controller.removeContentField)
+	route.POST(":id/comments/*path", controller.addFieldComment)
+	route.POST(":id/field-comments/:commentId/replies", controller.replyToFieldComment)
+	route.PUT(":id/field-comments/:commentId", controller.editFieldComment)
+	route.DELETE(":id/field-comments/:commentId", controller.deleteFieldComment)
+	route.POST(":id/field-comments/:commentId/resolve", controller.resolveFieldComment(true))
+	route.POST(":id/field-comments/:commentId/reopen", controller.resolveFieldComment(false))
 	route.PUT(":id/:fieldName", controller.updateContentField)
+	route.DELETE(":id/:fieldName", controller.removeContentField)
 	route.POST(":id/:fieldName/comments", controller.addFieldComment)
+	route.POST(":id/branches", controller.createBranch)
+	route.GET(":id/branches", controller.getBranches)
+	route.GET(":id/branches/:branch", controller.getBranch)
+	route.POST(":id/branches/:branch/fields", controller.addContentField)
+	route.PUT(":id/branches/:branch/fields/*path", controller.updateContentField)
+	route.DELETE(":id/branches/:branch/fields/*path", controller.removeContentField)
+	route.PUT(":id/branches/:branch/:fieldName", controller.updateContentField)
+	route.DELETE(":id/branches/:branch/:fieldName", controller.removeContentField)
+	route.POST(":id/branches/:branch/revert", controller.revertContent)
+	route.POST(":id/branches/:branch/commits", controller.createCommit)
+	route.GET(":id/branches/:branch/merge", controller.getMergePreview)
+	route.POST(":id/branches/:branch/merge", controller.mergeBranch)
+	route.POST(":id/branches/:branch/merge/resolve", controller.resolveMerge)
+	route.POST(":id/tags", controller.createTag)
+	route.GET(":id/tags", controller.getContentTags)
+	route.DELETE(":id/tags/:tag", controller.deleteTag)
+	route.POST(":id/schedules", controller.createSchedule)
+	route.GET(":id/schedules", controller.getSchedules)
+	route.POST(":id/schedules/:scheduleId/cancel", controller.cancelSchedule)
+
+	controller.routerGroup.GET("/tenants/:tenantId/tags", controller.getTags)
+
+	publicRoute := controller.routerGroup.Group("/public/tenants/:tenantId/:contentType/contents")
+	publicRoute.GET("", controller.getPublishedContents)
+	publicRoute.GET(":id", controller.getPublishedContent)
 }
 
 func (controller ContentController) createBulkContents(ctx *gin.Context) {
@@ -57,8 +108,10 @@ func (controller ContentController) createBulkContents(ctx *gin.Context) {
 		return
 	}
 
+	// every content is validated so that all the invalid ones are reported, none is created when one is invalid
+	validationErrors := make([]dtos.ContentValidationError, 0)
 	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
-		for _, c := range contents {
+		for i, c := range contents {
 			command := commands.CreateContentCommand{
 				TenantID:    tenantId,
 				AggregateID: uuid.New().String(),
@@ -67,15 +120,34 @@ func (controller ContentController) createBulkContents(ctx *gin.Context) {
 			}
 
 			err := controller.contentService.Commands.CreateContent.Handle(ctx, command)
+			var schemaViolation *content.SchemaViolationError
+			if errors.As(err, &schemaViolation) {
+				index := i
+				validationErrors = append(validationErrors, toContentValidationError(schemaViolation, &index))
+				continue
+			}
 			if err != nil {
 				return err
 			}
 		}
 
+		if len(validationErrors) > 0 {
+			return content.ErrSchemaViolation
+		}
 		return nil
 	})
 
 	if err != nil {
+		if errors.Is(err, content.ErrSchemaViolation) {
+			ctx.JSON(http.StatusUnprocessableEntity, validationErrors)
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+			ctx.Status(http.StatusConflict)
+			return
+		}
+
 		foundation.GinErrorHandler().InternalServerError(ctx, err)
 		return
 	}
@@ -110,6 +182,15 @@ func (controller ContentController) createContent(ctx *gin.Context) {
 	})
 
 	if err != nil {
+		if respondSchemaViolation(ctx, err) {
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+			ctx.Status(http.StatusConflict)
+			return
+		}
+
 		foundation.GinErrorHandler().InternalServerError(ctx, err)
 		return
 	}
@@ -130,7 +211,19 @@ func (controller ContentController) getContent(ctx *gin.Context) {
 		return
 	}
 
-	contentProjection, err := controller.contentQuery.GetContent(ctx.Request.Context(), tenantId, id)
+	if len(ctx.Query("version")) > 0 || len(ctx.Query("asOf")) > 0 || len(ctx.Query("ref")) > 0 {
+		controller.getContentAtPointInTime(ctx, tenantId, id)
+		return
+	}
+
+	locale, ok := localeParam(ctx)
+	if !ok {
+		return
+	}
+
+	includeDeleted := ctx.Query("includeDeleted") == "true"
+
+	contentProjection, err := controller.contentQuery.GetContent(ctx.Request.Context(), tenantId, id, includeDeleted)
 	if err != nil {
 		if errors.Is(err, persistence.ErrRecordNotFound) {
 			ctx.Status(http.StatusNotFound)
@@ -141,11 +234,34 @@ func (controller ContentController) getContent(ctx *gin.Context) {
 	}
 
 	contentDetails := dtos.ContentDetails{
-		Id:          contentProjection.Id,
-		Content:     contentProjection.Content,
-		ContentType: contentProjection.ContentType,
-		CreatedAt:   contentProjection.CreatedAt,
-		UpdatedAt:   contentProjection.UpdatedAt,
+		Id:               contentProjection.Id,
+		Content:          contentProjection.Content,
+		ContentType:      contentProjection.ContentType,
+		Status:           contentProjection.Status,
+		StatusReason:     contentProjection.StatusReason,
+		PublishedVersion: contentProjection.PublishedVersion,
+		CreatedAt:        contentProjection.CreatedAt,
+		UpdatedAt:        contentProjection.UpdatedAt,
+		DeletedAt:        contentProjection.DeletedTime(),
+		PurgedAt:         contentProjection.PurgedAt,
+	}
+
+	localeCompleteness, err := controller.contentQuery.GetLocaleCompleteness(ctx.Request.Context(), tenantId,
+		contentProjection.ContentType, contentProjection.Content)
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+	contentDetails.LocaleCompleteness = toLocaleCompleteness(localeCompleteness)
+
+	if len(locale) > 0 {
+		contentDetails.Content, contentDetails.FieldLocales, err = controller.contentQuery.LocalizeContent(ctx.Request.Context(),
+			tenantId, contentProjection.ContentType, contentProjection.Content, locale)
+		if err != nil {
+			foundation.GinErrorHandler().InternalServerError(ctx, err)
+			return
+		}
+		contentDetails.Locale = locale
 	}
 
 	contentDetails.FieldComments = make([]dtos.ContentDetailsFieldComment, 0)
@@ -157,20 +273,7 @@ func (controller ContentController) getContent(ctx *gin.Context) {
 			Field: fieldName,
 		}
 
-		comments := make([]dtos.ContentDetailsComment, 0)
-		for _, comment := range group {
-			comments = append(comments, dtos.ContentDetailsComment{
-				Id:            comment.ID,
-				Comment:       comment.Comment,
-				CreatedAt:     comment.CreatedAt,
-				CreatedById:   comment.CreatedById,
-				CreatedByName: comment.CreatedByName,
-			})
-		}
-		sort.Slice(comments, func(i, j int) bool {
-			return comments[i].Id < comments[j].Id
-		})
-		fieldComment.Comments = comments
+		fieldComment.Comments = toCommentThreads(group)
 		contentDetails.FieldComments = append(contentDetails.FieldComments, fieldComment)
 	}
 
@@ -213,30 +316,46 @@ func (controller ContentController) getContents(ctx *gin.Context) {
 
 	pageable := dtos.NewPageableFromRequest(ctx)
 	sortable := dtos.NewSortFromRequest(ctx)
+	filter := dtos.ContentFilter{
+		IncludeDeleted: ctx.Query("includeDeleted") == "true",
+	}
+	if status := ctx.Query("status"); status != "" {
+		if _, err := content.ParseStatus(status); err != nil {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.Status = status
+	}
 
-	contentProjections, totalCount, err := controller.contentQuery.GetContents(ctx.Request.Context(), tenantId, pageable, sortable)
+	contentProjections, totalCount, err := controller.contentQuery.GetContents(ctx.Request.Context(), tenantId, pageable, sortable, filter)
 	if err != nil {
 		foundation.GinErrorHandler().InternalServerError(ctx, err)
 		return
 	}
 
+	pageResult := dtos.PageResult[[]dtos.ContentSummary]{
+		Result:     toContentSummaries(contentProjections),
+		TotalCount: totalCount,
+	}
+
+	ctx.JSON(http.StatusOK, pageResult)
+}
+
+func toContentSummaries(contentProjections []projections.ContentProjection) []dtos.ContentSummary {
 	contentSummaries := make([]dtos.ContentSummary, 0)
 	for _, contentEntity := range contentProjections {
 		contentSummaries = append(contentSummaries, dtos.ContentSummary{
 			Id:          contentEntity.Id,
 			Content:     contentEntity.Content,
 			ContentType: contentEntity.ContentType,
+			Status:      contentEntity.Status,
 			CreatedAt:   contentEntity.CreatedAt,
 			UpdatedAt:   contentEntity.UpdatedAt,
+			DeletedAt:   contentEntity.DeletedTime(),
+			PurgedAt:    contentEntity.PurgedAt,
 		})
 	}
-
-	pageResult := dtos.PageResult[[]dtos.ContentSummary]{
-		Result:     contentSummaries,
-		TotalCount: totalCount,
-	}
-
-	ctx.JSON(http.StatusOK, pageResult)
+	return contentSummaries
 }
 
 func (controller ContentController) updateContentField(ctx *gin.Context) {
@@ -247,14 +366,27 @@ func (controller ContentController) updateContentField(ctx *gin.Context) {
 	}
 
 	id := ctx.Param("id")
-	fieldName := ctx.Param("fieldName")
+	fieldName := fieldNameParam(ctx)
 	if len(id) == 0 || len(fieldName) == 0 {
 		ctx.JSON(http.StatusBadRequest, "id and fieldName are required")
 		return
 	}
+	branch := ctx.Param("branch")
+	locale, ok := localeParam(ctx)
+	if !ok {
+		return
+	}
 
+	// the translation of a locale may be missing before or after the update, so a localized update requires no values
 	var updateField dtos.ContentUpdateField
-	if err := ctx.BindJSON(&updateField); err != nil {
+	if len(locale) > 0 {
+		var localizedUpdateField dtos.ContentLocalizedFieldUpdate
+		if err := ctx.BindJSON(&localizedUpdateField); err != nil {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+		updateField = dtos.ContentUpdateField(localizedUpdateField)
+	} else if err := ctx.BindJSON(&updateField); err != nil {
 		ctx.JSON(http.StatusBadRequest, err.Error())
 		return
 	}
@@ -263,7 +395,9 @@ func (controller ContentController) updateContentField(ctx *gin.Context) {
 		command := commands.UpdateContentFieldCommand{
 			AggregateID:   id,
 			TenantId:      tenantId,
+			Branch:        branch,
 			FieldName:     fieldName,
+			Locale:        locale,
 			BeforeValue:   updateField.BeforeValue,
 			AfterValue:    updateField.AfterValue,
 			CreatedById:   updateField.CreatedById,
@@ -274,16 +408,31 @@ func (controller ContentController) updateContentField(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		if errors.Is(err, persistence.ErrRecordNotFound) || errors.Is(err, content.ErrFieldNotFound) {
+		if respondSchemaViolation(ctx, err) {
+			return
+		}
+
+		if errors.Is(err, content.ErrInvalidBranchName) || errors.Is(err, content.ErrInvalidFieldName) ||
+			errors.Is(err, content.ErrFieldNotLocalized) {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if errors.Is(err, persistence.ErrRecordNotFound) || errors.Is(err, eventsourcing.ErrAggregateNotFound) || errors.Is(err, content.ErrFieldNotFound) {
 			ctx.Status(http.StatusNotFound)
 			return
 		}
 
-		if errors.Is(err, content.ErrFieldUpdateConflict) {
+		if errors.Is(err, content.ErrFieldUpdateConflict) || errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
 			ctx.Status(http.StatusConflict)
 			return
 		}
 
+		if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
 		foundation.GinErrorHandler().InternalServerError(ctx, err)
 		return
 	}
@@ -299,23 +448,31 @@ func (controller ContentController) addFieldComment(ctx *gin.Context) {
 	}
 
 	id := ctx.Param("id")
-	fieldName := ctx.Param("fieldName")
+	fieldName := fieldNameParam(ctx)
 	if len(id) == 0 || len(fieldName) == 0 {
 		ctx.JSON(http.StatusBadRequest, "id and fieldName are required")
 		return
 	}
 
+	locale, ok := localeParam(ctx)
+	if !ok {
+		return
+	}
+
 	var fieldComment dtos.ContentFieldComment
 	if err := ctx.BindJSON(&fieldComment); err != nil {
 		ctx.JSON(http.StatusBadRequest, err.Error())
 		return
 	}
 
+	commentId := uuid.New().String()
 	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
 		command := commands.AddContentFieldCommentCommand{
 			AggregateID:   id,
 			TenantId:      tenantId,
+			CommentId:     commentId,
 			FieldName:     fieldName,
+			Locale:        locale,
 			Comment:       fieldComment.Comment,
 			CreatedById:   fieldComment.CreatedById,
 			CreatedByName: fieldComment.CreatedByName,
@@ -325,11 +482,26 @@ func (controller ContentController) addFieldComment(ctx *gin.Context) {
 	})
 
 	if err != nil {
-		if errors.Is(err, persistence.ErrRecordNotFound) || errors.Is(err, content.ErrFieldNotFound) {
+		if errors.Is(err, content.ErrInvalidFieldName) || errors.Is(err, content.ErrInvalidComment) {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if errors.Is(err, persistence.ErrRecordNotFound) || errors.Is(err, eventsourcing.ErrAggregateNotFound) || errors.Is(err, content.ErrFieldNotFound) {
 			ctx.Status(http.StatusNotFound)
 			return
 		}
 
+		if errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+			ctx.Status(http.StatusConflict)
+			return
+		}
+
+		if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
 		foundation.GinErrorHandler().InternalServerError(ctx, err)
 		return
 	}
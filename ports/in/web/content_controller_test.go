@@ -1,8 +1,12 @@
 package web
 
 import (
+	"contentgit/foundation"
+	"contentgit/ports/out/messaging/consumer"
+	"contentgit/ports/out/persistance/eventsourcing"
 	"contentgit/testdata/testserver"
 	"contentgit/testdata/testsuite"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -99,23 +103,28 @@ func (suite *ContentControllerTestSuite) TestGetContent() {
 			"liveShowInventoryQuantity": "2000",
 		},
 		"contentType": "products",
+		"status":      "draft",
 		"fieldComments": []any{
 			map[string]any{
 				"field": "price",
 				"comments": []any{
 					map[string]any{
 						"id":            float64(1),
+						"commentId":     "3",
 						"comment":       "금액 결정되었나요?",
 						"createdAt":     "1982-01-05T00:00:00+09:00",
 						"createdById":   "1",
 						"createdByName": "사이트 관리자",
+						"resolved":      false,
 					},
 					map[string]any{
 						"id":            float64(2),
+						"commentId":     "4",
 						"comment":       "네 250000으로 결정되었네요.",
 						"createdAt":     "1982-01-06T00:00:00+09:00",
 						"createdById":   "2",
 						"createdByName": "김영희",
+						"resolved":      false,
 					},
 				},
 			},
@@ -124,10 +133,12 @@ func (suite *ContentControllerTestSuite) TestGetContent() {
 				"comments": []any{
 					map[string]any{
 						"id":            float64(3),
+						"commentId":     "6",
 						"comment":       "이미지는 https://gdimg.gmarket.co.kr/2367233519/still/280?ver=1645526559 이것으로 해주세요",
 						"createdAt":     "1982-01-07T00:00:00+09:00",
 						"createdById":   "3",
 						"createdByName": "이수민",
+						"resolved":      false,
 					},
 				},
 			},
@@ -198,6 +209,7 @@ func (suite *ContentControllerTestSuite) TestGetContents_페이징() {
 			map[string]any{
 				"id":          "074c7322-e7fa-4d5c-8938-8dbe0ce67465",
 				"contentType": "products",
+				"status":      "draft",
 				"content": map[string]any{
 					"name":      "불스원샷",
 					"mainImage": "https://gdimg.gmarket.co.kr/2367233519/still/280?ver=1645526559",
@@ -235,6 +247,7 @@ func (suite *ContentControllerTestSuite) TestGetContents_Sort_By_Desc_CreatedAt(
 			map[string]any{
 				"id":          "6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd",
 				"contentType": "products",
+				"status":      "draft",
 				"content": map[string]any{
 					"name":                      "2024 최신형 공기 살균기",
 					"mainImage":                 "https://cdn.011st.com/11dims/resize/600x600/quality/75/11src/product/5966707693/B.jpg?920000000",
@@ -248,6 +261,7 @@ func (suite *ContentControllerTestSuite) TestGetContents_Sort_By_Desc_CreatedAt(
 			map[string]any{
 				"id":          "074c7322-e7fa-4d5c-8938-8dbe0ce67465",
 				"contentType": "products",
+				"status":      "draft",
 				"content": map[string]any{
 					"name":      "불스원샷",
 					"mainImage": "https://gdimg.gmarket.co.kr/2367233519/still/280?ver=1645526559",
@@ -364,3 +378,1686 @@ func (suite *ContentControllerTestSuite) TestAddFieldComment_NotFound_Field() {
 	// then
 	suite.Equal(http.StatusNotFound, rec.Code)
 }
+
+func (suite *ContentControllerTestSuite) TestReplyToFieldComment() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+	requestBody := `{
+		"comment": "확인했습니다.",
+		"createdById": "3",
+		"createdByName": "이수민"
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/field-comments/3/replies", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.NotEmpty(actual["id"])
+}
+
+func (suite *ContentControllerTestSuite) TestReplyToFieldComment_존재하지_않는_댓글이면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+	requestBody := `{
+		"comment": "확인했습니다.",
+		"createdById": "3",
+		"createdByName": "이수민"
+	}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/field-comments/unknown/replies", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestEditFieldComment() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+	requestBody := `{
+		"comment": "금액이 결정되었나요?",
+		"createdById": "1",
+		"createdByName": "사이트 관리자"
+	}`
+
+	req := httptest.NewRequest(http.MethodPut, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/field-comments/3", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestEditFieldComment_작성자가_아니면_Forbidden을_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+	requestBody := `{
+		"comment": "금액이 결정되었나요?",
+		"createdById": "2",
+		"createdByName": "김영희"
+	}`
+
+	req := httptest.NewRequest(http.MethodPut, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/field-comments/3", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusForbidden, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestDeleteFieldComment() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+	requestBody := `{
+		"createdById": "3",
+		"createdByName": "이수민"
+	}`
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/field-comments/6", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestResolveFieldComment() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+	requestBody := `{
+		"createdById": "2",
+		"createdByName": "김영희"
+	}`
+
+	resolve := func() int {
+		req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/field-comments/3/resolve", strings.NewReader(requestBody))
+		rec := httptest.NewRecorder()
+		sut.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// when
+	first := resolve()
+	second := resolve()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/field-comments/3/reopen", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, first)
+	suite.Equal(http.StatusConflict, second)
+	suite.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestCreateBranch() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"name": "black-friday",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465/branches", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestCreateBranch_이미_존재하는_branch이면_Conflict를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"name": "summer-sale",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/branches", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestCreateBranch_존재하지_않는_버전이면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"name": "black-friday",
+			"sourceVersion": 99,
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465/branches", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetBranches() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/branches", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+
+	expected := []any{
+		map[string]any{
+			"name":      "main",
+			"version":   float64(6),
+			"createdAt": "1982-01-07T00:00:00+09:00",
+			"updatedAt": "1982-01-07T00:00:00+09:00",
+		},
+		map[string]any{
+			"name":          "summer-sale",
+			"sourceBranch":  "main",
+			"sourceVersion": float64(6),
+			"version":       float64(2),
+			"createdAt":     "1982-01-08T00:00:00+09:00",
+			"updatedAt":     "1982-01-09T00:00:00+09:00",
+		},
+	}
+	suite.Equal(expected, actual)
+}
+
+func (suite *ContentControllerTestSuite) TestGetBranch() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/branches/summer-sale", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal("summer-sale", actual["branch"])
+	suite.Equal("main", actual["sourceBranch"])
+	suite.Equal(float64(6), actual["sourceVersion"])
+	suite.Equal("2024 최신형 공기 살균기", actual["content"].(map[string]any)["name"])
+}
+
+func (suite *ContentControllerTestSuite) TestUpdateBranchContentField() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"beforeValue": "2500",
+			"afterValue": "2000",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPut, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/branches/summer-sale/price", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetMergePreview() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/branches/summer-sale/merge", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+
+	expected := map[string]any{
+		"sourceBranch":  "summer-sale",
+		"sourceVersion": float64(2),
+		"targetBranch":  "main",
+		"targetVersion": float64(6),
+		"baseBranch":    "main",
+		"baseVersion":   float64(6),
+		"fields": []any{
+			map[string]any{
+				"field":       "price",
+				"beforeValue": "3000",
+				"afterValue":  "2500",
+			},
+		},
+		"conflicts": []any{},
+	}
+	suite.Equal(expected, actual)
+}
+
+func (suite *ContentControllerTestSuite) TestMergeBranch() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/branches/summer-sale/merge", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestResolveMerge_branch가_변경되었으면_Conflict를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"sourceVersion": 1,
+			"targetVersion": 6,
+			"resolutions": {"price": "2700"},
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/branches/summer-sale/merge/resolve", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetContent_버전을_지정하면_해당_버전의_컨텐츠를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd?version=2", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+
+	expected := map[string]any{
+		"id": "6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd",
+		"content": map[string]any{
+			"name":                      "최신형 공기 살균기",
+			"mainImage":                 "https://cdn.011st.com/11dims/resize/600x600/quality/75/11src/product/5966707693/B.jpg?920000000",
+			"price":                     "3000",
+			"taxRate":                   "10.2",
+			"liveShowInventoryQuantity": "2000",
+		},
+		"contentType":   "products",
+		"version":       float64(2),
+		"fieldComments": []any{},
+	}
+	suite.Equal(expected, actual)
+}
+
+func (suite *ContentControllerTestSuite) TestGetContent_시점을_지정하면_해당_시점의_컨텐츠를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd?asOf=1982-01-05T12:00:00%2B09:00", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(3), actual["version"])
+	suite.Equal("최신형 공기 살균기", actual["content"].(map[string]any)["name"])
+	suite.Equal([]any{
+		map[string]any{
+			"field": "price",
+			"comments": []any{
+				map[string]any{
+					"comment":       "금액 결정되었나요?",
+					"createdById":   "1",
+					"createdByName": "사이트 관리자",
+				},
+			},
+		},
+	}, actual["fieldComments"])
+}
+
+func (suite *ContentControllerTestSuite) TestGetContent_존재하지_않는_버전이면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd?version=99", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetContent_버전_형식이_잘못되면_BadRequest를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd?version=latest", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetContentDiff() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/diff?from=1&to=5", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+
+	expected := map[string]any{
+		"from":    float64(1),
+		"to":      float64(5),
+		"added":   []any{},
+		"removed": []any{},
+		"changed": []any{
+			map[string]any{
+				"field":       "name",
+				"beforeValue": "살균기",
+				"afterValue":  "2024 최신형 공기 살균기",
+			},
+		},
+	}
+	suite.Equal(expected, actual)
+}
+
+func (suite *ContentControllerTestSuite) TestGetContentDiff_JSON_Patch_형식으로_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/diff?from=1&format=json-patch", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal("application/json-patch+json", rec.Header().Get("Content-Type"))
+
+	var actual any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal([]any{
+		map[string]any{"op": "replace", "path": "/name", "value": "2024 최신형 공기 살균기"},
+	}, actual)
+}
+
+func (suite *ContentControllerTestSuite) TestGetContentDiff_from이_없으면_BadRequest를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/diff?to=5", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestRevertContent() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"version": 1,
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/revert", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd?version=7", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+
+	suite.Equal(http.StatusOK, rec.Code)
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal("살균기", actual["content"].(map[string]any)["name"])
+	suite.Equal(2, len(actual["fieldComments"].([]any)))
+}
+
+func (suite *ContentControllerTestSuite) TestRevertContent_존재하지_않는_버전이면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"version": 99,
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/revert", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestCreateCommit() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"message": "여름 할인가 반영",
+			"parentVersion": 6,
+			"fields": [
+				{"field": "price", "beforeValue": "3000", "afterValue": "2500"},
+				{"field": "taxRate", "beforeValue": "10.2", "afterValue": "10"}
+			],
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/commits", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.NotEmpty(actual["id"])
+}
+
+func (suite *ContentControllerTestSuite) TestCreateCommit_parent_버전이_다르면_Conflict를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"message": "여름 할인가 반영",
+			"parentVersion": 5,
+			"fields": [
+				{"field": "price", "beforeValue": "3000", "afterValue": "2500"}
+			],
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/commits", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetCommits() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/yuren/products/contents/03ab7edb-881b-49f8-848a-3e8266376ffe/commits", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual []map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(1, len(actual))
+	suite.Equal("9b1d6c2e-7f3a-4a51-9c0e-2d8f4b6a1e37", actual[0]["id"])
+	suite.Equal("main", actual[0]["branch"])
+	suite.Equal(float64(2), actual[0]["version"])
+	suite.Equal(float64(1), actual[0]["parentVersion"])
+	suite.Equal("상품명 정리", actual[0]["message"])
+	suite.Equal([]any{
+		map[string]any{"field": "name", "beforeValue": "링셀 수분 단백질 크림", "afterValue": "링셀 수분 단백질 크림 50ml"},
+	}, actual[0]["fields"])
+	suite.Equal("박지민", actual[0]["createdByName"])
+}
+
+func (suite *ContentControllerTestSuite) TestCreateTag() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"name": "release-1982-02",
+			"version": 5,
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/tags", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestCreateTag_이미_존재하는_태그면_Conflict를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"name": "release-1982-01",
+			"version": 5,
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/tags", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetContent_태그를_지정하면_태그된_버전의_컨텐츠를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd?ref=release-1982-01", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(2), actual["version"])
+	suite.Equal("최신형 공기 살균기", actual["content"].(map[string]any)["name"])
+}
+
+func (suite *ContentControllerTestSuite) TestCreateTag_다른_테넌트의_컨텐츠면_태그하지도_조회하지도_못한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"name": "stolen",
+			"version": 2,
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/atlas/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/tags", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/atlas/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd?ref=stolen", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetContentTags() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/tags", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual []map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(1, len(actual))
+	suite.Equal("release-1982-01", actual[0]["name"])
+	suite.Equal("main", actual[0]["branch"])
+	suite.Equal(float64(2), actual[0]["version"])
+}
+
+func (suite *ContentControllerTestSuite) TestGetTags() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/tags", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(1), actual["totalCount"])
+	suite.Equal("6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd", actual["result"].([]any)[0].(map[string]any)["contentId"])
+}
+
+func (suite *ContentControllerTestSuite) TestDeleteTag() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/tags/release-1982-01", nil)
+	req.Header.Set("X-User-Role", "admin")
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestDeleteTag_관리자가_아니면_Forbidden을_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/tags/release-1982-01", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusForbidden, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetContentBlame() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/blame", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual []map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(5, len(actual))
+
+	blames := make(map[string]map[string]any)
+	for _, blame := range actual {
+		blames[blame["field"].(string)] = blame
+	}
+	suite.Equal(float64(5), blames["name"]["version"])
+	suite.Equal("2", blames["name"]["createdById"])
+	suite.Equal("김영희", blames["name"]["createdByName"])
+	suite.Equal(float64(1), blames["price"]["version"])
+	suite.Equal("", blames["price"]["createdByName"])
+}
+
+func (suite *ContentControllerTestSuite) TestGetContentBlame_존재하지_않는_컨텐츠면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/yuren/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/blame", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestAddContentField() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"field": "origin",
+			"value": "대한민국",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/fields", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestAddContentField_이미_존재하는_필드면_Conflict를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"field": "price",
+			"value": "1000",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/fields", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestRemoveContentField() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/price", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd?version=7", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.NotContains(actual["content"].(map[string]any), "price")
+	suite.Equal("price", actual["fieldComments"].([]any)[0].(map[string]any)["field"])
+}
+
+func (suite *ContentControllerTestSuite) TestRemoveContentField_존재하지_않는_필드면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/origin", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestUpdateContentField_JSON_Pointer로_중첩된_필드를_업데이트한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	addRequestBody := `{
+			"field": "variants",
+			"value": [{"color": "white", "price": "3000"}, {"color": "black", "price": "3500"}],
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/fields", strings.NewReader(addRequestBody))
+	rec := httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusCreated, rec.Code)
+
+	requestBody := `{
+			"beforeValue": "3500",
+			"afterValue": "3200",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req = httptest.NewRequest(http.MethodPut, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/fields/variants/1/price", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPut, "/api/tenants/bettercode/products/contents/6f3bbc99-55aa-4340-89f6-1ddd4dfdb8cd/fields/variants/2/price", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestDeleteContent() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusGone, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestDeleteContent_다른_테넌트의_컨텐츠면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tenants/atlas/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusOK, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestUpdateContentField_삭제된_컨텐츠면_Gone을_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"beforeValue": "45000",
+			"afterValue": "40000",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPut, "/api/tenants/bettercode/products/contents/5e2d9a41-3c7b-4f0e-9a1d-8b6c2f4e7a90/price", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusGone, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetContent_삭제된_컨텐츠는_includeDeleted일_때만_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/5e2d9a41-3c7b-4f0e-9a1d-8b6c2f4e7a90", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/5e2d9a41-3c7b-4f0e-9a1d-8b6c2f4e7a90?includeDeleted=true", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal("1982-01-11T00:00:00+09:00", actual["deletedAt"])
+}
+
+func (suite *ContentControllerTestSuite) TestGetContents_includeDeleted이면_삭제된_컨텐츠를_포함한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents?includeDeleted=true", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(3), actual["totalCount"])
+}
+
+func (suite *ContentControllerTestSuite) TestGetTrash() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/trash", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(1), actual["totalCount"])
+	suite.Equal("5e2d9a41-3c7b-4f0e-9a1d-8b6c2f4e7a90", actual["result"].([]any)[0].(map[string]any)["id"])
+}
+
+func (suite *ContentControllerTestSuite) TestRestoreContent() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/5e2d9a41-3c7b-4f0e-9a1d-8b6c2f4e7a90/restore", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/5e2d9a41-3c7b-4f0e-9a1d-8b6c2f4e7a90/restore", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestPurgeContent() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465/purge", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465/purge", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusGone, rec.Code)
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusGone, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestPurgeContent_존재하지_않는_컨텐츠면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/00000000-0000-0000-0000-000000000000/purge", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestPurgeContent_다른_테넌트의_컨텐츠면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/atlas/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465/purge", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusOK, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestSubmitContent() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465/submit", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestPublishContent_승인되지_않은_컨텐츠면_Conflict를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465/publish", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestRejectContent_사유가_없으면_BadRequest를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "5",
+			"createdByName": "박지민"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/yuren/products/contents/03ab7edb-881b-49f8-848a-3e8266376ffe/reject", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusBadRequest, rec.Code)
+
+	requestBody = `{
+			"reason": "상품명을 확인해 주세요",
+			"createdById": "5",
+			"createdByName": "박지민"
+		}`
+	req = httptest.NewRequest(http.MethodPost, "/api/tenants/yuren/products/contents/03ab7edb-881b-49f8-848a-3e8266376ffe/reject", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetContents_상태로_필터링한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/yuren/products/contents?status=in_review", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(1), actual["totalCount"])
+	suite.Equal("in_review", actual["result"].([]any)[0].(map[string]any)["status"])
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/yuren/products/contents?status=draft", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(0), actual["totalCount"])
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/yuren/products/contents?status=archived", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetPublishedContent_게시된_버전을_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/public/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(map[string]any{"name": "봄 신상 원피스", "price": "39000"}, actual["content"])
+	suite.Equal(float64(3), actual["version"])
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(map[string]any{"name": "봄 신상 원피스", "price": "35000"}, actual["content"])
+	suite.Equal("draft", actual["status"])
+	suite.Equal(float64(3), actual["publishedVersion"])
+}
+
+func (suite *ContentControllerTestSuite) TestGetPublishedContent_게시되지_않은_컨텐츠면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/public/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetPublishedContents() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/public/tenants/mellow/dresses/contents", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(1), actual["totalCount"])
+	suite.Equal("39000", actual["result"].([]any)[0].(map[string]any)["content"].(map[string]any)["price"])
+}
+
+func (suite *ContentControllerTestSuite) TestCreateSchedule() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"transition": "unpublish",
+			"runAt": "2099-11-01T09:00:00+09:00",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465/schedules", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+
+	var created map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &created)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/products/contents/074c7322-e7fa-4d5c-8938-8dbe0ce67465/schedules", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+
+	var actual []map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Len(actual, 1)
+	suite.Equal(created["id"], actual[0]["id"])
+	suite.Equal("unpublish", actual[0]["transition"])
+	suite.Equal("pending", actual[0]["state"])
+	suite.Equal("2099-11-01T09:00:00+09:00", actual[0]["runAt"])
+}
+
+func (suite *ContentControllerTestSuite) TestCreateSchedule_잘못된_예약이면_BadRequest나_Conflict를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	pastRequestBody := `{
+			"transition": "unpublish",
+			"runAt": "1982-01-01T09:00:00+09:00",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+	staleVersionRequestBody := `{
+			"transition": "publish",
+			"version": 1,
+			"runAt": "2099-11-01T09:00:00+09:00",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	// when
+	pastReq := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/schedules", strings.NewReader(pastRequestBody))
+	pastRec := httptest.NewRecorder()
+	sut.ServeHTTP(pastRec, pastReq)
+
+	staleVersionReq := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/schedules", strings.NewReader(staleVersionRequestBody))
+	staleVersionRec := httptest.NewRecorder()
+	sut.ServeHTTP(staleVersionRec, staleVersionReq)
+
+	// then
+	suite.Equal(http.StatusBadRequest, pastRec.Code)
+	suite.Equal(http.StatusConflict, staleVersionRec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestCancelSchedule() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/schedules/c93e5a70-8b1f-4d2c-a6e4-5f7d1b3a9c82/cancel", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/schedules/c93e5a70-8b1f-4d2c-a6e4-5f7d1b3a9c82/cancel", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusConflict, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/dresses/contents/8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34/schedules/unknown/cancel", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestCreateContent_존재하지_않는_컨텐츠를_참조하면_UnprocessableEntity를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{"name": "스탠드 조명", "brand": {"id": "5c2e7a9d-3b1f-4d6e-a804-9f1b3d5e7a26", "contentType": "brands"}}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/lumen/products/contents", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusUnprocessableEntity, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal([]any{
+		map[string]any{"field": "brand", "message": "references brands 5c2e7a9d-3b1f-4d6e-a804-9f1b3d5e7a26 that does not exist"},
+	}, actual["errors"])
+
+	requestBody = `{"name": "스탠드 조명", "brand": {"id": "6a1e3c5b-0d2f-4b7a-9e84-3c5f7a9b1d20", "contentType": "brands"}}`
+	req = httptest.NewRequest(http.MethodPost, "/api/tenants/lumen/products/contents", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusCreated, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestCreateContent_다른_테넌트의_컨텐츠를_참조하면_UnprocessableEntity를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{"name": "스탠드 조명", "brand": {"id": "7b3d5f9a-2c4e-4a6b-8d1f-3e5a7c9b1d42", "contentType": "brands"}}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/lumen/products/contents", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusUnprocessableEntity, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal([]any{
+		map[string]any{"field": "brand", "message": "references brands 7b3d5f9a-2c4e-4a6b-8d1f-3e5a7c9b1d42 that does not exist"},
+	}, actual["errors"])
+}
+
+func (suite *ContentControllerTestSuite) TestUpdateContentField_다른_타입의_컨텐츠를_참조하면_UnprocessableEntity를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"beforeValue": {"id": "6a1e3c5b-0d2f-4b7a-9e84-3c5f7a9b1d20", "contentType": "brands"},
+			"afterValue": {"id": "9e2b4d6f-1a3c-4e5b-8d70-4b6d8f0a2c31", "contentType": "products"},
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPut, "/api/tenants/lumen/products/contents/9e2b4d6f-1a3c-4e5b-8d70-4b6d8f0a2c31/brand", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusUnprocessableEntity, rec.Code)
+	suite.Contains(rec.Body.String(), "must reference a content of type brands")
+}
+
+func (suite *ContentControllerTestSuite) TestGetReferencedBy() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/lumen/brands/contents/6a1e3c5b-0d2f-4b7a-9e84-3c5f7a9b1d20/referenced-by", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual []any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal([]any{
+		map[string]any{
+			"contentId":   "9e2b4d6f-1a3c-4e5b-8d70-4b6d8f0a2c31",
+			"contentType": "products",
+			"field":       "brand",
+			"version":     float64(1),
+			"updatedAt":   "1982-04-02T00:00:00+09:00",
+		},
+	}, actual)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/lumen/brands/contents/5c2e7a9d-3b1f-4d6e-a804-9f1b3d5e7a26/referenced-by", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestDeleteContent_다른_컨텐츠가_참조하면_Conflict를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tenants/lumen/brands/contents/6a1e3c5b-0d2f-4b7a-9e84-3c5f7a9b1d20", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestDeleteContent_참조를_지우면_삭제할_수_있다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tenants/lumen/products/contents/9e2b4d6f-1a3c-4e5b-8d70-4b6d8f0a2c31/brand", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	// the reverse-reference index follows the removal of the field
+	ctx := foundation.ContextProvider().SetDB(context.Background(), sut.GetDB())
+	suite.NoError(sut.GetComponent("ReferenceEventHandler").(consumer.EventHandler).Handle(ctx, eventsourcing.Event{
+		AggregateID:   "9e2b4d6f-1a3c-4e5b-8d70-4b6d8f0a2c31",
+		TenantId:      "lumen",
+		Branch:        eventsourcing.DefaultBranch,
+		AggregateType: "Content",
+		EventType:     "CONTENT_FIELD_REMOVED_V1",
+		Version:       2,
+	}))
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/tenants/lumen/brands/contents/6a1e3c5b-0d2f-4b7a-9e84-3c5f7a9b1d20", strings.NewReader(requestBody))
+	rec = httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestGetContent_로케일을_지정하면_대체_로케일의_값으로_읽는다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/atlas/guides/contents/2f7c9a1e-4b3d-4c8e-a5f6-7d9e1b3c5a80?locale=en-US", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(map[string]any{"slug": "getting-started", "title": "Getting started", "body": "본문"}, actual["content"])
+	suite.Equal("en-US", actual["locale"])
+	suite.Equal(map[string]any{"title": "en-US", "body": "ko-KR"}, actual["fieldLocales"])
+	suite.Equal([]any{
+		map[string]any{"locale": "ko-KR", "translated": float64(2), "total": float64(2), "complete": true, "missing": []any{}},
+		map[string]any{"locale": "en-US", "translated": float64(1), "total": float64(2), "complete": false, "missing": []any{"body"}},
+	}, actual["localeCompleteness"])
+}
+
+func (suite *ContentControllerTestSuite) TestGetContent_로케일이_없으면_모든_로케일의_값을_읽는다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/atlas/guides/contents/2f7c9a1e-4b3d-4c8e-a5f6-7d9e1b3c5a80", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(map[string]any{"ko-KR": "시작하기", "en-US": "Getting started"}, actual["content"].(map[string]any)["title"])
+	suite.Nil(actual["locale"])
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/atlas/guides/contents/2f7c9a1e-4b3d-4c8e-a5f6-7d9e1b3c5a80?locale=korean", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (suite *ContentControllerTestSuite) TestUpdateContentField_로케일의_값을_업데이트한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"beforeValue": null,
+			"afterValue": "Body",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPut, "/api/tenants/atlas/guides/contents/2f7c9a1e-4b3d-4c8e-a5f6-7d9e1b3c5a80/body?locale=en-US", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/atlas/guides/contents/2f7c9a1e-4b3d-4c8e-a5f6-7d9e1b3c5a80?version=2", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(map[string]any{"ko-KR": "본문", "en-US": "Body"}, actual["content"].(map[string]any)["body"])
+}
+
+func (suite *ContentControllerTestSuite) TestUpdateContentField_로케일별_값이_아닌_필드면_BadRequest를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"beforeValue": null,
+			"afterValue": "start",
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPut, "/api/tenants/atlas/guides/contents/2f7c9a1e-4b3d-4c8e-a5f6-7d9e1b3c5a80/slug?locale=en-US", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusBadRequest, rec.Code)
+}
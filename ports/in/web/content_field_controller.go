@@ -0,0 +1,156 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+func (controller ContentController) addContentField(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+	branch := ctx.Param("branch")
+
+	var fieldAdd dtos.ContentFieldAdd
+	if err := ctx.BindJSON(&fieldAdd); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.AddContentFieldCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			Branch:        branch,
+			FieldName:     fieldAdd.Field,
+			Value:         fieldAdd.Value,
+			CreatedById:   fieldAdd.CreatedById,
+			CreatedByName: fieldAdd.CreatedByName,
+		}
+
+		return controller.contentService.Commands.AddContentField.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if respondSchemaViolation(ctx, err) {
+			return
+		}
+
+		if errors.Is(err, content.ErrInvalidBranchName) || errors.Is(err, content.ErrInvalidFieldName) {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrAggregateNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, content.ErrFieldAlreadyExists) || errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+			ctx.Status(http.StatusConflict)
+			return
+		}
+
+		if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusCreated)
+}
+
+func (controller ContentController) removeContentField(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	fieldName := fieldNameParam(ctx)
+	if len(id) == 0 || len(fieldName) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id and fieldName are required")
+		return
+	}
+	branch := ctx.Param("branch")
+
+	var fieldRemove dtos.ContentFieldRemove
+	if err := ctx.BindJSON(&fieldRemove); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.RemoveContentFieldCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			Branch:        branch,
+			FieldName:     fieldName,
+			CreatedById:   fieldRemove.CreatedById,
+			CreatedByName: fieldRemove.CreatedByName,
+		}
+
+		return controller.contentService.Commands.RemoveContentField.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if respondSchemaViolation(ctx, err) {
+			return
+		}
+
+		if errors.Is(err, content.ErrInvalidBranchName) || errors.Is(err, content.ErrInvalidFieldName) {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrAggregateNotFound) || errors.Is(err, content.ErrFieldNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+			ctx.Status(http.StatusConflict)
+			return
+		}
+
+		if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// fieldNameParam returns the field addressed by the route: a top-level field name, or the JSON Pointer
+// of a nested value matched by the path wildcard, e.g. /variants/2/price.
+func fieldNameParam(ctx *gin.Context) string {
+	if fieldName := ctx.Param("fieldName"); len(fieldName) > 0 {
+		return fieldName
+	}
+	return ctx.Param("path")
+}
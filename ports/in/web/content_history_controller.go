@@ -0,0 +1,264 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// getContentAtPointInTime serves a content rebuilt from its event stream at ?version=, ?asOf= or the tag ?ref=.
+func (controller ContentController) getContentAtPointInTime(ctx *gin.Context, tenantId string, id string) {
+	var contentAggregate *content.ContentAggregate
+	var err error
+
+	if versionParam := ctx.Query("version"); len(versionParam) > 0 {
+		version, parseErr := strconv.ParseUint(versionParam, 10, 64)
+		if parseErr != nil || version == 0 {
+			ctx.JSON(http.StatusBadRequest, "version must be a positive integer")
+			return
+		}
+		contentAggregate, err = controller.contentQuery.GetContentAtVersion(ctx.Request.Context(), tenantId, id, version)
+	} else if ref := ctx.Query("ref"); len(ref) > 0 {
+		contentAggregate, err = controller.contentQuery.GetContentAtRef(ctx.Request.Context(), tenantId, id, ref)
+	} else {
+		asOf, parseErr := time.Parse(time.RFC3339, ctx.Query("asOf"))
+		if parseErr != nil {
+			ctx.JSON(http.StatusBadRequest, "asOf must be a RFC3339 timestamp")
+			return
+		}
+		contentAggregate, err = controller.contentQuery.GetContentAsOf(ctx.Request.Context(), tenantId, id, asOf)
+	}
+
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) || errors.Is(err, eventsourcing.ErrVersionNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	contentVersionDetails := dtos.ContentVersionDetails{
+		Id:            contentAggregate.GetID(),
+		Content:       contentAggregate.Content,
+		ContentType:   contentAggregate.ContentType,
+		Version:       contentAggregate.GetVersion(),
+		FieldComments: make([]dtos.ContentVersionFieldComment, 0),
+	}
+	for _, fieldComment := range contentAggregate.FieldComments {
+		comments := make([]dtos.ContentVersionComment, 0)
+		for _, comment := range fieldComment.Comments {
+			if comment.Deleted {
+				continue
+			}
+			comments = append(comments, dtos.ContentVersionComment{
+				Comment:       comment.Comment,
+				CreatedById:   comment.CreatedById,
+				CreatedByName: comment.CreatedByName,
+			})
+		}
+		contentVersionDetails.FieldComments = append(contentVersionDetails.FieldComments, dtos.ContentVersionFieldComment{
+			Field:    fieldComment.FieldName,
+			Comments: comments,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, contentVersionDetails)
+}
+
+// getContentDiff compares two versions of a content, ?format=json-patch answers with RFC 6902 operations.
+func (controller ContentController) getContentDiff(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	from, err := strconv.ParseUint(ctx.Query("from"), 10, 64)
+	if err != nil || from == 0 {
+		ctx.JSON(http.StatusBadRequest, "from must be a positive integer")
+		return
+	}
+
+	var to uint64
+	if toParam := ctx.Query("to"); len(toParam) > 0 {
+		to, err = strconv.ParseUint(toParam, 10, 64)
+		if err != nil || to == 0 {
+			ctx.JSON(http.StatusBadRequest, "to must be a positive integer")
+			return
+		}
+	}
+
+	format := ctx.DefaultQuery("format", "json")
+	if format != "json" && format != "json-patch" {
+		ctx.JSON(http.StatusBadRequest, "format must be json or json-patch")
+		return
+	}
+
+	fromAggregate, toAggregate, diff, err := controller.contentQuery.GetContentDiff(ctx.Request.Context(), tenantId, id, from, to)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) || errors.Is(err, eventsourcing.ErrVersionNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	if format == "json-patch" {
+		operations := make([]dtos.ContentPatchOperation, 0)
+		for _, operation := range diff.JSONPatch() {
+			patchOperation := dtos.ContentPatchOperation{Op: operation.Op, Path: operation.Path}
+			if operation.Op != "remove" {
+				value := operation.Value
+				patchOperation.Value = &value
+			}
+			operations = append(operations, patchOperation)
+		}
+		ctx.Header("Content-Type", "application/json-patch+json")
+		ctx.JSON(http.StatusOK, operations)
+		return
+	}
+
+	contentDiff := dtos.ContentDiff{
+		From:    fromAggregate.GetVersion(),
+		To:      toAggregate.GetVersion(),
+		Added:   make([]dtos.ContentDiffField, 0),
+		Removed: make([]dtos.ContentDiffField, 0),
+		Changed: make([]dtos.ContentChangedField, 0),
+	}
+	for _, field := range diff.Added {
+		contentDiff.Added = append(contentDiff.Added, dtos.ContentDiffField{Field: field.FieldName, Value: field.Value})
+	}
+	for _, field := range diff.Removed {
+		contentDiff.Removed = append(contentDiff.Removed, dtos.ContentDiffField{Field: field.FieldName, Value: field.Value})
+	}
+	for _, field := range diff.Changed {
+		contentDiff.Changed = append(contentDiff.Changed, dtos.ContentChangedField{Field: field.FieldName, BeforeValue: field.BeforeValue, AfterValue: field.AfterValue})
+	}
+
+	ctx.JSON(http.StatusOK, contentDiff)
+}
+
+// revertContent brings the fields of a content back to the values of an earlier version with a new event.
+func (controller ContentController) revertContent(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+	branch := ctx.Param("branch")
+
+	var contentRevert dtos.ContentRevert
+	if err := ctx.BindJSON(&contentRevert); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.RevertContentCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			Branch:        branch,
+			TargetVersion: contentRevert.Version,
+			CreatedById:   contentRevert.CreatedById,
+			CreatedByName: contentRevert.CreatedByName,
+		}
+
+		return controller.contentService.Commands.RevertContent.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if errors.Is(err, content.ErrInvalidBranchName) {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrAggregateNotFound) || errors.Is(err, eventsourcing.ErrVersionNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, content.ErrNothingToRevert) {
+			ctx.Status(http.StatusNoContent)
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+			ctx.Status(http.StatusConflict)
+			return
+		}
+
+		if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusCreated)
+}
+
+// getContentBlame serves the version and author that set the current value of every field, on ?branch= or the default branch.
+func (controller ContentController) getContentBlame(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+	branch := ctx.DefaultQuery("branch", eventsourcing.DefaultBranch)
+
+	fieldBlames, err := controller.contentQuery.GetContentBlame(ctx.Request.Context(), tenantId, id, branch)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) || errors.Is(err, eventsourcing.ErrAggregateNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	contentFieldBlames := make([]dtos.ContentFieldBlame, 0, len(fieldBlames))
+	for _, fieldBlame := range fieldBlames {
+		contentFieldBlames = append(contentFieldBlames, dtos.ContentFieldBlame{
+			Field:         fieldBlame.FieldName,
+			Version:       fieldBlame.Version,
+			Timestamp:     fieldBlame.Timestamp,
+			CreatedById:   fieldBlame.CreatedById,
+			CreatedByName: fieldBlame.CreatedByName,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, contentFieldBlames)
+}
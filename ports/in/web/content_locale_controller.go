@@ -0,0 +1,38 @@
+package web
+
+import (
+	"contentgit/domain/content"
+	"contentgit/dtos"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// localeParam reads the locale a request reads or writes localized fields in, none when the request has no locale.
+// It responds with 400 to an invalid locale.
+func localeParam(ctx *gin.Context) (string, bool) {
+	locale := ctx.Query("locale")
+	if len(locale) > 0 && !content.IsLocale(locale) {
+		ctx.JSON(http.StatusBadRequest, "invalid locale: "+locale)
+		return "", false
+	}
+	return locale, true
+}
+
+func toLocaleCompleteness(completeness []content.LocaleCompleteness) []dtos.ContentLocaleCompleteness {
+	if len(completeness) == 0 {
+		return nil
+	}
+
+	localeCompleteness := make([]dtos.ContentLocaleCompleteness, 0, len(completeness))
+	for _, localeCompletenessItem := range completeness {
+		localeCompleteness = append(localeCompleteness, dtos.ContentLocaleCompleteness{
+			Locale:     localeCompletenessItem.Locale,
+			Translated: localeCompletenessItem.Translated,
+			Total:      localeCompletenessItem.Total,
+			Complete:   localeCompletenessItem.IsComplete(),
+			Missing:    localeCompletenessItem.Missing,
+		})
+	}
+	return localeCompleteness
+}
@@ -0,0 +1,198 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+func (controller ContentController) getMergePreview(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	branch := ctx.Param("branch")
+	if len(id) == 0 || len(branch) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id and branch are required")
+		return
+	}
+	into := ctx.DefaultQuery("into", eventsourcing.DefaultBranch)
+
+	participants, mergeResult, err := controller.contentQuery.GetMergePreview(ctx.Request.Context(), tenantId, id, branch, into)
+	if err != nil {
+		controller.handleMergeError(ctx, err)
+		return
+	}
+
+	mergePreview := dtos.ContentMergePreview{
+		SourceBranch:  participants.Source.GetBranch(),
+		SourceVersion: participants.Source.GetVersion(),
+		TargetBranch:  participants.Target.GetBranch(),
+		TargetVersion: participants.Target.GetVersion(),
+		BaseBranch:    participants.Base.GetBranch(),
+		BaseVersion:   participants.Base.GetVersion(),
+		Fields:        make([]dtos.ContentMergeField, 0),
+		Conflicts:     toMergeFieldConflicts(mergeResult.Conflicts),
+	}
+	for _, field := range mergeResult.Fields {
+		mergePreview.Fields = append(mergePreview.Fields, dtos.ContentMergeField{
+			Field:       field.FieldName,
+			BeforeValue: field.BeforeValue,
+			AfterValue:  field.AfterValue,
+			Removed:     field.Removed,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, mergePreview)
+}
+
+func (controller ContentController) mergeBranch(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	branch := ctx.Param("branch")
+	if len(id) == 0 || len(branch) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id and branch are required")
+		return
+	}
+
+	var merge dtos.ContentMerge
+	if err := ctx.BindJSON(&merge); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.MergeContentBranchCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			SourceBranch:  branch,
+			TargetBranch:  merge.Into,
+			CreatedById:   merge.CreatedById,
+			CreatedByName: merge.CreatedByName,
+		}
+
+		return controller.contentService.Commands.MergeContentBranch.Handle(ctx, command)
+	})
+
+	if err != nil {
+		controller.handleMergeError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusCreated)
+}
+
+func (controller ContentController) resolveMerge(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	branch := ctx.Param("branch")
+	if len(id) == 0 || len(branch) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id and branch are required")
+		return
+	}
+
+	var mergeResolve dtos.ContentMergeResolve
+	if err := ctx.BindJSON(&mergeResolve); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.MergeContentBranchCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			SourceBranch:  branch,
+			TargetBranch:  mergeResolve.Into,
+			SourceVersion: mergeResolve.SourceVersion,
+			TargetVersion: mergeResolve.TargetVersion,
+			Resolutions:   mergeResolve.Resolutions,
+			Removals:      mergeResolve.Removals,
+			CreatedById:   mergeResolve.CreatedById,
+			CreatedByName: mergeResolve.CreatedByName,
+		}
+
+		return controller.contentService.Commands.MergeContentBranch.Handle(ctx, command)
+	})
+
+	if err != nil {
+		controller.handleMergeError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusCreated)
+}
+
+func (controller ContentController) handleMergeError(ctx *gin.Context, err error) {
+	var mergeConflictError *content.MergeConflictError
+	if errors.As(err, &mergeConflictError) {
+		ctx.JSON(http.StatusConflict, dtos.ContentMergeConflicts{Conflicts: toMergeFieldConflicts(mergeConflictError.Conflicts)})
+		return
+	}
+
+	if errors.Is(err, content.ErrInvalidBranchName) || errors.Is(err, content.ErrNoMergeBase) {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if respondSchemaViolation(ctx, err) {
+		return
+	}
+
+	if errors.Is(err, eventsourcing.ErrAggregateNotFound) || errors.Is(err, eventsourcing.ErrVersionNotFound) {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	if errors.Is(err, content.ErrNothingToMerge) {
+		ctx.Status(http.StatusNoContent)
+		return
+	}
+
+	if errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+		ctx.Status(http.StatusConflict)
+		return
+	}
+
+	if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+		ctx.Status(http.StatusGone)
+		return
+	}
+
+	foundation.GinErrorHandler().InternalServerError(ctx, err)
+}
+
+func toMergeFieldConflicts(conflicts []content.FieldConflict) []dtos.ContentMergeFieldConflict {
+	mergeFieldConflicts := make([]dtos.ContentMergeFieldConflict, 0)
+	for _, conflict := range conflicts {
+		mergeFieldConflicts = append(mergeFieldConflicts, dtos.ContentMergeFieldConflict{
+			Field:         conflict.FieldName,
+			BaseValue:     conflict.BaseValue,
+			SourceValue:   conflict.SourceValue,
+			TargetValue:   conflict.TargetValue,
+			SourceRemoved: conflict.SourceRemoved,
+			TargetRemoved: conflict.TargetRemoved,
+		})
+	}
+	return mergeFieldConflicts
+}
@@ -0,0 +1,118 @@
+package web
+
+import (
+	"contentgit/domain/content/projections"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// getPublishedContents serves the published contents of a content type to readers, drafts are never listed.
+func (controller ContentController) getPublishedContents(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	contentType := ctx.Param("contentType")
+	if len(tenantId) == 0 || len(contentType) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId and contentType are required")
+		return
+	}
+
+	locale, ok := localeParam(ctx)
+	if !ok {
+		return
+	}
+
+	pageable := dtos.NewPageableFromRequest(ctx)
+
+	publishedContents, totalCount, err := controller.contentQuery.GetPublishedContents(ctx.Request.Context(), tenantId, contentType, pageable)
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	result := make([]dtos.PublishedContent, 0, len(publishedContents))
+	for _, publishedContent := range publishedContents {
+		localizedContent, err := controller.localizePublishedContent(ctx.Request.Context(), tenantId, toPublishedContent(publishedContent), locale)
+		if err != nil {
+			foundation.GinErrorHandler().InternalServerError(ctx, err)
+			return
+		}
+		result = append(result, localizedContent)
+	}
+
+	ctx.JSON(http.StatusOK, dtos.PageResult[[]dtos.PublishedContent]{
+		Result:     result,
+		TotalCount: totalCount,
+	})
+}
+
+// getPublishedContent serves the published version of a content to readers, a content that is not published is not found.
+func (controller ContentController) getPublishedContent(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	contentType := ctx.Param("contentType")
+	if len(tenantId) == 0 || len(contentType) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId and contentType are required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	locale, ok := localeParam(ctx)
+	if !ok {
+		return
+	}
+
+	publishedContent, err := controller.contentQuery.GetPublishedContent(ctx.Request.Context(), tenantId, contentType, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	localizedContent, err := controller.localizePublishedContent(ctx.Request.Context(), tenantId, toPublishedContent(*publishedContent), locale)
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, localizedContent)
+}
+
+// localizePublishedContent reads the localized fields of a published content in locale, when a locale is requested.
+func (controller ContentController) localizePublishedContent(ctx context.Context, tenantId string,
+	publishedContent dtos.PublishedContent, locale string) (dtos.PublishedContent, error) {
+	if len(locale) == 0 {
+		return publishedContent, nil
+	}
+
+	localized, fieldLocales, err := controller.contentQuery.LocalizeContent(ctx, tenantId,
+		publishedContent.ContentType, publishedContent.Content, locale)
+	if err != nil {
+		return publishedContent, err
+	}
+	publishedContent.Content = localized
+	publishedContent.Locale = locale
+	publishedContent.FieldLocales = fieldLocales
+	return publishedContent, nil
+}
+
+func toPublishedContent(publishedContent projections.PublishedContentProjection) dtos.PublishedContent {
+	return dtos.PublishedContent{
+		Id:          publishedContent.Id,
+		Content:     publishedContent.Content,
+		ContentType: publishedContent.ContentType,
+		Version:     publishedContent.Version,
+		PublishedAt: publishedContent.PublishedAt,
+	}
+}
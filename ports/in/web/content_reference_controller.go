@@ -0,0 +1,54 @@
+package web
+
+import (
+	"contentgit/domain/content/projections"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// getReferencedBy lists the contents referencing a content from the reverse-reference index, which follows the
+// changes of the contents asynchronously.
+func (controller ContentController) getReferencedBy(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	references, err := controller.contentQuery.GetReferencedBy(ctx.Request.Context(), tenantId, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toContentReferences(references))
+}
+
+func toContentReferences(references []projections.ContentReferenceProjection) []dtos.ContentReference {
+	contentReferences := make([]dtos.ContentReference, 0, len(references))
+	for _, reference := range references {
+		contentReferences = append(contentReferences, dtos.ContentReference{
+			ContentId:   reference.SourceId,
+			ContentType: reference.SourceContentType,
+			Field:       reference.Field,
+			Version:     reference.SourceVersion,
+			UpdatedAt:   reference.UpdatedAt,
+		})
+	}
+	return contentReferences
+}
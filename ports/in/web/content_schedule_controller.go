@@ -0,0 +1,168 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// createSchedule schedules a publish or an unpublish of a content, the job runs through the workflow of the content type.
+func (controller ContentController) createSchedule(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	var scheduleCreate dtos.ContentScheduleCreate
+	if err := ctx.BindJSON(&scheduleCreate); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	scheduleId := uuid.New().String()
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.ScheduleContentCommand{
+			ScheduleId:    scheduleId,
+			AggregateID:   id,
+			TenantId:      tenantId,
+			Transition:    content.Transition(scheduleCreate.Transition),
+			Version:       scheduleCreate.Version,
+			RunAt:         scheduleCreate.RunAt,
+			CreatedById:   scheduleCreate.CreatedById,
+			CreatedByName: scheduleCreate.CreatedByName,
+		}
+
+		return controller.contentService.Commands.ScheduleContent.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if errors.Is(err, content.ErrInvalidSchedule) {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrAggregateNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, content.ErrVersionNotCurrent) || errors.Is(err, content.ErrTransitionNotAllowed) {
+			ctx.JSON(http.StatusConflict, err.Error())
+			return
+		}
+
+		if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.ContentScheduleCreated{Id: scheduleId})
+}
+
+func (controller ContentController) getSchedules(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	contentSchedules, err := controller.contentQuery.GetContentSchedules(ctx.Request.Context(), tenantId, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	schedules := make([]dtos.ContentSchedule, 0, len(contentSchedules))
+	for _, contentSchedule := range contentSchedules {
+		schedules = append(schedules, dtos.ContentSchedule{
+			Id:            contentSchedule.Id,
+			ContentId:     contentSchedule.ContentId,
+			Transition:    string(contentSchedule.Transition),
+			Version:       contentSchedule.Version,
+			RunAt:         contentSchedule.RunAt,
+			State:         string(contentSchedule.State),
+			Error:         contentSchedule.Error,
+			Attempts:      contentSchedule.Attempts,
+			CreatedById:   contentSchedule.CreatedById,
+			CreatedByName: contentSchedule.CreatedByName,
+			CreatedAt:     contentSchedule.CreatedAt,
+			ExecutedAt:    contentSchedule.ExecutedAt,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, schedules)
+}
+
+// cancelSchedule cancels a job that has not run yet.
+func (controller ContentController) cancelSchedule(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	scheduleId := ctx.Param("scheduleId")
+	if len(id) == 0 || len(scheduleId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id and scheduleId are required")
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.CancelContentScheduleCommand{
+			AggregateID: id,
+			TenantId:    tenantId,
+			ScheduleId:  scheduleId,
+		}
+
+		return controller.contentService.Commands.CancelContentSchedule.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, content.ErrScheduleNotPending) {
+			ctx.JSON(http.StatusConflict, err.Error())
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
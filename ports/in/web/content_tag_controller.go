@@ -0,0 +1,191 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	// userRoleHeader carries the role of the caller, it is set by the gateway in front of the api.
+	userRoleHeader = "X-User-Role"
+	adminRole      = "admin"
+)
+
+func (controller ContentController) createTag(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	var tagCreate dtos.ContentTagCreate
+	if err := ctx.BindJSON(&tagCreate); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.CreateContentTagCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			Tag:           tagCreate.Name,
+			Branch:        tagCreate.Branch,
+			Version:       tagCreate.Version,
+			CreatedById:   tagCreate.CreatedById,
+			CreatedByName: tagCreate.CreatedByName,
+		}
+
+		return controller.contentService.Commands.CreateContentTag.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if errors.Is(err, content.ErrInvalidBranchName) || errors.Is(err, content.ErrInvalidTagName) {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrAggregateNotFound) || errors.Is(err, eventsourcing.ErrVersionNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, content.ErrTagAlreadyExists) {
+			ctx.Status(http.StatusConflict)
+			return
+		}
+
+		if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusCreated)
+}
+
+func (controller ContentController) getContentTags(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	contentTags, err := controller.contentQuery.GetContentTags(ctx.Request.Context(), tenantId, id)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toContentTags(contentTags))
+}
+
+func (controller ContentController) getTags(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	pageable := dtos.NewPageableFromRequest(ctx)
+
+	contentTags, totalCount, err := controller.contentQuery.GetTags(ctx.Request.Context(), tenantId, pageable)
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	pageResult := dtos.PageResult[[]dtos.ContentTag]{
+		Result:     toContentTags(contentTags),
+		TotalCount: totalCount,
+	}
+
+	ctx.JSON(http.StatusOK, pageResult)
+}
+
+// deleteTag removes a tag, tags are never moved so only admins may delete one that was created by mistake.
+func (controller ContentController) deleteTag(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	tag := ctx.Param("tag")
+	if len(id) == 0 || len(tag) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id and tag are required")
+		return
+	}
+
+	if ctx.GetHeader(userRoleHeader) != adminRole {
+		ctx.Status(http.StatusForbidden)
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.DeleteContentTagCommand{
+			AggregateID: id,
+			TenantId:    tenantId,
+			Tag:         tag,
+		}
+
+		return controller.contentService.Commands.DeleteContentTag.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func toContentTags(contentTags []content.ContentTag) []dtos.ContentTag {
+	tags := make([]dtos.ContentTag, 0, len(contentTags))
+	for _, contentTag := range contentTags {
+		tags = append(tags, dtos.ContentTag{
+			Name:          contentTag.Name,
+			ContentId:     contentTag.ContentId,
+			Branch:        contentTag.Branch,
+			Version:       contentTag.Version,
+			CreatedById:   contentTag.CreatedById,
+			CreatedByName: contentTag.CreatedByName,
+			CreatedAt:     contentTag.CreatedAt,
+		})
+	}
+	return tags
+}
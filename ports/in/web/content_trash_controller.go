@@ -0,0 +1,205 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// deleteContent moves a content to the trash, it keeps its history and can be restored.
+func (controller ContentController) deleteContent(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	var contentDelete dtos.ContentDelete
+	if err := ctx.BindJSON(&contentDelete); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.DeleteContentCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			CreatedById:   contentDelete.CreatedById,
+			CreatedByName: contentDelete.CreatedByName,
+		}
+
+		return controller.contentService.Commands.DeleteContent.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if errors.Is(err, eventsourcing.ErrAggregateNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
+		if errors.Is(err, content.ErrContentReferenced) {
+			ctx.JSON(http.StatusConflict, err.Error())
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+			ctx.Status(http.StatusConflict)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (controller ContentController) restoreContent(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	var contentRestore dtos.ContentRestore
+	if err := ctx.BindJSON(&contentRestore); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.RestoreContentCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			CreatedById:   contentRestore.CreatedById,
+			CreatedByName: contentRestore.CreatedByName,
+		}
+
+		return controller.contentService.Commands.RestoreContent.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if errors.Is(err, eventsourcing.ErrAggregateNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, content.ErrContentPurged) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
+		if errors.Is(err, content.ErrContentNotDeleted) || errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+			ctx.Status(http.StatusConflict)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// purgeContent erases the personal data of a content for good, its history is kept but the purged values read as null.
+func (controller ContentController) purgeContent(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	id := ctx.Param("id")
+	if len(id) == 0 {
+		ctx.JSON(http.StatusBadRequest, "id is required")
+		return
+	}
+
+	var contentPurge dtos.ContentPurge
+	if err := ctx.BindJSON(&contentPurge); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.PurgeContentCommand{
+			AggregateID:   id,
+			TenantId:      tenantId,
+			CreatedById:   contentPurge.CreatedById,
+			CreatedByName: contentPurge.CreatedByName,
+		}
+
+		return controller.contentService.Commands.PurgeContent.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if errors.Is(err, eventsourcing.ErrAggregateNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, content.ErrContentPurged) {
+			ctx.Status(http.StatusGone)
+			return
+		}
+
+		if errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+			ctx.Status(http.StatusConflict)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// getTrash lists the deleted contents of the tenant, the latest deleted first.
+func (controller ContentController) getTrash(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	pageable := dtos.NewPageableFromRequest(ctx)
+
+	contentProjections, totalCount, err := controller.contentQuery.GetTrash(ctx.Request.Context(), tenantId, pageable)
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	pageResult := dtos.PageResult[[]dtos.ContentSummary]{
+		Result:     toContentSummaries(contentProjections),
+		TotalCount: totalCount,
+	}
+
+	ctx.JSON(http.StatusOK, pageResult)
+}
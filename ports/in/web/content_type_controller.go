@@ -0,0 +1,341 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/appservices"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+type ContentTypeController struct {
+	routerGroup    *gin.RouterGroup
+	contentService *appservices.ContentService
+	contentQuery   *appservices.ContentQuery
+}
+
+func NewContentTypeController(rg *gin.RouterGroup, contentService *appservices.ContentService,
+	contentQuery *appservices.ContentQuery) *ContentTypeController {
+	return &ContentTypeController{
+		routerGroup:    rg,
+		contentService: contentService,
+		contentQuery:   contentQuery,
+	}
+}
+
+func (controller ContentTypeController) MapRoutes() {
+	route := controller.routerGroup.Group("/tenants/:tenantId/content-types")
+	route.POST("", controller.createContentType)
+	route.GET("", controller.getContentTypes)
+	route.GET(":name", controller.getContentType)
+	route.GET(":name/versions", controller.getContentTypeVersions)
+	route.PUT(":name", controller.updateContentType)
+	route.DELETE(":name", controller.deleteContentType)
+	route.POST(":name/migrations", controller.createSchemaMigration)
+	route.GET(":name/migrations", controller.getSchemaMigrations)
+	route.GET(":name/migrations/:migrationId", controller.getSchemaMigration)
+}
+
+func (controller ContentTypeController) createContentType(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	var contentTypeCreate dtos.ContentTypeCreate
+	if err := ctx.BindJSON(&contentTypeCreate); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var schema *content.ContentTypeSchema
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.CreateContentTypeCommand{
+			TenantId:      tenantId,
+			ContentType:   contentTypeCreate.Name,
+			Description:   contentTypeCreate.Description,
+			Fields:        toFieldDefinitions(contentTypeCreate.Fields),
+			CreatedById:   contentTypeCreate.CreatedById,
+			CreatedByName: contentTypeCreate.CreatedByName,
+		}
+
+		if err := controller.contentService.Commands.CreateContentType.Handle(ctx, command); err != nil {
+			return err
+		}
+
+		var err error
+		schema, err = controller.contentQuery.GetContentType(ctx, tenantId, contentTypeCreate.Name, 0)
+		return err
+	})
+
+	if err != nil {
+		handleContentTypeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.ContentTypeVersion{Name: schema.ContentType, Version: schema.Version})
+}
+
+func (controller ContentTypeController) getContentTypes(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	if len(tenantId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId is required")
+		return
+	}
+
+	schemas, err := controller.contentQuery.GetContentTypes(ctx.Request.Context(), tenantId)
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toContentTypeDetailsList(schemas))
+}
+
+// getContentType returns the latest schema of the content type, or the one at the version query parameter.
+func (controller ContentTypeController) getContentType(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	name := ctx.Param("name")
+	if len(tenantId) == 0 || len(name) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId and name are required")
+		return
+	}
+
+	var version uint64
+	if versionParam := ctx.Query("version"); len(versionParam) > 0 {
+		parsed, err := strconv.ParseUint(versionParam, 10, 64)
+		if err != nil || parsed == 0 {
+			ctx.JSON(http.StatusBadRequest, "version must be a positive number")
+			return
+		}
+		version = parsed
+	}
+
+	schema, err := controller.contentQuery.GetContentType(ctx.Request.Context(), tenantId, name, version)
+	if err != nil {
+		handleContentTypeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toContentTypeDetails(*schema))
+}
+
+func (controller ContentTypeController) getContentTypeVersions(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	name := ctx.Param("name")
+	if len(tenantId) == 0 || len(name) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId and name are required")
+		return
+	}
+
+	schemas, err := controller.contentQuery.GetContentTypeVersions(ctx.Request.Context(), tenantId, name)
+	if err != nil {
+		handleContentTypeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toContentTypeDetailsList(schemas))
+}
+
+func (controller ContentTypeController) updateContentType(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	name := ctx.Param("name")
+	if len(tenantId) == 0 || len(name) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId and name are required")
+		return
+	}
+
+	var contentTypeUpdate dtos.ContentTypeUpdate
+	if err := ctx.BindJSON(&contentTypeUpdate); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var schema *content.ContentTypeSchema
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.UpdateContentTypeCommand{
+			TenantId:      tenantId,
+			ContentType:   name,
+			Description:   contentTypeUpdate.Description,
+			Fields:        toFieldDefinitions(contentTypeUpdate.Fields),
+			Migrations:    toMigrationSteps(contentTypeUpdate.Migrations),
+			CreatedById:   contentTypeUpdate.CreatedById,
+			CreatedByName: contentTypeUpdate.CreatedByName,
+		}
+
+		if err := controller.contentService.Commands.UpdateContentType.Handle(ctx, command); err != nil {
+			return err
+		}
+
+		var err error
+		schema, err = controller.contentQuery.GetContentType(ctx, tenantId, name, 0)
+		return err
+	})
+
+	if err != nil {
+		handleContentTypeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.ContentTypeVersion{Name: schema.ContentType, Version: schema.Version})
+}
+
+func (controller ContentTypeController) deleteContentType(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	name := ctx.Param("name")
+	if len(tenantId) == 0 || len(name) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId and name are required")
+		return
+	}
+
+	var contentTypeDelete dtos.ContentTypeDelete
+	if err := ctx.BindJSON(&contentTypeDelete); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.DeleteContentTypeCommand{
+			TenantId:      tenantId,
+			ContentType:   name,
+			CreatedById:   contentTypeDelete.CreatedById,
+			CreatedByName: contentTypeDelete.CreatedByName,
+		}
+
+		return controller.contentService.Commands.DeleteContentType.Handle(ctx, command)
+	})
+
+	if err != nil {
+		handleContentTypeError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func handleContentTypeError(ctx *gin.Context, err error) {
+	if errors.Is(err, content.ErrInvalidSchema) {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if errors.Is(err, persistence.ErrRecordNotFound) || errors.Is(err, content.ErrContentTypeNotFound) {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+
+	if errors.Is(err, content.ErrContentTypeExists) || errors.Is(err, content.ErrSchemaChanged) {
+		ctx.JSON(http.StatusConflict, err.Error())
+		return
+	}
+
+	foundation.GinErrorHandler().InternalServerError(ctx, err)
+}
+
+// respondSchemaViolation answers 422 with the violations of the fields when err is a schema violation.
+func respondSchemaViolation(ctx *gin.Context, err error) bool {
+	var schemaViolation *content.SchemaViolationError
+	if !errors.As(err, &schemaViolation) {
+		return false
+	}
+
+	ctx.JSON(http.StatusUnprocessableEntity, toContentValidationError(schemaViolation, nil))
+	return true
+}
+
+func toContentValidationError(schemaViolation *content.SchemaViolationError, index *int) dtos.ContentValidationError {
+	fieldErrors := make([]dtos.ContentFieldError, 0, len(schemaViolation.Violations))
+	for _, violation := range schemaViolation.Violations {
+		fieldErrors = append(fieldErrors, dtos.ContentFieldError{Field: violation.Field, Message: violation.Message})
+	}
+	return dtos.ContentValidationError{Index: index, Errors: fieldErrors}
+}
+
+func toFieldDefinitions(fields []dtos.ContentTypeField) []content.FieldDefinition {
+	definitions := make([]content.FieldDefinition, 0, len(fields))
+	for _, field := range fields {
+		definitions = append(definitions, content.FieldDefinition{
+			Name:          field.Name,
+			Type:          content.FieldType(field.Type),
+			Required:      field.Required,
+			Enum:          field.Enum,
+			Pattern:       field.Pattern,
+			ReferenceType: field.ReferenceType,
+			Localized:     field.Localized,
+		})
+	}
+	return definitions
+}
+
+func toMigrationSteps(steps []dtos.ContentTypeMigrationStep) []content.MigrationStep {
+	migrationSteps := make([]content.MigrationStep, 0, len(steps))
+	for _, step := range steps {
+		migrationSteps = append(migrationSteps, content.MigrationStep{
+			Op:        content.MigrationOperation(step.Op),
+			Field:     step.Field,
+			To:        step.To,
+			Into:      step.Into,
+			Separator: step.Separator,
+			Type:      content.FieldType(step.Type),
+			Value:     step.Value,
+		})
+	}
+	return migrationSteps
+}
+
+func toContentTypeDetailsList(schemas []content.ContentTypeSchema) []dtos.ContentTypeDetails {
+	details := make([]dtos.ContentTypeDetails, 0, len(schemas))
+	for _, schema := range schemas {
+		details = append(details, toContentTypeDetails(schema))
+	}
+	return details
+}
+
+func toContentTypeDetails(schema content.ContentTypeSchema) dtos.ContentTypeDetails {
+	fields := make([]dtos.ContentTypeField, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		fields = append(fields, dtos.ContentTypeField{
+			Name:          field.Name,
+			Type:          string(field.Type),
+			Required:      field.Required,
+			Enum:          field.Enum,
+			Pattern:       field.Pattern,
+			ReferenceType: field.ReferenceType,
+			Localized:     field.Localized,
+		})
+	}
+
+	var migrations []dtos.ContentTypeMigrationStep
+	for _, step := range schema.Migrations {
+		migrations = append(migrations, dtos.ContentTypeMigrationStep{
+			Op:        string(step.Op),
+			Field:     step.Field,
+			To:        step.To,
+			Into:      step.Into,
+			Separator: step.Separator,
+			Type:      string(step.Type),
+			Value:     step.Value,
+		})
+	}
+
+	return dtos.ContentTypeDetails{
+		Name:          schema.ContentType,
+		Description:   schema.Description,
+		Version:       schema.Version,
+		Fields:        fields,
+		Migrations:    migrations,
+		Deleted:       schema.Deleted,
+		CreatedById:   schema.CreatedById,
+		CreatedByName: schema.CreatedByName,
+		CreatedAt:     schema.CreatedAt,
+	}
+}
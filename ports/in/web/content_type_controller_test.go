@@ -0,0 +1,340 @@
+package web
+
+import (
+	"contentgit/testdata/testserver"
+	"contentgit/testdata/testsuite"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ContentTypeControllerTestSuite struct {
+	testsuite.BaseDatabaseTestSuite
+}
+
+func TestContentTypeControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ContentTypeControllerTestSuite))
+}
+
+func (suite *ContentTypeControllerTestSuite) TestCreateContentType() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"name": "faqs",
+			"fields": [
+				{"name": "question", "type": "string", "required": true},
+				{"name": "order", "type": "integer"}
+			],
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/content-types", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+	suite.JSONEq(`{"name": "faqs", "version": 1}`, rec.Body.String())
+}
+
+func (suite *ContentTypeControllerTestSuite) TestCreateContentType_이미_존재하면_Conflict를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"name": "notices",
+			"fields": [{"name": "title", "type": "string"}],
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/content-types", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusConflict, rec.Code)
+}
+
+func (suite *ContentTypeControllerTestSuite) TestCreateContentType_필드_정의가_잘못되면_BadRequest를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"name": "faqs",
+			"fields": [{"name": "question", "type": "date"}],
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/content-types", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (suite *ContentTypeControllerTestSuite) TestGetContentType() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/content-types/notices", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(map[string]any{
+		"name":        "notices",
+		"description": "공지사항",
+		"version":     float64(2),
+		"fields": []any{
+			map[string]any{"name": "title", "type": "string", "required": true},
+			map[string]any{"name": "body", "type": "string", "required": false},
+			map[string]any{"name": "category", "type": "string", "required": false, "enum": []any{"event", "maintenance"}},
+		},
+		"createdById":   "1",
+		"createdByName": "사이트 관리자",
+		"createdAt":     "1982-03-02T00:00:00+09:00",
+	}, actual)
+}
+
+func (suite *ContentTypeControllerTestSuite) TestGetContentType_버전을_지정하면_해당_버전의_스키마를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/content-types/notices?version=1", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(1), actual["version"])
+	suite.Len(actual["fields"], 2)
+}
+
+func (suite *ContentTypeControllerTestSuite) TestGetContentTypeVersions() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/content-types/notices/versions", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual []map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Len(actual, 2)
+	suite.Equal(float64(1), actual[0]["version"])
+	suite.Equal(float64(2), actual[1]["version"])
+}
+
+func (suite *ContentTypeControllerTestSuite) TestUpdateContentType() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"description": "공지사항",
+			"fields": [
+				{"name": "title", "type": "string", "required": true},
+				{"name": "body", "type": "string", "required": true}
+			],
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPut, "/api/tenants/bettercode/content-types/notices", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.JSONEq(`{"name": "notices", "version": 3}`, rec.Body.String())
+}
+
+func (suite *ContentTypeControllerTestSuite) TestDeleteContentType() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/tenants/bettercode/content-types/notices", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/content-types/notices", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *ContentTypeControllerTestSuite) TestCreateContent_스키마를_위반하면_UnprocessableEntity를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{"body": "서버 점검이 있습니다.", "category": "notice"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/notices/contents", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusUnprocessableEntity, rec.Code)
+	suite.JSONEq(`{
+		"errors": [
+			{"field": "category", "message": "must be one of [event maintenance]"},
+			{"field": "title", "message": "is required"}
+		]
+	}`, rec.Body.String())
+}
+
+func (suite *ContentTypeControllerTestSuite) TestCreateContent_스키마를_만족하면_생성된다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{"title": "점검 안내", "category": "maintenance"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/notices/contents", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusCreated, rec.Code)
+}
+
+func (suite *ContentTypeControllerTestSuite) TestCreateBulkContent_스키마를_위반한_컨텐츠의_위치를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `[
+		{"title": "점검 안내"},
+		{"body": "제목 없는 공지"}
+	]`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/bettercode/notices/contents/bulk", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusUnprocessableEntity, rec.Code)
+	suite.JSONEq(`[
+		{"index": 1, "errors": [{"field": "title", "message": "is required"}]}
+	]`, rec.Body.String())
+}
+
+func (suite *ContentTypeControllerTestSuite) TestUpdateContentType_마이그레이션_단계를_함께_저장한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"description": "공지사항",
+			"fields": [
+				{"name": "title", "type": "string", "required": true},
+				{"name": "content", "type": "string"}
+			],
+			"migrations": [
+				{"op": "rename", "field": "body", "to": "content"},
+				{"op": "remove", "field": "category"}
+			],
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPut, "/api/tenants/bettercode/content-types/notices", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/content-types/notices", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal([]any{
+		map[string]any{"op": "rename", "field": "body", "to": "content"},
+		map[string]any{"op": "remove", "field": "category"},
+	}, actual["migrations"])
+}
+
+func (suite *ContentTypeControllerTestSuite) TestUpdateContentType_마이그레이션_단계가_잘못되면_BadRequest를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	requestBody := `{
+			"fields": [{"name": "title", "type": "string"}],
+			"migrations": [{"op": "split", "field": "title", "into": ["head"]}],
+			"createdById": "1",
+			"createdByName": "사이트 관리자"
+		}`
+
+	req := httptest.NewRequest(http.MethodPut, "/api/tenants/bettercode/content-types/notices", strings.NewReader(requestBody))
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (suite *ContentTypeControllerTestSuite) TestGetSchemaMigration_없으면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/bettercode/content-types/notices/migrations/unknown", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
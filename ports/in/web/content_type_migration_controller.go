@@ -0,0 +1,168 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// createSchemaMigration starts a schema migration of the contents of a content type, the migration runs in the background.
+func (controller ContentTypeController) createSchemaMigration(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	name := ctx.Param("name")
+	if len(tenantId) == 0 || len(name) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId and name are required")
+		return
+	}
+
+	var migrationCreate dtos.SchemaMigrationCreate
+	if err := ctx.BindJSON(&migrationCreate); err != nil {
+		ctx.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	migrationId := uuid.New().String()
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		command := commands.StartSchemaMigrationCommand{
+			MigrationId:   migrationId,
+			TenantId:      tenantId,
+			ContentType:   name,
+			FromVersion:   migrationCreate.FromVersion,
+			ToVersion:     migrationCreate.ToVersion,
+			DryRun:        migrationCreate.DryRun,
+			CreatedById:   migrationCreate.CreatedById,
+			CreatedByName: migrationCreate.CreatedByName,
+		}
+
+		return controller.contentService.Commands.StartSchemaMigration.Handle(ctx, command)
+	})
+
+	if err != nil {
+		if errors.Is(err, content.ErrInvalidMigration) {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if errors.Is(err, content.ErrContentTypeNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, content.ErrMigrationInProgress) {
+			ctx.JSON(http.StatusConflict, err.Error())
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, dtos.SchemaMigrationCreated{Id: migrationId})
+}
+
+// getSchemaMigrations returns the progress of the schema migrations of a content type, without their results.
+func (controller ContentTypeController) getSchemaMigrations(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	name := ctx.Param("name")
+	if len(tenantId) == 0 || len(name) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId and name are required")
+		return
+	}
+
+	migrations, err := controller.contentQuery.GetSchemaMigrations(ctx.Request.Context(), tenantId, name)
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	migrationDtos := make([]dtos.SchemaMigration, 0, len(migrations))
+	for _, migration := range migrations {
+		migrationDto := toSchemaMigrationDto(migration)
+		migrationDto.Results = nil
+		migrationDtos = append(migrationDtos, migrationDto)
+	}
+
+	ctx.JSON(http.StatusOK, migrationDtos)
+}
+
+// getSchemaMigration returns the progress of a schema migration with what it did, or would do on a dry run, to each content.
+func (controller ContentTypeController) getSchemaMigration(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	name := ctx.Param("name")
+	migrationId := ctx.Param("migrationId")
+	if len(tenantId) == 0 || len(name) == 0 || len(migrationId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId, name and migrationId are required")
+		return
+	}
+
+	migration, err := controller.contentQuery.GetSchemaMigration(ctx.Request.Context(), tenantId, name, migrationId)
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toSchemaMigrationDto(*migration))
+}
+
+func toSchemaMigrationDto(migration content.SchemaMigration) dtos.SchemaMigration {
+	// contents created while the migration runs are migrated as well, so that it may go through more than it counted
+	progress := 0
+	switch {
+	case migration.State == content.SchemaMigrationStateDone:
+		progress = 100
+	case migration.Total > 0:
+		progress = min(migration.Processed*100/migration.Total, 99)
+	}
+
+	results := make([]dtos.SchemaMigrationResult, 0, len(migration.Results))
+	for _, result := range migration.Results {
+		fields := make([]dtos.SchemaMigrationField, 0, len(result.Fields))
+		for _, field := range result.Fields {
+			fields = append(fields, dtos.SchemaMigrationField{Field: field.FieldName, Operation: string(field.Operation)})
+		}
+		results = append(results, dtos.SchemaMigrationResult{
+			ContentId: result.ContentId,
+			Branch:    result.Branch,
+			Outcome:   string(result.Outcome),
+			Fields:    fields,
+			Error:     result.Error,
+		})
+	}
+
+	return dtos.SchemaMigration{
+		Id:            migration.Id,
+		ContentType:   migration.ContentType,
+		FromVersion:   migration.FromVersion,
+		ToVersion:     migration.ToVersion,
+		DryRun:        migration.DryRun,
+		State:         string(migration.State),
+		Total:         migration.Total,
+		Processed:     migration.Processed,
+		Migrated:      migration.Migrated,
+		Unchanged:     migration.Unchanged,
+		Skipped:       migration.Skipped,
+		Failed:        migration.Failed,
+		Progress:      progress,
+		Results:       results,
+		Error:         migration.Error,
+		CreatedById:   migration.CreatedById,
+		CreatedByName: migration.CreatedByName,
+		CreatedAt:     migration.CreatedAt,
+		StartedAt:     migration.StartedAt,
+		CompletedAt:   migration.CompletedAt,
+	}
+}
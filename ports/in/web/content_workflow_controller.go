@@ -0,0 +1,83 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/domain/content"
+	"contentgit/domain/content/commands"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// changeContentStatus serves an editorial transition of a content, such as submitting it for review or publishing it.
+func (controller ContentController) changeContentStatus(transition content.Transition) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		tenantId := ctx.Param("tenantId")
+		if len(tenantId) == 0 {
+			ctx.JSON(http.StatusBadRequest, "tenantId is required")
+			return
+		}
+
+		id := ctx.Param("id")
+		if len(id) == 0 {
+			ctx.JSON(http.StatusBadRequest, "id is required")
+			return
+		}
+
+		var contentStatusChange dtos.ContentStatusChange
+		if err := ctx.BindJSON(&contentStatusChange); err != nil {
+			ctx.JSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+			command := commands.ChangeContentStatusCommand{
+				AggregateID:   id,
+				TenantId:      tenantId,
+				Transition:    transition,
+				Reason:        contentStatusChange.Reason,
+				CreatedById:   contentStatusChange.CreatedById,
+				CreatedByName: contentStatusChange.CreatedByName,
+			}
+
+			return controller.contentService.Commands.ChangeContentStatus.Handle(ctx, command)
+		})
+
+		if err != nil {
+			if errors.Is(err, eventsourcing.ErrAggregateNotFound) {
+				ctx.Status(http.StatusNotFound)
+				return
+			}
+
+			if errors.Is(err, content.ErrReasonRequired) {
+				ctx.JSON(http.StatusBadRequest, err.Error())
+				return
+			}
+
+			if errors.Is(err, content.ErrContentDeleted) || errors.Is(err, content.ErrContentPurged) {
+				ctx.Status(http.StatusGone)
+				return
+			}
+
+			if errors.Is(err, content.ErrTransitionNotAllowed) || errors.Is(err, content.ErrContentNotPublished) {
+				ctx.JSON(http.StatusConflict, err.Error())
+				return
+			}
+
+			if errors.Is(err, eventsourcing.ErrConcurrencyConflict) {
+				ctx.Status(http.StatusConflict)
+				return
+			}
+
+			foundation.GinErrorHandler().InternalServerError(ctx, err)
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
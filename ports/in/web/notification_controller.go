@@ -0,0 +1,134 @@
+package web
+
+import (
+	"contentgit/app/datasource"
+	"contentgit/appservices"
+	"contentgit/domain/notification/projections"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+type NotificationController struct {
+	routerGroup         *gin.RouterGroup
+	notificationService *appservices.NotificationService
+	notificationQuery   *appservices.NotificationQuery
+}
+
+func NewNotificationController(rg *gin.RouterGroup, notificationService *appservices.NotificationService,
+	notificationQuery *appservices.NotificationQuery) *NotificationController {
+	return &NotificationController{
+		routerGroup:         rg,
+		notificationService: notificationService,
+		notificationQuery:   notificationQuery,
+	}
+}
+
+func (controller NotificationController) MapRoutes() {
+	route := controller.routerGroup.Group("/tenants/:tenantId/users/:userId/notifications")
+	route.GET("", controller.getNotifications)
+	route.POST("read", controller.markAllNotificationsAsRead)
+	route.POST(":notificationId/read", controller.markNotificationAsRead)
+}
+
+func (controller NotificationController) getNotifications(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	userId := ctx.Param("userId")
+	if len(tenantId) == 0 || len(userId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId and userId are required")
+		return
+	}
+
+	pageable := dtos.NewPageableFromRequest(ctx)
+	unreadOnly := ctx.Query("unread") == "true"
+
+	notificationProjections, totalCount, err := controller.notificationQuery.GetNotifications(ctx.Request.Context(), tenantId, userId, unreadOnly, pageable)
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	pageResult := dtos.PageResult[[]dtos.Notification]{
+		Result:     toNotifications(notificationProjections),
+		TotalCount: totalCount,
+	}
+
+	ctx.JSON(http.StatusOK, pageResult)
+}
+
+func (controller NotificationController) markNotificationAsRead(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	userId := ctx.Param("userId")
+	if len(tenantId) == 0 || len(userId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId and userId are required")
+		return
+	}
+
+	notificationId, err := strconv.ParseUint(ctx.Param("notificationId"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, "notificationId must be a number")
+		return
+	}
+
+	err = datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		return controller.notificationService.MarkAsRead(ctx, tenantId, userId, uint(notificationId))
+	})
+
+	if err != nil {
+		if errors.Is(err, persistence.ErrRecordNotFound) {
+			ctx.Status(http.StatusNotFound)
+			return
+		}
+
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (controller NotificationController) markAllNotificationsAsRead(ctx *gin.Context) {
+	tenantId := ctx.Param("tenantId")
+	userId := ctx.Param("userId")
+	if len(tenantId) == 0 || len(userId) == 0 {
+		ctx.JSON(http.StatusBadRequest, "tenantId and userId are required")
+		return
+	}
+
+	err := datasource.TransactionalWithContext(ctx.Request.Context(), func(ctx context.Context) error {
+		return controller.notificationService.MarkAllAsRead(ctx, tenantId, userId)
+	})
+
+	if err != nil {
+		foundation.GinErrorHandler().InternalServerError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func toNotifications(notificationProjections []projections.NotificationProjection) []dtos.Notification {
+	notifications := make([]dtos.Notification, 0, len(notificationProjections))
+	for _, notificationProjection := range notificationProjections {
+		notifications = append(notifications, dtos.Notification{
+			Id:              notificationProjection.Id,
+			ContentId:       notificationProjection.ContentId,
+			ContentType:     notificationProjection.ContentType,
+			Field:           notificationProjection.FieldName,
+			CommentId:       notificationProjection.CommentId,
+			Comment:         notificationProjection.Comment,
+			MentionedById:   notificationProjection.MentionedById,
+			MentionedByName: notificationProjection.MentionedByName,
+			Read:            notificationProjection.IsRead(),
+			ReadAt:          notificationProjection.ReadAt,
+			CreatedAt:       notificationProjection.CreatedAt,
+		})
+	}
+	return notifications
+}
@@ -0,0 +1,142 @@
+package web
+
+import (
+	"contentgit/testdata/testserver"
+	"contentgit/testdata/testsuite"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationControllerTestSuite struct {
+	testsuite.BaseDatabaseTestSuite
+}
+
+func TestNotificationControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationControllerTestSuite))
+}
+
+func (suite *NotificationControllerTestSuite) TestGetNotifications() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/mellow/users/8/notifications", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(2), actual["totalCount"])
+	suite.Equal([]any{
+		map[string]any{
+			"id":              float64(2),
+			"contentId":       "8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34",
+			"contentType":     "dresses",
+			"field":           "name",
+			"commentId":       "f1b3d5e7-2a4c-4e6a-8b0d-1f3e5a7c9e21",
+			"comment":         "@8 상품명 검토해 주세요.",
+			"mentionedById":   "7",
+			"mentionedByName": "최유진",
+			"read":            false,
+			"createdAt":       "1982-02-09T12:00:00+09:00",
+		},
+		map[string]any{
+			"id":              float64(1),
+			"contentId":       "8c4f1b2e-6d3a-4e7b-9f10-2a5c7d9e1b34",
+			"contentType":     "dresses",
+			"field":           "price",
+			"commentId":       "d2a4f6b8-1c3e-4a5b-9d7f-0e2c4a6b8d10",
+			"comment":         "@8 할인가 확인 부탁드려요.",
+			"mentionedById":   "7",
+			"mentionedByName": "최유진",
+			"read":            true,
+			"readAt":          "1982-02-08T09:00:00+09:00",
+			"createdAt":       "1982-02-07T12:00:00+09:00",
+		},
+	}, actual["result"])
+}
+
+func (suite *NotificationControllerTestSuite) TestGetNotifications_unread이면_읽지_않은_알림만_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/tenants/mellow/users/8/notifications?unread=true", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusOK, rec.Code)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(1), actual["totalCount"])
+	suite.Equal(float64(2), actual["result"].([]any)[0].(map[string]any)["id"])
+}
+
+func (suite *NotificationControllerTestSuite) TestMarkNotificationAsRead() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/users/8/notifications/2/read", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/mellow/users/8/notifications?unread=true", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(0), actual["totalCount"])
+}
+
+func (suite *NotificationControllerTestSuite) TestMarkNotificationAsRead_다른_사용자의_알림이면_NotFound를_반환한다() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/users/7/notifications/2/read", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNotFound, rec.Code)
+}
+
+func (suite *NotificationControllerTestSuite) TestMarkAllNotificationsAsRead() {
+	// given
+	sut := testserver.NewTestAppServerBuilder(Router{}, suite.TestDbContainer).WithDatabaseFixture().Build()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/tenants/mellow/users/8/notifications/read", nil)
+	rec := httptest.NewRecorder()
+
+	// when
+	sut.ServeHTTP(rec, req)
+
+	// then
+	suite.Equal(http.StatusNoContent, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/tenants/mellow/users/8/notifications?unread=true", nil)
+	rec = httptest.NewRecorder()
+	sut.ServeHTTP(rec, req)
+
+	var actual map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &actual)
+	suite.Equal(float64(0), actual["totalCount"])
+}
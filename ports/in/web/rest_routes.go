@@ -12,4 +12,12 @@ type Router struct {
 func (r Router) MapRoutes(registry *app.ComponentRegistry, routerGroup *gin.RouterGroup) {
 	NewContentController(routerGroup, registry.Get("ContentService").(*appservices.ContentService),
 		registry.Get("ContentQuery").(*appservices.ContentQuery)).MapRoutes()
+	NewContentTypeController(routerGroup, registry.Get("ContentService").(*appservices.ContentService),
+		registry.Get("ContentQuery").(*appservices.ContentQuery)).MapRoutes()
+	NewChangeRequestController(routerGroup, registry.Get("ChangeRequestService").(*appservices.ChangeRequestService),
+		registry.Get("ChangeRequestQuery").(*appservices.ChangeRequestQuery)).MapRoutes()
+	NewNotificationController(routerGroup, registry.Get("NotificationService").(*appservices.NotificationService),
+		registry.Get("NotificationQuery").(*appservices.NotificationQuery)).MapRoutes()
+	NewAssetController(routerGroup, registry.Get("AssetService").(*appservices.AssetService),
+		registry.Get("AssetQuery").(*appservices.AssetQuery)).MapRoutes()
 }
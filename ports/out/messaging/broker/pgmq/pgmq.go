@@ -14,6 +14,22 @@ import (
 
 const vtDefault = 30
 
+// ContentNotificationQueue receives a copy of the content events for the notification consumer, a message is read by
+// a single consumer so it cannot share the content queue with the content projections.
+const ContentNotificationQueue = "content_notification"
+
+// ContentReferenceQueue receives a copy of the content events for the consumer keeping the reverse-reference index.
+const ContentReferenceQueue = "content_reference"
+
+// ContentChangeRequestQueue receives a copy of the content events for the consumer erasing the purged fields from change requests.
+const ContentChangeRequestQueue = "content_change_request"
+
+// subscriberQueues are the queues receiving a copy of every message sent to a queue.
+var subscriberQueues = map[string][]string{
+	"members": {"members_for_console"},
+	"content": {ContentNotificationQueue, ContentReferenceQueue, ContentChangeRequestQueue},
+}
+
 type PostgresMessagingQueue struct {
 }
 
@@ -48,8 +64,8 @@ func (e *PostgresMessagingQueue) PublishMessage(ctx context.Context, queueName,
 		return errors.Wrap(err, "failed to send message to pgmq")
 	}
 
-	if queueName == "members" {
-		if err := db.Exec("SELECT * from pgmq.send(queue_name  => ?, msg => ?)", "members_for_console", message).Error; err != nil {
+	for _, subscriberQueue := range subscriberQueues[queueName] {
+		if err := db.Exec("SELECT * from pgmq.send(queue_name  => ?, msg => ?)", subscriberQueue, message).Error; err != nil {
 			return errors.Wrap(err, "failed to send message to pgmq")
 		}
 	}
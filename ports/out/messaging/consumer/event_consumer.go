@@ -15,10 +15,17 @@ import (
 type EventConsumer struct {
 	messageBroker broker.MessageBroker
 	eventHandler  EventHandler
+	queueName     string
 }
 
 func NewEventConsumer(messageBroker broker.MessageBroker, eventHandler EventHandler) *EventConsumer {
-	return &EventConsumer{messageBroker: messageBroker, eventHandler: eventHandler}
+	return NewQueueEventConsumer(messageBroker, string(eventHandler.GetAggregateType()), eventHandler)
+}
+
+// NewQueueEventConsumer consumes the events of the aggregate type of the handler from queueName, a queue other than
+// the one of the aggregate type which receives a copy of its events.
+func NewQueueEventConsumer(messageBroker broker.MessageBroker, queueName string, eventHandler EventHandler) *EventConsumer {
+	return &EventConsumer{messageBroker: messageBroker, eventHandler: eventHandler, queueName: queueName}
 }
 
 func (c *EventConsumer) Consume(ctx context.Context) {
@@ -28,7 +35,7 @@ func (c *EventConsumer) Consume(ctx context.Context) {
 	go func() {
 		for {
 			time.Sleep(1 * time.Second)
-			messageEnvelope, err := c.messageBroker.ReadMessage(ctx, string(c.eventHandler.GetAggregateType()), 30)
+			messageEnvelope, err := c.messageBroker.ReadMessage(ctx, c.queueName, 30)
 			if err != nil {
 				if errors.Is(err, persistence.ErrRecordNotFound) {
 					continue
@@ -60,7 +67,7 @@ func (c *EventConsumer) Consume(ctx context.Context) {
 				continue
 			}
 
-			_, err = c.messageBroker.DeleteMessage(ctx, string(c.eventHandler.GetAggregateType()), messageEnvelope.MsgId)
+			_, err = c.messageBroker.DeleteMessage(ctx, c.queueName, messageEnvelope.MsgId)
 			if err != nil {
 				log.Error(err)
 				continue
@@ -3,5 +3,6 @@ package persistence
 import "github.com/pkg/errors"
 
 var (
-	ErrRecordNotFound = errors.New("not found record")
+	ErrRecordNotFound  = errors.New("not found record")
+	ErrDuplicateRecord = errors.New("duplicate record")
 )
@@ -6,6 +6,9 @@ import "fmt"
 const (
 	changesEventsCap = 10
 	startVersion     = 0
+
+	// DefaultBranch is the branch every aggregate event stream starts on.
+	DefaultBranch = "main"
 )
 
 type When interface {
@@ -43,6 +46,8 @@ type AggregateRoot interface {
 	SetType(aggregateType AggregateType)
 	GetTenantId() string
 	SetTenantId(tenantId string) *AggregateBase
+	GetBranch() string
+	SetBranch(branch string) *AggregateBase
 	GetChanges() []any
 	ClearChanges()
 	GetVersion() uint64
@@ -60,6 +65,7 @@ type AggregateType string
 type AggregateBase struct {
 	ID       string
 	TenantId string
+	Branch   string
 	Version  uint64
 	Changes  []any
 	Type     AggregateType
@@ -85,6 +91,7 @@ func NewAggregateBase(when when) *AggregateBase {
 	}
 
 	return &AggregateBase{
+		Branch:  DefaultBranch,
 		Version: startVersion,
 		Changes: make([]any, 0, changesEventsCap),
 		when:    when,
@@ -126,6 +133,17 @@ func (a *AggregateBase) SetTenantId(tenantId string) *AggregateBase {
 	return a
 }
 
+// GetBranch get AggregateBase branch, the event stream of the aggregate is keyed by ID and branch
+func (a *AggregateBase) GetBranch() string {
+	return a.Branch
+}
+
+// SetBranch set AggregateBase branch
+func (a *AggregateBase) SetBranch(branch string) *AggregateBase {
+	a.Branch = branch
+	return a
+}
+
 // ClearChanges clear AggregateBase uncommitted Event's
 func (a *AggregateBase) ClearChanges() {
 	a.Changes = make([]any, 0, changesEventsCap)
@@ -179,8 +197,9 @@ func (a *AggregateBase) ToSnapshot() {
 }
 
 func (a *AggregateBase) String() string {
-	return fmt.Sprintf("(Aggregate) AggregateID: %s, Type: %s, Version: %v, Changes: %d",
+	return fmt.Sprintf("(Aggregate) AggregateID: %s, Branch: %s, Type: %s, Version: %v, Changes: %d",
 		a.GetID(),
+		a.GetBranch(),
 		string(a.GetType()),
 		a.GetVersion(),
 		len(a.GetChanges()),
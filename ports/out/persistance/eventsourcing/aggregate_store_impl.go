@@ -4,15 +4,18 @@ import (
 	"contentgit/ports/out/persistance/eventsourcing/serializer"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
-// Load eventsourcing.Aggregate events using snapshots with given frequency
+// Load eventsourcing.Aggregate events using snapshots with given frequency.
+// The ids of the aggregates are unique across tenants, so that a loaded aggregate takes the tenant its events were
+// stored with, which callers compare with the tenant of the request.
 func (m *rdbEventStore) Load(ctx context.Context, aggregate Aggregate) error {
-	snapshot, err := m.GetSnapshot(ctx, aggregate.GetID())
+	snapshot, err := m.GetSnapshot(ctx, aggregate.GetID(), aggregate.GetBranch())
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return err
 	}
@@ -43,29 +46,86 @@ func (m *rdbEventStore) Load(ctx context.Context, aggregate Aggregate) error {
 	return nil
 }
 
+// LoadAtVersion load eventsourcing.Aggregate events up to the given version, starting from the nearest earlier snapshot
+func (m *rdbEventStore) LoadAtVersion(ctx context.Context, aggregate Aggregate, version uint64) error {
+	snapshot, err := m.GetSnapshotAtVersion(ctx, aggregate.GetID(), aggregate.GetBranch(), version)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	if snapshot != nil {
+		if err := serializer.Unmarshal(snapshot.State, aggregate); err != nil {
+			log.Info("(LoadAtVersion) serializer.Unmarshal err", err)
+			return errors.Wrap(err, "json.Unmarshal")
+		}
+	}
+
+	events, err := m.eventRepository.FindByAggregateIdAndVersionRange(ctx, aggregate.GetID(), aggregate.GetBranch(), aggregate.GetVersion(), version)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		deserializedEvent, err := m.serializer.DeserializeEvent(ctx, event)
+		if err != nil {
+			return errors.Wrap(err, "(LoadAtVersion) serializer.DeserializeEvent err")
+		}
+
+		if err := aggregate.RaiseEvent(deserializedEvent); err != nil {
+			return errors.Wrap(err, "(LoadAtVersion) aggregate.RaiseEvent err")
+		}
+		aggregate.SetTenantId(event.GetTenantId())
+	}
+
+	if aggregate.GetVersion() != version {
+		return errors.Wrapf(ErrVersionNotFound, "aggregateID: %s, branch: %s, version: %d", aggregate.GetID(), aggregate.GetBranch(), version)
+	}
+
+	log.Info(fmt.Sprintf("(Load Aggregate At Version) aggregate: %s", aggregate.String()))
+	return nil
+}
+
+// LoadAsOf load eventsourcing.Aggregate events up to the last event stored at or before asOf
+func (m *rdbEventStore) LoadAsOf(ctx context.Context, aggregate Aggregate, asOf time.Time) error {
+	event, err := m.eventRepository.FindLastByAggregateIdAndCreatedAt(ctx, aggregate.GetID(), aggregate.GetBranch(), asOf)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.Wrapf(ErrVersionNotFound, "aggregateID: %s, branch: %s, asOf: %s", aggregate.GetID(), aggregate.GetBranch(), asOf)
+		}
+		return errors.Wrap(err, "(LoadAsOf) db.Query err")
+	}
+
+	return m.LoadAtVersion(ctx, aggregate, event.GetVersion())
+}
+
 // Save eventsourcing.Aggregate events using snapshots with given frequency
-func (m *rdbEventStore) Save(ctx context.Context, aggregate Aggregate) error {
+func (m *rdbEventStore) Save(ctx context.Context, aggregate Aggregate, expectedVersion uint64) error {
 	if len(aggregate.GetChanges()) == 0 {
 		log.Info("(Save) aggregate.GetChanges()) == 0")
 		return nil
 	}
 
 	changes := aggregate.GetChanges()
+	if aggregate.GetVersion() != expectedVersion+uint64(len(changes)) {
+		return errors.Wrapf(ErrConcurrencyConflict, "(Save) expected version: %d, aggregate: %s", expectedVersion, aggregate.String())
+	}
+
 	events := make([]Event, 0, len(changes))
 
 	for i := range changes {
-		event, err := m.serializer.SerializeEvent(aggregate, changes[i])
+		event, err := m.serializer.SerializeEvent(ctx, aggregate, changes[i])
 		if err != nil {
 			return errors.Wrap(err, "(Save) serializer.SerializeEvent err")
 		}
+		event.SetVersion(expectedVersion + uint64(i) + 1)
 		events = append(events, event)
 	}
 
 	if err := m.saveEventsTx(ctx, events); err != nil {
-		return errors.Wrap(err, "saveEventsTx")
+		return errors.Wrap(err, "(Save) saveEventsTx")
 	}
 
-	if aggregate.GetVersion()%snapshotFrequency == 0 {
+	if expectedVersion/snapshotFrequency < aggregate.GetVersion()/snapshotFrequency {
 		aggregate.ToSnapshot()
 		if err := m.saveSnapshotTx(ctx, aggregate); err != nil {
 			return errors.Wrap(err, "saveSnapshotTx")
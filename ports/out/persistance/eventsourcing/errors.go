@@ -10,4 +10,6 @@ var (
 	ErrInvalidAggregate    = errors.New("invalid aggregate")
 	ErrInvalidAggregateID  = errors.New("invalid aggregate id")
 	ErrInvalidEventVersion = errors.New("Invalid event version")
+	ErrConcurrencyConflict = errors.New("concurrency conflict")
+	ErrVersionNotFound     = errors.New("version not found")
 )
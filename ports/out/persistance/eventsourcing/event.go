@@ -16,13 +16,14 @@ type EventType string
 // represented by each DBs internal event type, implementing Event.
 type Event struct {
 	gorm.Model
-	AggregateID   string        `gorm:"type:varchar(100);not null;uniqueIndex:idx_unique;index:idx_aggregate_id_version"`
+	AggregateID   string        `gorm:"type:varchar(100);not null;uniqueIndex:idx_events_stream_version"`
 	TenantId      string        `gorm:"type:varchar(100);not null"`
+	Branch        string        `gorm:"type:varchar(100);not null;default:main;uniqueIndex:idx_events_stream_version"`
 	AggregateType AggregateType `gorm:"type:varchar(250);not null"`
 	EventType     EventType     `gorm:"type:varchar(250);not null"`
 	Data          string        `gorm:"type:jsonb"`
 	Metadata      *string       `gorm:"type:jsonb"`
-	Version       uint64        `gorm:"not null;uniqueIndex:idx_unique;index:idx_aggregate_id_version"`
+	Version       uint64        `gorm:"not null;uniqueIndex:idx_events_stream_version"`
 }
 
 func (*Event) TableName() string {
@@ -35,6 +36,7 @@ func NewBaseEvent(aggregate Aggregate, eventType EventType) Event {
 		AggregateType: aggregate.GetType(),
 		TenantId:      aggregate.GetTenantId(),
 		AggregateID:   aggregate.GetID(),
+		Branch:        aggregate.GetBranch(),
 		Version:       aggregate.GetVersion(),
 		EventType:     eventType,
 	}
@@ -44,6 +46,7 @@ func NewEvent(aggregate Aggregate, eventType EventType, data string, metadata *s
 	return Event{
 		AggregateID:   aggregate.GetID(),
 		TenantId:      aggregate.GetTenantId(),
+		Branch:        aggregate.GetBranch(),
 		EventType:     eventType,
 		AggregateType: aggregate.GetType(),
 		Version:       aggregate.GetVersion(),
@@ -109,6 +112,15 @@ func (e *Event) GetAggregateID() string {
 	return e.AggregateID
 }
 
+// GetBranch is the branch of the Aggregate event stream that the Event belongs to.
+// Events stored before branches existed belong to DefaultBranch.
+func (e *Event) GetBranch() string {
+	if e.Branch == "" {
+		return DefaultBranch
+	}
+	return e.Branch
+}
+
 // GetVersion is the version of the Aggregate after the Event has been applied.
 func (e *Event) GetVersion() uint64 {
 	return e.Version
@@ -150,9 +162,10 @@ func (e *Event) GetTenantId() string {
 }
 
 func (e *Event) String() string {
-	return fmt.Sprintf("(Event) AggregateID: %s, TenantId: %s, Version: %d, EventType: %s, AggregateType: %s, Metadata: %s, TimeStamp: %s, EventID: %d",
+	return fmt.Sprintf("(Event) AggregateID: %s, TenantId: %s, Branch: %s, Version: %d, EventType: %s, AggregateType: %s, Metadata: %s, TimeStamp: %s, EventID: %d",
 		e.AggregateID,
 		e.TenantId,
+		e.GetBranch(),
 		e.Version,
 		e.EventType,
 		e.AggregateType,
@@ -1,17 +1,28 @@
 package eventsourcing
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // AggregateStore is responsible for loading and saving Aggregate.
 type AggregateStore interface {
 	// Load loads the most recent version of an aggregate to provided  into params aggregate with a type and id.
 	Load(ctx context.Context, aggregate Aggregate) error
 
+	// LoadAtVersion loads an aggregate as it was right after the event with the given version was applied.
+	LoadAtVersion(ctx context.Context, aggregate Aggregate, version uint64) error
+
+	// LoadAsOf loads an aggregate as it was at the given time, ErrVersionNotFound is returned when it did not exist yet.
+	LoadAsOf(ctx context.Context, aggregate Aggregate, asOf time.Time) error
+
 	// Save saves the uncommitted events for an aggregate.
-	Save(ctx context.Context, aggregate Aggregate) error
+	// expectedVersion is the version the aggregate was loaded at, ErrConcurrencyConflict is returned
+	// when the stored event stream has moved past it in the meantime.
+	Save(ctx context.Context, aggregate Aggregate, expectedVersion uint64) error
 
-	// Exists check aggregate exists by id.
-	Exists(ctx context.Context, aggregateID string) (bool, error)
+	// Exists check aggregate exists by id on the given branch.
+	Exists(ctx context.Context, aggregateID string, branch string) (bool, error)
 
 	EventStore
 	SnapshotStore
@@ -22,8 +33,8 @@ type EventStore interface {
 	// SaveEvents appends all events in the Event stream to the store.
 	SaveEvents(ctx context.Context, events []Event) error
 
-	// LoadEvents loads all events for the Aggregate id from the store.
-	LoadEvents(ctx context.Context, aggregateID string) ([]Event, error)
+	// LoadEvents loads all events for the Aggregate id on the given branch from the store.
+	LoadEvents(ctx context.Context, aggregateID string, branch string) ([]Event, error)
 }
 
 // SnapshotStore is an interface for an event sourcing Snapshot store.
@@ -31,6 +42,12 @@ type SnapshotStore interface {
 	// SaveSnapshot save aggregate snapshot.
 	SaveSnapshot(ctx context.Context, aggregate Aggregate) error
 
-	// GetSnapshot load aggregate snapshot.
-	GetSnapshot(ctx context.Context, id string) (*Snapshot, error)
+	// GetSnapshot load the latest aggregate snapshot.
+	GetSnapshot(ctx context.Context, id string, branch string) (*Snapshot, error)
+
+	// GetSnapshotAtVersion load the latest aggregate snapshot that is not newer than the given version.
+	GetSnapshotAtVersion(ctx context.Context, id string, branch string, version uint64) (*Snapshot, error)
+
+	// DeleteSnapshots deletes every snapshot of the aggregate, on all branches.
+	DeleteSnapshots(ctx context.Context, id string) error
 }
@@ -43,20 +43,20 @@ func (m *rdbEventStore) SaveEvents(ctx context.Context, events []Event) error {
 	return nil
 }
 
-// LoadEvents load aggregate events by id
-func (m *rdbEventStore) LoadEvents(ctx context.Context, aggregateID string) ([]Event, error) {
-	return m.eventRepository.FindByAggregateId(ctx, aggregateID)
+// LoadEvents load aggregate events by id and branch
+func (m *rdbEventStore) LoadEvents(ctx context.Context, aggregateID string, branch string) ([]Event, error) {
+	return m.eventRepository.FindByAggregateId(ctx, aggregateID, branch)
 }
 
 // LoadEvents load aggregate events by id
 func (m *rdbEventStore) loadEvents(ctx context.Context, aggregate Aggregate) error {
-	events, err := m.eventRepository.FindByAggregateId(ctx, aggregate.GetID())
+	events, err := m.eventRepository.FindByAggregateId(ctx, aggregate.GetID(), aggregate.GetBranch())
 	if err != nil {
 		return errors.Wrap(err, "(loadEvents) db.Query err")
 	}
 
 	for _, event := range events {
-		deserializedEvent, err := m.serializer.DeserializeEvent(event)
+		deserializedEvent, err := m.serializer.DeserializeEvent(ctx, event)
 		if err != nil {
 			return errors.Wrap(err, "(loadEvents) serializer.DeserializeEvent err")
 		}
@@ -64,14 +64,15 @@ func (m *rdbEventStore) loadEvents(ctx context.Context, aggregate Aggregate) err
 		if err := aggregate.RaiseEvent(deserializedEvent); err != nil {
 			return errors.Wrap(err, "(loadEvents) aggregate.RaiseEvent err")
 		}
+		aggregate.SetTenantId(event.GetTenantId())
 	}
 
 	return nil
 }
 
-// Exists check for exists aggregate by id
-func (m *rdbEventStore) Exists(ctx context.Context, aggregateID string) (bool, error) {
-	_, err := m.eventRepository.FindOneByAggregateId(ctx, aggregateID, false)
+// Exists check for exists aggregate by id and branch
+func (m *rdbEventStore) Exists(ctx context.Context, aggregateID string, branch string) (bool, error) {
+	_, err := m.eventRepository.FindOneByAggregateId(ctx, aggregateID, branch, false)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return false, nil
@@ -83,18 +84,14 @@ func (m *rdbEventStore) Exists(ctx context.Context, aggregateID string) (bool, e
 	return true, nil
 }
 
-func (m *rdbEventStore) loadEventsByVersion(ctx context.Context, aggregateID string, versionFrom uint64) ([]Event, error) {
-	return m.eventRepository.FindByAggregateIdAndVersion(ctx, aggregateID, versionFrom)
-}
-
 func (m *rdbEventStore) loadAggregateEventsByVersion(ctx context.Context, aggregate Aggregate) error {
-	events, err := m.eventRepository.FindByAggregateIdAndVersion(ctx, aggregate.GetID(), aggregate.GetVersion())
+	events, err := m.eventRepository.FindByAggregateIdAndVersion(ctx, aggregate.GetID(), aggregate.GetBranch(), aggregate.GetVersion())
 	if err != nil {
 		return err
 	}
 
 	for _, event := range events {
-		deserializedEvent, err := m.serializer.DeserializeEvent(event)
+		deserializedEvent, err := m.serializer.DeserializeEvent(ctx, event)
 		if err != nil {
 			return errors.Wrap(err, "(loadAggregateEventsByVersion) serializer.DeserializeEvent err")
 		}
@@ -102,21 +99,18 @@ func (m *rdbEventStore) loadAggregateEventsByVersion(ctx context.Context, aggreg
 		if err := aggregate.RaiseEvent(deserializedEvent); err != nil {
 			return errors.Wrap(err, "(loadAggregateEventsByVersion) aggregate.RaiseEvent err")
 		}
+		aggregate.SetTenantId(event.GetTenantId())
 	}
 
 	return nil
 }
 
-func (m *rdbEventStore) loadEventsByVersionTx(ctx context.Context, aggregateID string, versionFrom uint64) ([]Event, error) {
-	return m.eventRepository.FindByAggregateIdAndVersion(ctx, aggregateID, versionFrom)
-}
-
 func (m *rdbEventStore) saveEventsTx(ctx context.Context, events []Event) error {
 	if err := m.handleConcurrency(ctx, events); err != nil {
 		return err
 	}
 
-	log.Info(fmt.Sprintf("(saveEventsTx) AggregateID: %s, AggregateVersion: %v, AggregateType: %s", events[0].GetAggregateID(), events[0].GetVersion(), events[0].GetAggregateType()))
+	log.Info(fmt.Sprintf("(saveEventsTx) AggregateID: %s, Branch: %s, AggregateVersion: %v, AggregateType: %s", events[0].GetAggregateID(), events[0].GetBranch(), events[0].GetVersion(), events[0].GetAggregateType()))
 	return m.eventRepository.Save(ctx, events)
 }
 
@@ -133,14 +127,25 @@ func (m *rdbEventStore) processEvents(ctx context.Context, events []Event) error
 	return m.eventBus.ProcessEvents(ctx, events)
 }
 
+// handleConcurrency locks the head of the event stream and checks that it is still at the version
+// the new events were built on. A concurrent writer that slipped in between is reported as ErrConcurrencyConflict.
 func (m *rdbEventStore) handleConcurrency(ctx context.Context, events []Event) error {
-	_, err := m.eventRepository.FindOneByAggregateId(ctx, events[0].GetAggregateID(), true)
+	expectedVersion := events[0].GetVersion() - 1
+
+	lastEvent, err := m.eventRepository.FindLastByAggregateId(ctx, events[0].GetAggregateID(), events[0].GetBranch(), true)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if expectedVersion != startVersion {
+				return errors.Wrapf(ErrConcurrencyConflict, "aggregateID: %s, expected version: %d, actual version: %d", events[0].GetAggregateID(), expectedVersion, startVersion)
+			}
 			return nil
 		}
 		return errors.Wrap(err, "(handleConcurrency) tx.Exec")
 	}
 
+	if lastEvent.GetVersion() != expectedVersion {
+		return errors.Wrapf(ErrConcurrencyConflict, "aggregateID: %s, expected version: %d, actual version: %d", events[0].GetAggregateID(), expectedVersion, lastEvent.GetVersion())
+	}
+
 	return nil
 }
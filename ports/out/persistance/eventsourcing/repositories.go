@@ -5,36 +5,42 @@ import (
 	"context"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pkg/errors"
 	"gorm.io/gorm/clause"
 )
 
+const uniqueViolationCode = "23505"
+
 type EventRepository struct {
 }
 
 func (r EventRepository) Save(ctx context.Context, events []Event) error {
 	db := foundation.ContextProvider().GetDB(ctx)
 	if err := db.Create(events).Error; err != nil {
+		if isUniqueViolation(err) {
+			return errors.Wrap(ErrConcurrencyConflict, err.Error())
+		}
 		return errors.Wrap(err, "(SaveEvents) tx.Exec err")
 	}
 	return nil
 }
 
-func (r EventRepository) FindByAggregateIdAndVersion(ctx context.Context, aggregateID string, versionFrom uint64) ([]Event, error) {
+func (r EventRepository) FindByAggregateIdAndVersion(ctx context.Context, aggregateID string, branch string, versionFrom uint64) ([]Event, error) {
 	db := foundation.ContextProvider().GetDB(ctx)
 	events := make([]Event, 0)
 
-	if err := db.Where("aggregate_id = ? AND version > ?", aggregateID, versionFrom).Order("version ASC").Find(&events).Error; err != nil {
+	if err := db.Where("aggregate_id = ? AND branch = ? AND version > ?", aggregateID, branch, versionFrom).Order("version ASC").Find(&events).Error; err != nil {
 		return nil, errors.Wrap(err, "(FindByAggregateIdAndVersion) db.Query err")
 	}
 
 	return events, nil
 }
 
-func (r EventRepository) FindOneByAggregateId(ctx context.Context, aggregateID string, forUpdate bool) (*Event, error) {
+func (r EventRepository) FindOneByAggregateId(ctx context.Context, aggregateID string, branch string, forUpdate bool) (*Event, error) {
 	db := foundation.ContextProvider().GetDB(ctx)
 
-	db = db.Where("aggregate_id = ?", aggregateID)
+	db = db.Where("aggregate_id = ? AND branch = ?", aggregateID, branch)
 	if forUpdate {
 		db = db.Clauses(clause.Locking{Strength: "UPDATE"})
 	}
@@ -44,14 +50,52 @@ func (r EventRepository) FindOneByAggregateId(ctx context.Context, aggregateID s
 		return nil, err
 	}
 
-	return nil, nil
+	return &event, nil
+}
+
+func (r EventRepository) FindLastByAggregateId(ctx context.Context, aggregateID string, branch string, forUpdate bool) (*Event, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	db = db.Where("aggregate_id = ? AND branch = ?", aggregateID, branch).Order("version DESC")
+	if forUpdate {
+		db = db.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
+	event := Event{}
+	if err := db.Take(&event).Error; err != nil {
+		return nil, err
+	}
+
+	return &event, nil
 }
 
-func (r EventRepository) FindByAggregateId(ctx context.Context, aggregateID string) ([]Event, error) {
+func (r EventRepository) FindByAggregateIdAndVersionRange(ctx context.Context, aggregateID string, branch string, versionFrom uint64, versionTo uint64) ([]Event, error) {
 	db := foundation.ContextProvider().GetDB(ctx)
 	events := make([]Event, 0)
 
-	if err := db.Where("aggregate_id = ?", aggregateID).Order("version ASC").Find(&events).Error; err != nil {
+	if err := db.Where("aggregate_id = ? AND branch = ? AND version > ? AND version <= ?", aggregateID, branch, versionFrom, versionTo).Order("version ASC").Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "(FindByAggregateIdAndVersionRange) db.Query err")
+	}
+
+	return events, nil
+}
+
+func (r EventRepository) FindLastByAggregateIdAndCreatedAt(ctx context.Context, aggregateID string, branch string, createdAtTo time.Time) (*Event, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	event := Event{}
+	if err := db.Where("aggregate_id = ? AND branch = ? AND created_at <= ?", aggregateID, branch, createdAtTo).Order("version DESC").Take(&event).Error; err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (r EventRepository) FindByAggregateId(ctx context.Context, aggregateID string, branch string) ([]Event, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+	events := make([]Event, 0)
+
+	if err := db.Where("aggregate_id = ? AND branch = ?", aggregateID, branch).Order("version ASC").Find(&events).Error; err != nil {
 		return nil, errors.Wrap(err, "(FindByAggregateId) db.Query err")
 	}
 
@@ -65,7 +109,7 @@ func (r SnapshotRepository) Save(ctx context.Context, snapshot *Snapshot) error
 	db := foundation.ContextProvider().GetDB(ctx)
 
 	if err := db.Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "aggregate_id"}},
+		Columns:   []clause.Column{{Name: "aggregate_id"}, {Name: "branch"}, {Name: "version"}},
 		DoUpdates: clause.Assignments(map[string]any{"data": snapshot.State, "version": snapshot.Version, "updated_at": time.Now()}),
 	}).Create(&snapshot).Error; err != nil {
 		return errors.Wrap(err, "(Save Snapshot) tx.Exec err")
@@ -74,13 +118,39 @@ func (r SnapshotRepository) Save(ctx context.Context, snapshot *Snapshot) error
 	return nil
 }
 
-func (r SnapshotRepository) FindOneByAggregateId(ctx context.Context, aggregateId string) (*Snapshot, error) {
+func (r SnapshotRepository) FindOneByAggregateId(ctx context.Context, aggregateId string, branch string) (*Snapshot, error) {
 	db := foundation.ContextProvider().GetDB(ctx)
 
 	snapshot := Snapshot{}
-	if err := db.Where("aggregate_id = ?", aggregateId).First(&snapshot).Error; err != nil {
+	if err := db.Where("aggregate_id = ? AND branch = ?", aggregateId, branch).Order("version DESC").Take(&snapshot).Error; err != nil {
 		return nil, err
 	}
 
 	return &snapshot, nil
 }
+
+func (r SnapshotRepository) FindOneByAggregateIdAndVersion(ctx context.Context, aggregateId string, branch string, versionTo uint64) (*Snapshot, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	snapshot := Snapshot{}
+	if err := db.Where("aggregate_id = ? AND branch = ? AND version <= ?", aggregateId, branch, versionTo).Order("version DESC").Take(&snapshot).Error; err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+func (r SnapshotRepository) DeleteByAggregateId(ctx context.Context, aggregateId string) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Unscoped().Where("aggregate_id = ?", aggregateId).Delete(&Snapshot{}).Error; err != nil {
+		return errors.Wrap(err, "(DeleteByAggregateId) tx.Exec err")
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
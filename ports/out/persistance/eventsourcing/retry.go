@@ -0,0 +1,32 @@
+package eventsourcing
+
+import (
+	"contentgit/foundation"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// RetryOnConcurrencyConflict runs fn and runs it again, up to maxAttempts times, while it fails with ErrConcurrencyConflict.
+// Each attempt runs in a nested transaction (a savepoint when the context already carries a transaction),
+// so a failed attempt is rolled back without aborting the surrounding transaction.
+// fn is expected to load the aggregate, apply the command and save it.
+func RetryOnConcurrencyConflict(ctx context.Context, maxAttempts int, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		db := foundation.ContextProvider().GetDB(ctx)
+		err = db.Transaction(func(tx *gorm.DB) error {
+			return fn(foundation.ContextProvider().SetDB(ctx, tx))
+		})
+		if !errors.Is(err, ErrConcurrencyConflict) {
+			return err
+		}
+
+		log.Info(fmt.Sprintf("(RetryOnConcurrencyConflict) attempt: %d/%d, err: %v", attempt, maxAttempts, err))
+	}
+
+	return err
+}
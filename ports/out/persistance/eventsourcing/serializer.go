@@ -1,6 +1,8 @@
 package eventsourcing
 
+import "context"
+
 type Serializer interface {
-	SerializeEvent(aggregate Aggregate, event any) (Event, error)
-	DeserializeEvent(event Event) (any, error)
+	SerializeEvent(ctx context.Context, aggregate Aggregate, event any) (Event, error)
+	DeserializeEvent(ctx context.Context, event Event) (any, error)
 }
@@ -9,22 +9,25 @@ import (
 )
 
 // Snapshot Event Sourcing Snapshotting is an optimisation that reduces time spent on reading event from an event store.
+// Snapshots are kept per version so that an aggregate can also be rebuilt quickly at an earlier version.
 type Snapshot struct {
 	gorm.Model
-	AggregateId string        `gorm:"type:varchar(100);not null;uniqueIndex:idx_snapshot_unique;index:idx_snapshot_aggregate_id_version"`
+	AggregateId string        `gorm:"type:varchar(100);not null;uniqueIndex:idx_snapshots_stream_version"`
 	TenantId    string        `gorm:"type:varchar(100);not null"`
+	Branch      string        `gorm:"type:varchar(100);not null;default:main;uniqueIndex:idx_snapshots_stream_version"`
 	Type        AggregateType `gorm:"column:aggregate_type;type:varchar(250);not null"`
 	State       string        `gorm:"column:data;type:jsonb"`
-	Version     uint64        `gorm:"not null;index:idx_snapshot_aggregate_id_version"`
+	Version     uint64        `gorm:"not null;uniqueIndex:idx_snapshots_stream_version"`
 }
 
 func (*Snapshot) TableName() string {
 	return "snapshots"
 }
 func (s *Snapshot) String() string {
-	return fmt.Sprintf("AggregateID: %s, TenantId: %s, Type: %s, StateSize: %d, Version: %d",
+	return fmt.Sprintf("AggregateID: %s, TenantId: %s, Branch: %s, Type: %s, StateSize: %d, Version: %d",
 		s.AggregateId,
 		s.TenantId,
+		s.Branch,
 		string(s.Type),
 		len(s.State),
 		s.Version,
@@ -41,6 +44,7 @@ func NewSnapshotFromAggregate(aggregate Aggregate) (*Snapshot, error) {
 	return &Snapshot{
 		AggregateId: aggregate.GetID(),
 		TenantId:    aggregate.GetTenantId(),
+		Branch:      aggregate.GetBranch(),
 		Type:        aggregate.GetType(),
 		State:       aggregateJson,
 		Version:     aggregate.GetVersion(),
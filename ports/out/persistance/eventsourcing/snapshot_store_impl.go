@@ -20,8 +20,8 @@ func (m *rdbEventStore) SaveSnapshot(ctx context.Context, aggregate Aggregate) e
 }
 
 // GetSnapshot load eventsourcing.Aggregate snapshot
-func (m *rdbEventStore) GetSnapshot(ctx context.Context, id string) (*Snapshot, error) {
-	snapshot, err := m.snapshotRepository.FindOneByAggregateId(ctx, id)
+func (m *rdbEventStore) GetSnapshot(ctx context.Context, id string, branch string) (*Snapshot, error) {
+	snapshot, err := m.snapshotRepository.FindOneByAggregateId(ctx, id, branch)
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -33,3 +33,23 @@ func (m *rdbEventStore) GetSnapshot(ctx context.Context, id string) (*Snapshot,
 	log.Info(fmt.Sprintf("(GetSnapshot) snapshot: %s", snapshot.String()))
 	return snapshot, nil
 }
+
+// GetSnapshotAtVersion load the nearest eventsourcing.Aggregate snapshot at or before the given version
+func (m *rdbEventStore) GetSnapshotAtVersion(ctx context.Context, id string, branch string, version uint64) (*Snapshot, error) {
+	snapshot, err := m.snapshotRepository.FindOneByAggregateIdAndVersion(ctx, id, branch, version)
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "db.QueryRow")
+	}
+
+	log.Info(fmt.Sprintf("(GetSnapshotAtVersion) snapshot: %s", snapshot.String()))
+	return snapshot, nil
+}
+
+// DeleteSnapshots delete every eventsourcing.Aggregate snapshot on all branches
+func (m *rdbEventStore) DeleteSnapshots(ctx context.Context, id string) error {
+	return m.snapshotRepository.DeleteByAggregateId(ctx, id)
+}
@@ -0,0 +1,66 @@
+package rdb
+
+import (
+	"contentgit/domain/asset/projections"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type AssetProjectionRepositoryImpl struct {
+}
+
+func (AssetProjectionRepositoryImpl) Create(ctx context.Context, projection projections.AssetProjection) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Create(&projection).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+// FindByID returns the asset, deleted or not.
+func (AssetProjectionRepositoryImpl) FindByID(ctx context.Context, tenantId string, id string) (*projections.AssetProjection, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var projection projections.AssetProjection
+	if err := db.First(&projection, "tenant_id = ? AND id = ?", tenantId, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &projection, nil
+}
+
+// FindAll returns the assets of the tenant that are not deleted, the latest uploaded first.
+func (AssetProjectionRepositoryImpl) FindAll(ctx context.Context, tenantId string, pageable dtos.Pageable) ([]projections.AssetProjection, int64, error) {
+	db := foundation.ContextProvider().GetDB(ctx).Model(&projections.AssetProjection{})
+
+	var entities = make([]projections.AssetProjection, 0)
+	var totalCount int64
+
+	db = db.Where("tenant_id = ? AND deleted_at IS NULL", tenantId).Order("created_at desc, id")
+	if err := db.Count(&totalCount).Scopes(foundation.GormPaginator().Pageable(pageable)).Find(&entities).Error; err != nil {
+		return entities, totalCount, errors.Wrap(err, "db error")
+	}
+
+	return entities, totalCount, nil
+}
+
+func (AssetProjectionRepositoryImpl) Save(ctx context.Context, entity *projections.AssetProjection) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Save(entity).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
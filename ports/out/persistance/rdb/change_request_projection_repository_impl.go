@@ -0,0 +1,68 @@
+package rdb
+
+import (
+	"contentgit/domain/changerequest/projections"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type ChangeRequestProjectionRepositoryImpl struct {
+}
+
+func (ChangeRequestProjectionRepositoryImpl) Create(ctx context.Context, projection projections.ChangeRequestProjection) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Create(&projection).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (ChangeRequestProjectionRepositoryImpl) FindByID(ctx context.Context, tenantId string, id string) (*projections.ChangeRequestProjection, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var projection projections.ChangeRequestProjection
+	if err := db.Preload("Reviews", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at, id")
+	}).Preload("Comments", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at, id")
+	}).First(&projection, "tenant_id = ? AND id = ?", tenantId, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &projection, nil
+}
+
+// FindAllByContentId returns the change requests of the content in state, all of them when state is empty, the latest first.
+func (ChangeRequestProjectionRepositoryImpl) FindAllByContentId(ctx context.Context, tenantId string, contentId string, state string) ([]projections.ChangeRequestProjection, error) {
+	db := foundation.ContextProvider().GetDB(ctx).Where("tenant_id = ? AND content_id = ?", tenantId, contentId)
+	if state != "" {
+		db = db.Where("state = ?", state)
+	}
+
+	var entities = make([]projections.ChangeRequestProjection, 0)
+	if err := db.Order("created_at desc, id").Find(&entities).Error; err != nil {
+		return entities, errors.Wrap(err, "db error")
+	}
+
+	return entities, nil
+}
+
+func (ChangeRequestProjectionRepositoryImpl) Save(ctx context.Context, entity *projections.ChangeRequestProjection) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Session(&gorm.Session{FullSaveAssociations: true}).Save(entity).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package rdb
+
+import (
+	"contentgit/domain/content/projections"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type ContentBranchProjectionRepositoryImpl struct {
+}
+
+func (ContentBranchProjectionRepositoryImpl) Create(ctx context.Context, projection projections.ContentBranchProjection) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Create(&projection).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (ContentBranchProjectionRepositoryImpl) FindByID(ctx context.Context, tenantId string, contentId string, branch string) (*projections.ContentBranchProjection, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var projection projections.ContentBranchProjection
+	if err := db.First(&projection, "tenant_id = ? AND content_id = ? AND branch = ?", tenantId, contentId, branch).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &projection, nil
+}
+
+func (ContentBranchProjectionRepositoryImpl) FindAllByContentId(ctx context.Context, tenantId string, contentId string) ([]projections.ContentBranchProjection, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var entities = make([]projections.ContentBranchProjection, 0)
+	if err := db.Where("tenant_id = ? AND content_id = ?", tenantId, contentId).Order("created_at ASC").Find(&entities).Error; err != nil {
+		return entities, errors.Wrap(err, "db error")
+	}
+
+	return entities, nil
+}
+
+func (ContentBranchProjectionRepositoryImpl) Save(ctx context.Context, entity *projections.ContentBranchProjection) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Save(entity).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
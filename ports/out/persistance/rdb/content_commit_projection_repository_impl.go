@@ -0,0 +1,43 @@
+package rdb
+
+import (
+	"contentgit/domain/content/projections"
+	"contentgit/foundation"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type ContentCommitProjectionRepositoryImpl struct {
+}
+
+func (ContentCommitProjectionRepositoryImpl) Create(ctx context.Context, projection projections.ContentCommitProjection) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Create(&projection).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (ContentCommitProjectionRepositoryImpl) FindAllByContentId(ctx context.Context, tenantId string, contentId string, branch string) ([]projections.ContentCommitProjection, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var entities = make([]projections.ContentCommitProjection, 0)
+	if err := db.Where("tenant_id = ? AND content_id = ? AND branch = ?", tenantId, contentId, branch).Order("version DESC").Find(&entities).Error; err != nil {
+		return entities, errors.Wrap(err, "db error")
+	}
+
+	return entities, nil
+}
+
+func (ContentCommitProjectionRepositoryImpl) Save(ctx context.Context, entity *projections.ContentCommitProjection) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Save(entity).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
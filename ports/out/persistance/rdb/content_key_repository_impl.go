@@ -0,0 +1,68 @@
+package rdb
+
+import (
+	"contentgit/domain/content"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ContentKeyRepositoryImpl struct {
+}
+
+// FindOrCreate returns the key of the content, creating it on first use. A destroyed key is returned as is and never recreated.
+func (ContentKeyRepositoryImpl) FindOrCreate(ctx context.Context, tenantId string, contentId string) (*content.ContentKey, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	key, err := content.NewContentKey(tenantId, contentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(key).Error; err != nil {
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	var entity content.ContentKey
+	if err := db.First(&entity, "content_id = ?", contentId).Error; err != nil {
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &entity, nil
+}
+
+func (ContentKeyRepositoryImpl) FindByContentId(ctx context.Context, contentId string) (*content.ContentKey, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var entity content.ContentKey
+	if err := db.First(&entity, "content_id = ?", contentId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &entity, nil
+}
+
+// Destroy drops the key material of the content. A content that never had a key gets a destroyed one,
+// so that no key can be created for it afterwards.
+func (ContentKeyRepositoryImpl) Destroy(ctx context.Context, tenantId string, contentId string) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	destroyedAt := time.Now()
+	if err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "content_id"}},
+		DoUpdates: clause.Assignments(map[string]any{"key": nil, "destroyed_at": destroyedAt}),
+	}).Create(&content.ContentKey{ContentId: contentId, TenantId: tenantId, DestroyedAt: &destroyedAt}).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
@@ -28,6 +28,16 @@ func (ContentProjectionRepositoryImpl) Create(ctx context.Context, projection pr
 func (ContentProjectionRepositoryImpl) FindByID(ctx context.Context, tenantId string, id string) (*projections.ContentProjection, error) {
 	db := foundation.ContextProvider().GetDB(ctx)
 
+	return findContentProjection(db, tenantId, id)
+}
+
+func (ContentProjectionRepositoryImpl) FindByIDIncludingDeleted(ctx context.Context, tenantId string, id string) (*projections.ContentProjection, error) {
+	db := foundation.ContextProvider().GetDB(ctx).Unscoped()
+
+	return findContentProjection(db, tenantId, id)
+}
+
+func findContentProjection(db *gorm.DB, tenantId string, id string) (*projections.ContentProjection, error) {
 	var projection projections.ContentProjection
 	if err := db.Preload("FieldChanges").Preload("FieldComments").First(&projection, "tenant_id = ? AND id = ?", tenantId, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -40,14 +50,33 @@ func (ContentProjectionRepositoryImpl) FindByID(ctx context.Context, tenantId st
 	return &projection, nil
 }
 
-func (ContentProjectionRepositoryImpl) FindAll(ctx context.Context, tenantId string, pageable dtos.Pageable, sort *dtos.Sort) ([]projections.ContentProjection, int64, error) {
+func (ContentProjectionRepositoryImpl) FindAll(ctx context.Context, tenantId string, pageable dtos.Pageable, sort *dtos.Sort, filter dtos.ContentFilter) ([]projections.ContentProjection, int64, error) {
 	db := foundation.ContextProvider().GetDB(ctx).Model(&projections.ContentProjection{})
+	if filter.IncludeDeleted {
+		db = db.Unscoped()
+	}
+
+	db = db.Where("tenant_id = ?", tenantId)
+	if filter.Status != "" {
+		db = db.Where("status = ?", filter.Status)
+	}
 
+	return findAllContentProjections(db, pageable, sort)
+}
+
+// FindAllDeleted returns the trash of the tenant, the latest deleted first.
+func (ContentProjectionRepositoryImpl) FindAllDeleted(ctx context.Context, tenantId string, pageable dtos.Pageable) ([]projections.ContentProjection, int64, error) {
+	db := foundation.ContextProvider().GetDB(ctx).Model(&projections.ContentProjection{}).Unscoped()
+
+	db = db.Where("tenant_id = ? AND deleted_at IS NOT NULL", tenantId)
+
+	return findAllContentProjections(db, pageable, &dtos.Sort{Field: "deleted_at", Direction: "desc"})
+}
+
+func findAllContentProjections(db *gorm.DB, pageable dtos.Pageable, sort *dtos.Sort) ([]projections.ContentProjection, int64, error) {
 	var entities = make([]projections.ContentProjection, 0)
 	var totalCount int64
 
-	db = db.Where("tenant_id = ?", tenantId)
-
 	if sort != nil {
 		db = db.Order(fmt.Sprintf("%s %s", sort.Field, sort.Direction))
 	}
@@ -62,7 +91,8 @@ func (ContentProjectionRepositoryImpl) FindAll(ctx context.Context, tenantId str
 func (ContentProjectionRepositoryImpl) Save(ctx context.Context, entity *projections.ContentProjection) error {
 	db := foundation.ContextProvider().GetDB(ctx)
 
-	if err := db.Session(&gorm.Session{FullSaveAssociations: true}).Save(entity).Error; err != nil {
+	// Unscoped so that saving a deleted projection, such as restoring it, updates it instead of inserting it again.
+	if err := db.Unscoped().Session(&gorm.Session{FullSaveAssociations: true}).Save(entity).Error; err != nil {
 		return errors.Wrap(err, "db error")
 	}
 
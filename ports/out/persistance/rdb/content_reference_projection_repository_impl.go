@@ -0,0 +1,59 @@
+package rdb
+
+import (
+	"contentgit/domain/content/projections"
+	"contentgit/foundation"
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type ContentReferenceProjectionRepositoryImpl struct {
+}
+
+func (ContentReferenceProjectionRepositoryImpl) ReplaceAllBySourceId(ctx context.Context, tenantId string, sourceId string,
+	references []projections.ContentReferenceProjection) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tenant_id = ? AND source_id = ?", tenantId, sourceId).
+			Delete(&projections.ContentReferenceProjection{}).Error; err != nil {
+			return err
+		}
+		if len(references) == 0 {
+			return nil
+		}
+		return tx.Create(&references).Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (ContentReferenceProjectionRepositoryImpl) FindAllByTargetId(ctx context.Context, tenantId string, targetId string) ([]projections.ContentReferenceProjection, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var entities = make([]projections.ContentReferenceProjection, 0)
+	if err := db.Where("tenant_id = ? AND target_id = ?", tenantId, targetId).
+		Order("source_id, field").Find(&entities).Error; err != nil {
+		return entities, errors.Wrap(err, "db error")
+	}
+
+	return entities, nil
+}
+
+func (ContentReferenceProjectionRepositoryImpl) ExistsByTargetId(ctx context.Context, tenantId string, targetId string) (bool, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var count int64
+	if err := db.Model(&projections.ContentReferenceProjection{}).
+		Where("tenant_id = ? AND target_id = ? AND source_id <> ?", tenantId, targetId, targetId).
+		Count(&count).Error; err != nil {
+		return false, errors.Wrap(err, "db error")
+	}
+
+	return count > 0, nil
+}
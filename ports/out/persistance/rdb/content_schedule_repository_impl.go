@@ -0,0 +1,86 @@
+package rdb
+
+import (
+	"contentgit/domain/content"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ContentScheduleRepositoryImpl struct {
+}
+
+func (ContentScheduleRepositoryImpl) Create(ctx context.Context, schedule content.ContentSchedule) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Create(&schedule).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (ContentScheduleRepositoryImpl) FindByID(ctx context.Context, tenantId string, contentId string, id string) (*content.ContentSchedule, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var schedule content.ContentSchedule
+	if err := db.First(&schedule, "tenant_id = ? AND content_id = ? AND id = ?", tenantId, contentId, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &schedule, nil
+}
+
+// FindAllByContentId returns the jobs of the content, the next to run first.
+func (ContentScheduleRepositoryImpl) FindAllByContentId(ctx context.Context, tenantId string, contentId string) ([]content.ContentSchedule, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var entities = make([]content.ContentSchedule, 0)
+	if err := db.Where("tenant_id = ? AND content_id = ?", tenantId, contentId).Order("run_at, id").Find(&entities).Error; err != nil {
+		return entities, errors.Wrap(err, "db error")
+	}
+
+	return entities, nil
+}
+
+func (ContentScheduleRepositoryImpl) ClaimNextDue(ctx context.Context, now time.Time, skipIds []string) (*content.ContentSchedule, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	query := db.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("state = ? AND run_at <= ?", content.ScheduleStatePending, now)
+	if len(skipIds) > 0 {
+		query = query.Where("id NOT IN ?", skipIds)
+	}
+
+	var schedule content.ContentSchedule
+	if err := query.
+		Order("run_at, id").
+		First(&schedule).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &schedule, nil
+}
+
+func (ContentScheduleRepositoryImpl) Save(ctx context.Context, schedule *content.ContentSchedule) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Save(schedule).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
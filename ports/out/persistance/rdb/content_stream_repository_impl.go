@@ -0,0 +1,51 @@
+package rdb
+
+import (
+	"contentgit/domain/content/events"
+	"contentgit/foundation"
+	"contentgit/ports/out/persistance/eventsourcing"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type ContentStreamRepositoryImpl struct {
+}
+
+func (ContentStreamRepositoryImpl) FindIdsByContentType(ctx context.Context, tenantId string, contentType string, afterId string,
+	limit int) ([]string, error) {
+	db := foundation.ContextProvider().GetDB(ctx).Model(&eventsourcing.Event{})
+
+	var ids = make([]string, 0)
+	if err := db.Where("tenant_id = ? AND branch = ? AND event_type = ? AND data->>'contentType' = ? AND aggregate_id > ?",
+		tenantId, eventsourcing.DefaultBranch, events.ContentCreatedEventType, contentType, afterId).
+		Order("aggregate_id").Limit(limit).Pluck("aggregate_id", &ids).Error; err != nil {
+		return ids, errors.Wrap(err, "db error")
+	}
+
+	return ids, nil
+}
+
+func (ContentStreamRepositoryImpl) CountByContentType(ctx context.Context, tenantId string, contentType string) (int64, error) {
+	db := foundation.ContextProvider().GetDB(ctx).Model(&eventsourcing.Event{})
+
+	var count int64
+	if err := db.Where("tenant_id = ? AND branch = ? AND event_type = ? AND data->>'contentType' = ?",
+		tenantId, eventsourcing.DefaultBranch, events.ContentCreatedEventType, contentType).Count(&count).Error; err != nil {
+		return 0, errors.Wrap(err, "db error")
+	}
+
+	return count, nil
+}
+
+func (ContentStreamRepositoryImpl) FindBranches(ctx context.Context, tenantId string, contentId string) ([]string, error) {
+	db := foundation.ContextProvider().GetDB(ctx).Model(&eventsourcing.Event{})
+
+	var branches = make([]string, 0)
+	if err := db.Distinct("branch").Where("tenant_id = ? AND aggregate_id = ? AND branch <> ?", tenantId, contentId, eventsourcing.DefaultBranch).
+		Order("branch").Pluck("branch", &branches).Error; err != nil {
+		return branches, errors.Wrap(err, "db error")
+	}
+
+	return branches, nil
+}
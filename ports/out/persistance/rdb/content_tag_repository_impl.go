@@ -0,0 +1,87 @@
+package rdb
+
+import (
+	"contentgit/domain/content"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const uniqueViolationCode = "23505"
+
+type ContentTagRepositoryImpl struct {
+}
+
+func (ContentTagRepositoryImpl) Create(ctx context.Context, tag content.ContentTag) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Create(&tag).Error; err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return errors.Wrap(persistence.ErrDuplicateRecord, err.Error())
+		}
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (ContentTagRepositoryImpl) FindByName(ctx context.Context, tenantId string, contentId string, name string) (*content.ContentTag, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var tag content.ContentTag
+	if err := db.First(&tag, "tenant_id = ? AND content_id = ? AND name = ?", tenantId, contentId, name).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &tag, nil
+}
+
+func (ContentTagRepositoryImpl) FindAllByContentId(ctx context.Context, tenantId string, contentId string) ([]content.ContentTag, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var entities = make([]content.ContentTag, 0)
+	if err := db.Where("tenant_id = ? AND content_id = ?", tenantId, contentId).Order("created_at DESC").Find(&entities).Error; err != nil {
+		return entities, errors.Wrap(err, "db error")
+	}
+
+	return entities, nil
+}
+
+func (ContentTagRepositoryImpl) FindAll(ctx context.Context, tenantId string, pageable dtos.Pageable) ([]content.ContentTag, int64, error) {
+	db := foundation.ContextProvider().GetDB(ctx).Model(&content.ContentTag{})
+
+	var entities = make([]content.ContentTag, 0)
+	var totalCount int64
+
+	db = db.Where("tenant_id = ?", tenantId).Order("created_at DESC")
+
+	if err := db.Count(&totalCount).Scopes(foundation.GormPaginator().Pageable(pageable)).Find(&entities).Error; err != nil {
+		return entities, totalCount, errors.Wrap(err, "db error")
+	}
+
+	return entities, totalCount, nil
+}
+
+func (ContentTagRepositoryImpl) Delete(ctx context.Context, tenantId string, contentId string, name string) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	result := db.Where("tenant_id = ? AND content_id = ? AND name = ?", tenantId, contentId, name).Delete(&content.ContentTag{})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "db error")
+	}
+	if result.RowsAffected == 0 {
+		return persistence.ErrRecordNotFound
+	}
+
+	return nil
+}
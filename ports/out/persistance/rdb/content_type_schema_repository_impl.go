@@ -0,0 +1,86 @@
+package rdb
+
+import (
+	"contentgit/domain/content"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type ContentTypeSchemaRepositoryImpl struct {
+}
+
+func (ContentTypeSchemaRepositoryImpl) Create(ctx context.Context, schema content.ContentTypeSchema) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Create(&schema).Error; err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return errors.Wrap(persistence.ErrDuplicateRecord, err.Error())
+		}
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (ContentTypeSchemaRepositoryImpl) FindLatest(ctx context.Context, tenantId string, contentType string) (*content.ContentTypeSchema, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var schema content.ContentTypeSchema
+	if err := db.Where("tenant_id = ? AND content_type = ?", tenantId, contentType).Order("version DESC").
+		First(&schema).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &schema, nil
+}
+
+func (ContentTypeSchemaRepositoryImpl) FindByVersion(ctx context.Context, tenantId string, contentType string, version uint64) (*content.ContentTypeSchema, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var schema content.ContentTypeSchema
+	if err := db.First(&schema, "tenant_id = ? AND content_type = ? AND version = ?", tenantId, contentType, version).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &schema, nil
+}
+
+func (ContentTypeSchemaRepositoryImpl) FindAllVersions(ctx context.Context, tenantId string, contentType string) ([]content.ContentTypeSchema, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var entities = make([]content.ContentTypeSchema, 0)
+	if err := db.Where("tenant_id = ? AND content_type = ?", tenantId, contentType).Order("version").Find(&entities).Error; err != nil {
+		return entities, errors.Wrap(err, "db error")
+	}
+
+	return entities, nil
+}
+
+func (ContentTypeSchemaRepositoryImpl) FindAllLatest(ctx context.Context, tenantId string) ([]content.ContentTypeSchema, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	latestVersions := db.Model(&content.ContentTypeSchema{}).Select("content_type, MAX(version)").
+		Where("tenant_id = ?", tenantId).Group("content_type")
+
+	var entities = make([]content.ContentTypeSchema, 0)
+	if err := db.Where("tenant_id = ? AND (content_type, version) IN (?) AND deleted = false", tenantId, latestVersions).
+		Order("content_type").Find(&entities).Error; err != nil {
+		return entities, errors.Wrap(err, "db error")
+	}
+
+	return entities, nil
+}
@@ -0,0 +1,106 @@
+package rdb
+
+import (
+	"contentgit/domain/notification/projections"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationProjectionRepositoryImpl struct {
+}
+
+func (NotificationProjectionRepositoryImpl) Create(ctx context.Context, projection projections.NotificationProjection) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&projection).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (NotificationProjectionRepositoryImpl) FindByID(ctx context.Context, tenantId string, userId string, id uint) (*projections.NotificationProjection, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var projection projections.NotificationProjection
+	if err := db.First(&projection, "tenant_id = ? AND user_id = ? AND id = ?", tenantId, userId, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &projection, nil
+}
+
+// FindAllByUserId returns the inbox of the user, only the unread notifications when unreadOnly is set, the latest first.
+func (NotificationProjectionRepositoryImpl) FindAllByUserId(ctx context.Context, tenantId string, userId string, unreadOnly bool,
+	pageable dtos.Pageable) ([]projections.NotificationProjection, int64, error) {
+	db := foundation.ContextProvider().GetDB(ctx).Model(&projections.NotificationProjection{}).
+		Where("tenant_id = ? AND user_id = ?", tenantId, userId)
+	if unreadOnly {
+		db = db.Where("read_at IS NULL")
+	}
+
+	var entities = make([]projections.NotificationProjection, 0)
+	var totalCount int64
+	if err := db.Count(&totalCount).Scopes(foundation.GormPaginator().Pageable(pageable)).
+		Order("created_at desc, id desc").Find(&entities).Error; err != nil {
+		return entities, 0, errors.Wrap(err, "db error")
+	}
+
+	return entities, totalCount, nil
+}
+
+func (NotificationProjectionRepositoryImpl) Save(ctx context.Context, entity *projections.NotificationProjection) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Save(entity).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (NotificationProjectionRepositoryImpl) MarkAllAsRead(ctx context.Context, tenantId string, userId string, readAt time.Time) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Model(&projections.NotificationProjection{}).
+		Where("tenant_id = ? AND user_id = ? AND read_at IS NULL", tenantId, userId).
+		Update("read_at", readAt).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (NotificationProjectionRepositoryImpl) UpdateCommentByCommentId(ctx context.Context, tenantId string, contentId string, commentId string, comment string) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Model(&projections.NotificationProjection{}).
+		Where("tenant_id = ? AND content_id = ? AND comment_id = ?", tenantId, contentId, commentId).
+		Update("comment", comment).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (NotificationProjectionRepositoryImpl) DeleteAllByCommentId(ctx context.Context, tenantId string, contentId string, commentId string) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Where("tenant_id = ? AND content_id = ? AND comment_id = ?", tenantId, contentId, commentId).
+		Delete(&projections.NotificationProjection{}).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package rdb
+
+import (
+	"contentgit/domain/content/projections"
+	"contentgit/dtos"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type PublishedContentProjectionRepositoryImpl struct {
+}
+
+// Save creates the published content or replaces it when a newer version is published.
+func (PublishedContentProjectionRepositoryImpl) Save(ctx context.Context, projection *projections.PublishedContentProjection) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Save(projection).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (PublishedContentProjectionRepositoryImpl) FindByID(ctx context.Context, tenantId string, contentType string, id string) (*projections.PublishedContentProjection, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var projection projections.PublishedContentProjection
+	if err := db.First(&projection, "tenant_id = ? AND LOWER(content_type) = LOWER(?) AND id = ?", tenantId, contentType, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &projection, nil
+}
+
+// FindAll returns the published contents of the content type, the latest published first.
+func (PublishedContentProjectionRepositoryImpl) FindAll(ctx context.Context, tenantId string, contentType string, pageable dtos.Pageable) ([]projections.PublishedContentProjection, int64, error) {
+	db := foundation.ContextProvider().GetDB(ctx).Model(&projections.PublishedContentProjection{})
+
+	var entities = make([]projections.PublishedContentProjection, 0)
+	var totalCount int64
+
+	db = db.Where("tenant_id = ? AND LOWER(content_type) = LOWER(?)", tenantId, contentType).Order("published_at desc")
+	if err := db.Count(&totalCount).Scopes(foundation.GormPaginator().Pageable(pageable)).Find(&entities).Error; err != nil {
+		return entities, totalCount, errors.Wrap(err, "db error")
+	}
+
+	return entities, totalCount, nil
+}
+
+// Delete takes the content offline, deleting a content that is not published is not an error.
+func (PublishedContentProjectionRepositoryImpl) Delete(ctx context.Context, tenantId string, id string) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Where("tenant_id = ? AND id = ?", tenantId, id).Delete(&projections.PublishedContentProjection{}).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
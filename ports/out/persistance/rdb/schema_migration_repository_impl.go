@@ -0,0 +1,92 @@
+package rdb
+
+import (
+	"contentgit/domain/content"
+	"contentgit/foundation"
+	persistence "contentgit/ports/out/persistance"
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SchemaMigrationRepositoryImpl struct {
+}
+
+func (SchemaMigrationRepositoryImpl) Create(ctx context.Context, migration content.SchemaMigration) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Create(&migration).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
+
+func (SchemaMigrationRepositoryImpl) FindByID(ctx context.Context, tenantId string, contentType string, id string) (*content.SchemaMigration, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var migration content.SchemaMigration
+	if err := db.First(&migration, "tenant_id = ? AND content_type = ? AND id = ?", tenantId, contentType, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &migration, nil
+}
+
+func (SchemaMigrationRepositoryImpl) FindAllByContentType(ctx context.Context, tenantId string, contentType string) ([]content.SchemaMigration, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var entities = make([]content.SchemaMigration, 0)
+	if err := db.Where("tenant_id = ? AND content_type = ?", tenantId, contentType).Order("created_at DESC, id").Find(&entities).Error; err != nil {
+		return entities, errors.Wrap(err, "db error")
+	}
+
+	return entities, nil
+}
+
+func (SchemaMigrationRepositoryImpl) ExistsActive(ctx context.Context, tenantId string, contentType string) (bool, error) {
+	db := foundation.ContextProvider().GetDB(ctx).Model(&content.SchemaMigration{})
+
+	var count int64
+	if err := db.Where("tenant_id = ? AND content_type = ? AND dry_run = false AND state IN ?", tenantId, contentType,
+		[]content.SchemaMigrationState{content.SchemaMigrationStatePending, content.SchemaMigrationStateRunning}).
+		Count(&count).Error; err != nil {
+		return false, errors.Wrap(err, "db error")
+	}
+
+	return count > 0, nil
+}
+
+func (SchemaMigrationRepositoryImpl) ClaimNextActive(ctx context.Context) (*content.SchemaMigration, error) {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	var migration content.SchemaMigration
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("state IN ?", []content.SchemaMigrationState{content.SchemaMigrationStatePending, content.SchemaMigrationStateRunning}).
+		Order("created_at, id").
+		First(&migration).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, persistence.ErrRecordNotFound
+		}
+
+		return nil, errors.Wrap(err, "db error")
+	}
+
+	return &migration, nil
+}
+
+func (SchemaMigrationRepositoryImpl) Save(ctx context.Context, migration *content.SchemaMigration) error {
+	db := foundation.ContextProvider().GetDB(ctx)
+
+	if err := db.Save(migration).Error; err != nil {
+		return errors.Wrap(err, "db error")
+	}
+
+	return nil
+}
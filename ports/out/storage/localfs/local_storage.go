@@ -0,0 +1,82 @@
+package localfs
+
+import (
+	"contentgit/domain/asset"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// LocalStorage keeps the blobs of the assets as files under a root directory, each at a path made of its SHA-256
+// so that the same bytes are written once.
+type LocalStorage struct {
+	root string
+}
+
+func NewLocalStorage(root string) (*LocalStorage, error) {
+	if root == "" {
+		return nil, errors.New("root of the asset storage is required.")
+	}
+	if err := os.MkdirAll(filepath.Join(root, "tmp"), 0o755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create the asset storage root: %s", root)
+	}
+	return &LocalStorage{root: root}, nil
+}
+
+// Store writes the bytes to a temporary file while hashing them, then moves it to the path of its SHA-256 unless
+// a blob is there already.
+func (s *LocalStorage) Store(ctx context.Context, r io.Reader) (asset.Blob, error) {
+	tmp, err := os.CreateTemp(filepath.Join(s.root, "tmp"), "upload-*")
+	if err != nil {
+		return asset.Blob{}, errors.Wrap(err, "failed to create a temporary file")
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+	size, err := io.Copy(tmp, io.TeeReader(r, hash))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return asset.Blob{}, errors.Wrap(err, "failed to write the blob")
+	}
+
+	blob := asset.Blob{Sha256: hex.EncodeToString(hash.Sum(nil)), Size: size}
+	blobPath := s.path(blob.Sha256)
+	if _, err := os.Stat(blobPath); err == nil {
+		return blob, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(blobPath), 0o755); err != nil {
+		return asset.Blob{}, errors.Wrap(err, "failed to create the blob directory")
+	}
+	if err := os.Rename(tmp.Name(), blobPath); err != nil {
+		return asset.Blob{}, errors.Wrap(err, "failed to move the blob")
+	}
+	return blob, nil
+}
+
+func (s *LocalStorage) Open(ctx context.Context, sha256 string) (io.ReadSeekCloser, error) {
+	if !asset.IsSha256(sha256) {
+		return nil, errors.Wrapf(asset.ErrBlobNotFound, "sha256: %s", sha256)
+	}
+
+	file, err := os.Open(s.path(sha256))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Wrapf(asset.ErrBlobNotFound, "sha256: %s", sha256)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open the blob")
+	}
+	return file, nil
+}
+
+// path spreads the blobs over directories named after the first bytes of their SHA-256.
+func (s *LocalStorage) path(sha256 string) string {
+	return filepath.Join(s.root, sha256[:2], sha256[2:4], sha256)
+}
@@ -0,0 +1,70 @@
+package localfs
+
+import (
+	"contentgit/domain/asset"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage_Store(t *testing.T) {
+	t.Run("바이트를 SHA-256으로 저장하고 읽는다", func(t *testing.T) {
+		// given
+		sut, err := NewLocalStorage(t.TempDir())
+		require.NoError(t, err)
+
+		// when
+		blob, err := sut.Store(context.Background(), strings.NewReader("hello"))
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, asset.Blob{Sha256: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", Size: 5}, blob)
+
+		file, err := sut.Open(context.Background(), blob.Sha256)
+		require.NoError(t, err)
+		defer file.Close()
+		bytes, _ := io.ReadAll(file)
+		assert.Equal(t, "hello", string(bytes))
+	})
+
+	t.Run("같은 바이트는 한 번만 저장한다", func(t *testing.T) {
+		// given
+		root := t.TempDir()
+		sut, err := NewLocalStorage(root)
+		require.NoError(t, err)
+		first, err := sut.Store(context.Background(), strings.NewReader("hello"))
+		require.NoError(t, err)
+
+		// when
+		second, err := sut.Store(context.Background(), strings.NewReader("hello"))
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, first, second)
+		blobs, _ := filepath.Glob(filepath.Join(root, "2c", "f2", "*"))
+		assert.Len(t, blobs, 1)
+		temporaryFiles, _ := os.ReadDir(filepath.Join(root, "tmp"))
+		assert.Empty(t, temporaryFiles)
+	})
+
+	t.Run("저장되지 않은 바이트는 ErrBlobNotFound를 반환한다", func(t *testing.T) {
+		// given
+		sut, err := NewLocalStorage(t.TempDir())
+		require.NoError(t, err)
+
+		// when
+		_, err = sut.Open(context.Background(), "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824")
+
+		// then
+		assert.ErrorIs(t, err, asset.ErrBlobNotFound)
+
+		_, err = sut.Open(context.Background(), "../../etc/passwd")
+		assert.ErrorIs(t, err, asset.ErrBlobNotFound)
+	})
+}
@@ -8,4 +8,9 @@ CREATE DATABASE content_git WITH ENCODING = 'UTF8' LC_COLLATE = 'C.utf8' LC_CTYP
 CREATE EXTENSION pgmq;
 
 -- creates the queue
-SELECT pgmq.create('content');
\ No newline at end of file
+SELECT pgmq.create('content');
+SELECT pgmq.create('content_notification');
+SELECT pgmq.create('content_reference');
+SELECT pgmq.create('content_change_request');
+SELECT pgmq.create('change_request');
+SELECT pgmq.create('asset');
\ No newline at end of file
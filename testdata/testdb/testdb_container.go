@@ -66,7 +66,7 @@ func (c TestDatabaseContainer) Terminate() error {
 	return c.dbContainer.Terminate(context.Background())
 }
 
-func (c *TestDatabaseContainer) ResetContentEventsQueue() error {
+func (c *TestDatabaseContainer) ResetEventsQueues() error {
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
 		c.Host,
 		c.Username,
@@ -86,9 +86,29 @@ func (c *TestDatabaseContainer) ResetContentEventsQueue() error {
 			IF EXISTS (SELECT 1 FROM pgmq.list_queues() WHERE queue_name = 'content') THEN
 				PERFORM pgmq.drop_queue('content');
 			END IF;
+			IF EXISTS (SELECT 1 FROM pgmq.list_queues() WHERE queue_name = 'content_notification') THEN
+				PERFORM pgmq.drop_queue('content_notification');
+			END IF;
+			IF EXISTS (SELECT 1 FROM pgmq.list_queues() WHERE queue_name = 'content_reference') THEN
+				PERFORM pgmq.drop_queue('content_reference');
+			END IF;
+			IF EXISTS (SELECT 1 FROM pgmq.list_queues() WHERE queue_name = 'content_change_request') THEN
+				PERFORM pgmq.drop_queue('content_change_request');
+			END IF;
+			IF EXISTS (SELECT 1 FROM pgmq.list_queues() WHERE queue_name = 'change_request') THEN
+				PERFORM pgmq.drop_queue('change_request');
+			END IF;
+			IF EXISTS (SELECT 1 FROM pgmq.list_queues() WHERE queue_name = 'asset') THEN
+				PERFORM pgmq.drop_queue('asset');
+			END IF;
 		END $$;
 
 		SELECT pgmq.create('content');
+		SELECT pgmq.create('content_notification');
+		SELECT pgmq.create('content_reference');
+		SELECT pgmq.create('content_change_request');
+		SELECT pgmq.create('change_request');
+		SELECT pgmq.create('asset');
 	`)
 	if err != nil {
 		return fmt.Errorf("failed to reset queue: %w", err)
@@ -43,10 +43,15 @@ func (t *TestAppServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	t.internalApp.GetGin().ServeHTTP(w, req)
 }
 
-func (t *TestAppServer) getDB() *gorm.DB {
+func (t *TestAppServer) GetDB() *gorm.DB {
 	return t.internalApp.GetDB()
 }
 
+// GetComponent returns a component of the app, such as a scheduler a test runs by hand.
+func (t *TestAppServer) GetComponent(name string) any {
+	return t.registry.Get(name)
+}
+
 func (t *TestAppServer) setMockComponent(name string, mock any) {
 	t.registry.Register(name, mock)
 }
@@ -107,7 +112,11 @@ func (builder *TestAppServerBuilder) SetMockComponent(name string, component any
 func (builder *TestAppServerBuilder) Build() *TestAppServer {
 	builder.appServer.run()
 	if builder.dbFixture {
-		testdb.DatabaseFixture{}.SetUpDefault(builder.appServer.getDB())
+		testdb.DatabaseFixture{}.SetUpDefault(builder.appServer.GetDB())
+		// the comment fixtures are projected before comment ids existed, they get their ids as a deployed database does
+		if err := app.BackfillFieldCommentIds(builder.appServer.GetDB()); err != nil {
+			panic(err)
+		}
 	}
 	return builder.appServer
 }
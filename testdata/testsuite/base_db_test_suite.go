@@ -20,7 +20,7 @@ func (suite *BaseDatabaseTestSuite) SetupSuite() {
 }
 
 func (suite *BaseDatabaseTestSuite) SetupTest() {
-	if err := suite.TestDbContainer.ResetContentEventsQueue(); err != nil {
+	if err := suite.TestDbContainer.ResetEventsQueues(); err != nil {
 		panic(err)
 	}
 }